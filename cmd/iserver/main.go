@@ -15,6 +15,7 @@
 package main
 
 import (
+	"fmt"
 	_ "net/http/pprof"
 	"os"
 	"os/signal"
@@ -67,6 +68,8 @@ func initLogger(logConfig *common.LogConfig) {
 }
 
 func main() {
+	// the flags following a command are parsed by the command
+	flag.SetInterspersed(false)
 	flag.Parse()
 	if *help {
 		flag.Usage()
@@ -84,6 +87,11 @@ func main() {
 	ilog.Infof("build time:%v", global.BuildTime)
 	ilog.Infof("git hash:%v", global.GitHash)
 
+	if flag.NArg() > 0 {
+		runCommand(conf, flag.Args())
+		return
+	}
+
 	err := initMetrics(conf.Metrics)
 	if err != nil {
 		ilog.Errorf("init metrics failed. err=%v", err)
@@ -98,6 +106,28 @@ func main() {
 	ilog.Stop()
 }
 
+// runCommand runs the subcommand instead of the server.
+func runCommand(conf *common.Config, args []string) {
+	var err error
+	switch args[0] {
+	case "snapshot":
+		err = runSnapshot(conf, args[1:])
+	case "verify-db":
+		err = runVerifyDB(conf, args[1:])
+	case "wal":
+		err = runWAL(conf, args[1:])
+	default:
+		err = fmt.Errorf("unknown command %v", args[0])
+	}
+	if err != nil {
+		ilog.Errorf("%v failed: %v", args[0], err)
+	}
+	ilog.Stop()
+	if err != nil {
+		os.Exit(1)
+	}
+}
+
 func waitExit() {
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
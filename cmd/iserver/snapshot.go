@@ -0,0 +1,69 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"io/ioutil"
+	"os"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/ilog"
+	"github.com/iost-official/go-iost/iserver"
+)
+
+const snapshotUsage = `usage: iserver [-f config] snapshot export <file>
+       iserver [-f config] snapshot import -block_hash <hash> <file>`
+
+// runSnapshot exports the state at LIB to the snapshot file, or imports the snapshot
+// file of the trusted block hash into the empty data directory of the config.
+func runSnapshot(conf *common.Config, args []string) error {
+	if len(args) == 0 {
+		return errors.New(snapshotUsage)
+	}
+	switch args[0] {
+	case "export":
+		if len(args) != 2 {
+			return errors.New(snapshotUsage)
+		}
+		f, err := os.OpenFile(args[1], os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if err != nil {
+			return err
+		}
+		blk, err := iserver.ExportSnapshot(conf, f)
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			os.Remove(args[1])
+			return err
+		}
+		ilog.Infof("Exported the snapshot at block %v, hash %v", blk.Head.Number, common.Base58Encode(blk.HeadHash()))
+	case "import":
+		fs := flag.NewFlagSet("import", flag.ContinueOnError)
+		fs.SetOutput(ioutil.Discard)
+		blockHash := fs.String("block_hash", "", "the trusted hash of the snapshot block")
+		if err := fs.Parse(args[1:]); err != nil || fs.NArg() != 1 {
+			return errors.New(snapshotUsage)
+		}
+		if *blockHash == "" {
+			return errors.New("-block_hash is required, set it to the hash of the snapshot block you trust")
+		}
+		trustedHash := common.Base58Decode(*blockHash)
+		if len(trustedHash) == 0 {
+			return errors.New("invalid -block_hash")
+		}
+		f, err := os.Open(fs.Arg(0))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		blk, err := iserver.ImportSnapshot(conf, f, trustedHash)
+		if err != nil {
+			return err
+		}
+		ilog.Infof("Imported the snapshot at block %v, hash %v", blk.Head.Number, common.Base58Encode(blk.HeadHash()))
+	default:
+		return errors.New(snapshotUsage)
+	}
+	return nil
+}
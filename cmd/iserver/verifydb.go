@@ -0,0 +1,42 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/ilog"
+	"github.com/iost-official/go-iost/iserver"
+)
+
+// runVerifyDB checks the databases of the config, and optionally repairs them.
+func runVerifyDB(conf *common.Config, args []string) error {
+	opts := &iserver.VerifyDBOptions{}
+	fs := flag.NewFlagSet("verify-db", flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	fs.Int64Var(&opts.Start, "start", 0, "the number of the first block to verify")
+	fs.Int64Var(&opts.End, "end", 0, "the number of the last block to verify, 0 means the top block")
+	fs.BoolVar(&opts.RepairIndex, "repair-index", false, "rewrite the broken tx and receipt indexes")
+	fs.BoolVar(&opts.Rollback, "rollback", false, "roll the blockchain and state back to the consistent height, which requires the archive of state to roll the state back")
+	if err := fs.Parse(args); err != nil || fs.NArg() > 0 {
+		return errors.New("usage: iserver [-f config] verify-db [-start number] [-end number] [-repair-index] [-rollback]")
+	}
+
+	report, err := iserver.VerifyDB(conf, opts)
+	if report != nil {
+		for _, p := range report.Problems {
+			ilog.Warnf("%v", p)
+		}
+		ilog.Infof("Verified %d blocks (%d pruned) of %d, repaired %d index entries, consistent to block %d, state at block %d",
+			report.Verified, report.Pruned, report.Length, report.Repaired, report.ConsistentLength-1, report.StateNumber)
+	}
+	if err != nil {
+		return err
+	}
+	if !report.Consistent {
+		return fmt.Errorf("databases are inconsistent")
+	}
+	return nil
+}
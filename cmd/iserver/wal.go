@@ -0,0 +1,146 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"text/tabwriter"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/db/wal"
+	"github.com/iost-official/go-iost/ilog"
+)
+
+const walUsage = `usage: iserver [-f config] wal [-dir path] inspect [-records]
+       iserver [-f config] wal [-dir path] verify
+       iserver [-f config] wal [-dir path] truncate`
+
+// runWAL inspects, verifies or truncates the block cache WAL, which must not be in use.
+func runWAL(conf *common.Config, args []string) error {
+	fs := flag.NewFlagSet("wal", flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	dir := fs.String("dir", blockcache.WALDir(conf), "the directory of the WAL")
+	if err := fs.Parse(args); err != nil || fs.NArg() == 0 {
+		return errors.New(walUsage)
+	}
+	switch fs.Arg(0) {
+	case "inspect":
+		sub := flag.NewFlagSet("inspect", flag.ContinueOnError)
+		sub.SetOutput(ioutil.Discard)
+		records := sub.Bool("records", false, "list all the records")
+		if err := sub.Parse(fs.Args()[1:]); err != nil || sub.NArg() > 0 {
+			return errors.New(walUsage)
+		}
+		return inspectWAL(*dir, *records)
+	case "verify":
+		if fs.NArg() > 1 {
+			return errors.New(walUsage)
+		}
+		report, err := wal.Inspect(*dir, func(*wal.Record) {})
+		if err != nil {
+			return err
+		}
+		printWALReport(report)
+		if report.Corrupted() {
+			return fmt.Errorf("wal is corrupted, run `iserver wal truncate` to drop the records from the bad one")
+		}
+	case "truncate":
+		if fs.NArg() > 1 {
+			return errors.New(walUsage)
+		}
+		report, removed, err := wal.Truncate(*dir)
+		if err != nil {
+			return err
+		}
+		printWALReport(report)
+		if report.Bad == nil {
+			ilog.Infof("Nothing to truncate")
+			return nil
+		}
+		for _, name := range removed {
+			ilog.Infof("Removed %v", name)
+		}
+		ilog.Infof("Truncated the WAL after the record at %v:%d", report.Bad.Segment, report.Bad.Offset)
+	default:
+		return errors.New(walUsage)
+	}
+	return nil
+}
+
+// inspectWAL prints the segments of the WAL, and all the records if records is true.
+func inspectWAL(dir string, records bool) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	if records {
+		fmt.Fprintln(w, "SEGMENT\tOFFSET\tTYPE\tINDEX\tENTRY\tCRC")
+	}
+	// the numbers of the linked blocks, to show the number of the new root
+	numbers := make(map[string]int64)
+	report, err := wal.Inspect(dir, func(r *wal.Record) {
+		if !records {
+			return
+		}
+		crc, index, entry := "ok", "", ""
+		if r.Err != nil {
+			crc = r.Err.Error()
+		}
+		if r.Entry != nil {
+			index = fmt.Sprint(r.Entry.Index)
+			entry = describeWALEntry(r.Entry, numbers)
+		}
+		fmt.Fprintf(w, "%v\t%d\t%v\t%v\t%v\t%v\n", r.Segment, r.Offset, r.Type, index, entry, crc)
+	})
+	if err != nil {
+		return err
+	}
+	if records {
+		fmt.Fprintln(w)
+	}
+	fmt.Fprintln(w, "SEGMENT\tSIZE\tCOMPRESSED\tRECORDS\tENTRIES\tFIRST INDEX\tLAST INDEX")
+	for _, s := range report.Segments {
+		first, last := "", ""
+		if s.Entries > 0 {
+			first, last = fmt.Sprint(s.FirstIndex), fmt.Sprint(s.LastIndex)
+		}
+		fmt.Fprintf(w, "%v\t%d\t%v\t%d\t%d\t%v\t%v\n", s.Name, s.Size, s.Compressed, s.Records, s.Entries, first, last)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	printWALReport(report)
+	return nil
+}
+
+// describeWALEntry returns the type and block of the block cache entry.
+func describeWALEntry(e *wal.Entry, numbers map[string]int64) string {
+	bce, err := blockcache.DecodeWALEntry(e.Data)
+	if err != nil {
+		return fmt.Sprintf("undecodable: %v", err)
+	}
+	hash := common.Base58Encode(bce.Hash)
+	switch bce.Type {
+	case blockcache.BcMessageType_LinkType:
+		numbers[string(bce.Hash)] = bce.Number
+		return fmt.Sprintf("link block %d %v", bce.Number, hash)
+	case blockcache.BcMessageType_SetRootType:
+		if n, ok := numbers[string(bce.Hash)]; ok {
+			return fmt.Sprintf("set root %d %v", n, hash)
+		}
+		return fmt.Sprintf("set root %v", hash)
+	}
+	return bce.Type.String()
+}
+
+func printWALReport(report *wal.Report) {
+	ilog.Infof("Read %d records with %d entries in %d segments", report.Records, report.Entries, len(report.Segments))
+	switch {
+	case report.Bad == nil:
+		ilog.Infof("All the records are good")
+	case report.Corrupted():
+		ilog.Errorf("Bad record at %v:%d: %v", report.Bad.Segment, report.Bad.Offset, report.Bad.Err)
+	default:
+		ilog.Warnf("Partially written record at %v:%d, which is dropped on recovery", report.Bad.Segment, report.Bad.Offset)
+	}
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/iost-official/go-iost/db/kv"
+	"github.com/iost-official/go-iost/ilog"
+	"github.com/iost-official/go-iost/istate"
+	"github.com/urfave/cli"
+)
+
+func openState(c *cli.Context, path string) (*istate.State, error) {
+	storageType, err := kv.ParseStorageType(c.GlobalString("storage"))
+	if err != nil {
+		return nil, err
+	}
+	return istate.Open(path, storageType)
+}
+
+// action opens the state db of the global flag, and prints the result of f.
+func action(args int, f func(s *istate.State, c *cli.Context) (istate.Tabler, error)) cli.ActionFunc {
+	return func(c *cli.Context) error {
+		if c.NArg() != args {
+			return cli.ShowCommandHelp(c, c.Command.Name)
+		}
+		s, err := openState(c, c.GlobalString("db"))
+		if err != nil {
+			return err
+		}
+		defer s.Close()
+		result, err := f(s, c)
+		if err != nil {
+			return err
+		}
+		return istate.Write(os.Stdout, c.GlobalString("format"), result)
+	}
+}
+
+var contractsCommand = cli.Command{
+	Name:  "contracts",
+	Usage: "list all the contracts",
+	Action: action(0, func(s *istate.State, c *cli.Context) (istate.Tabler, error) {
+		return s.Contracts()
+	}),
+}
+
+var storageCommand = cli.Command{
+	Name:      "storage",
+	Usage:     "dump the storage keys and maps of a contract",
+	ArgsUsage: "CONTRACT",
+	Action: action(1, func(s *istate.State, c *cli.Context) (istate.Tabler, error) {
+		return s.Storage(c.Args().Get(0))
+	}),
+}
+
+var accountCommand = cli.Command{
+	Name:      "account",
+	Usage:     "show the token balances and RAM of an account",
+	ArgsUsage: "ACCOUNT",
+	Action: action(1, func(s *istate.State, c *cli.Context) (istate.Tabler, error) {
+		return s.Account(c.Args().Get(0))
+	}),
+}
+
+var balancesCommand = cli.Command{
+	Name:      "balances",
+	Usage:     "list the balances of all the holders of a token",
+	ArgsUsage: "TOKEN",
+	Action: action(1, func(s *istate.State, c *cli.Context) (istate.Tabler, error) {
+		return s.Balances(c.Args().Get(0))
+	}),
+}
+
+var ramCommand = cli.Command{
+	Name:  "ram",
+	Usage: "list the RAM usage of all the accounts",
+	Action: action(0, func(s *istate.State, c *cli.Context) (istate.Tabler, error) {
+		return s.RAM()
+	}),
+}
+
+var diffCommand = cli.Command{
+	Name:      "diff",
+	Usage:     "compare the states of two db directories",
+	ArgsUsage: "DB_A DB_B",
+	Action: func(c *cli.Context) error {
+		if c.NArg() != 2 {
+			return cli.ShowCommandHelp(c, c.Command.Name)
+		}
+		a, err := openState(c, c.Args().Get(0))
+		if err != nil {
+			return err
+		}
+		defer a.Close()
+		b, err := openState(c, c.Args().Get(1))
+		if err != nil {
+			return err
+		}
+		defer b.Close()
+		diff, err := a.Diff(b)
+		if err != nil {
+			return err
+		}
+		return istate.Write(os.Stdout, c.GlobalString("format"), diff)
+	},
+}
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "istate"
+	app.Usage = "The cli tool for inspecting the state db of a stopped iserver"
+	app.Version = "0.0.1"
+	app.Commands = []cli.Command{
+		contractsCommand,
+		storageCommand,
+		accountCommand,
+		balancesCommand,
+		ramCommand,
+		diffCommand,
+	}
+	app.Flags = []cli.Flag{
+		cli.StringFlag{
+			Name:  "db, d",
+			Value: "storage/StateDB",
+			Usage: "The state db `DIR`",
+		},
+		cli.StringFlag{
+			Name:  "storage, s",
+			Value: kv.LevelDBStorage.String(),
+			Usage: "The storage type of db, leveldb, lsm or memory",
+		},
+		cli.StringFlag{
+			Name:  "format, f",
+			Value: istate.TableFormat,
+			Usage: "The output format, table or json",
+		},
+	}
+	app.Before = func(c *cli.Context) error {
+		ilog.SetLevel(ilog.LevelError)
+		format := c.GlobalString("format")
+		if format != istate.TableFormat && format != istate.JSONFormat {
+			return fmt.Errorf("unknown format %v", format)
+		}
+		return nil
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		ilog.Fatalf("Run istate failed: %v", err)
+	}
+}
@@ -47,11 +47,30 @@ type GenesisConfig struct {
 	ContractPath     string
 	AdminInfo        *Witness
 	FoundationInfo   *Witness
+	Production       *ProductionConfig
 }
 
 // DBConfig config of the database
 type DBConfig struct {
-	LdbPath string
+	LdbPath        string
+	StorageType    string
+	CacheType      string
+	AccountTxIndex bool
+	Archive        bool
+	PruneBlocks    int64
+	PruneHeaders   int64
+	StateRoot      bool
+	WALCompression bool
+}
+
+// ConsensusConfig config of the consensus
+type ConsensusConfig struct {
+	// Type is pob or dev. The dev consensus seals the blocks of a single node with the
+	// account of ACC, without p2p.
+	Type string
+	// DevBlockInterval is the interval in milliseconds of sealing blocks in dev mode, 0 means
+	// sealing a block as soon as txs arrive.
+	DevBlockInterval int64
 }
 
 // VMConfig config of the v8vm
@@ -81,6 +100,25 @@ type RPCConfig struct {
 	GRPCAddr     string
 	AllowOrigins []string
 	TryTx        bool
+	JSONRPCAddr  string
+
+	// RateLimit is the requests per second allowed for each client ip, 0 means unlimited.
+	RateLimit float64
+	RateBurst int
+	// KeyRateLimit is the requests per second allowed for each api key, 0 means unlimited.
+	KeyRateLimit  float64
+	KeyRateBurst  int
+	APIKeys       []string
+	RequireAPIKey bool
+	// AllowMethods is the methods enabled if it's not empty, DenyMethods is the methods disabled.
+	AllowMethods []string
+	DenyMethods  []string
+
+	// TLSCert and TLSKey enable TLS on all the listeners. TLSClientCA enables mutual TLS,
+	// the client certificates are verified by it.
+	TLSCert     string
+	TLSKey      string
+	TLSClientCA string
 }
 
 // FileLogConfig is the config for filewriter of ilog.
@@ -126,16 +164,17 @@ type VersionConfig struct {
 
 // Config provide all configuration for the application
 type Config struct {
-	ACC     *ACCConfig
-	Genesis string
-	VM      *VMConfig
-	DB      *DBConfig
-	P2P     *P2PConfig
-	RPC     *RPCConfig
-	Log     *LogConfig
-	Metrics *MetricsConfig
-	Debug   *DebugConfig
-	Version *VersionConfig
+	ACC       *ACCConfig
+	Genesis   string
+	VM        *VMConfig
+	DB        *DBConfig
+	Consensus *ConsensusConfig
+	P2P       *P2PConfig
+	RPC       *RPCConfig
+	Log       *LogConfig
+	Metrics   *MetricsConfig
+	Debug     *DebugConfig
+	Version   *VersionConfig
 }
 
 // LoadYamlAsViper load yaml file as viper object
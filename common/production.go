@@ -0,0 +1,67 @@
+package common
+
+import (
+	"fmt"
+	"time"
+)
+
+// ProductionConfig is the block production parameters of a chain, which are in the genesis
+// config and must be the same on all the nodes of the chain. A witness produces
+// BlocksPerSlot blocks in its slot, one every BlockInterval.
+type ProductionConfig struct {
+	// BlockInterval is the interval of the blocks in a slot in milliseconds
+	BlockInterval int64
+	// BlocksPerSlot is the number of the blocks a witness produces in its slot
+	BlocksPerSlot int64
+	// GenBlockTime is the time limit of generating a block in milliseconds
+	GenBlockTime int64
+	// LastGenBlockTime is the time limit of generating the last 2 blocks of a slot in
+	// milliseconds, which leaves the time to broadcast them before the next slot
+	LastGenBlockTime int64
+}
+
+// DefaultProductionConfig returns the block production parameters of the chains whose
+// genesis config doesn't set them.
+func DefaultProductionConfig() *ProductionConfig {
+	return &ProductionConfig{
+		BlockInterval:    SlotLength * 1000 / 10,
+		BlocksPerSlot:    10,
+		GenBlockTime:     250,
+		LastGenBlockTime: 30,
+	}
+}
+
+// Validate checks that the blocks can be generated within the slot.
+func (p *ProductionConfig) Validate() error {
+	switch {
+	case p.BlockInterval <= 0:
+		return fmt.Errorf("block interval %vms should be positive", p.BlockInterval)
+	case p.BlocksPerSlot <= 0:
+		return fmt.Errorf("blocks per slot %v should be positive", p.BlocksPerSlot)
+	case p.GenBlockTime <= 0 || p.GenBlockTime >= p.BlockInterval:
+		return fmt.Errorf("gen block time %vms should be positive and less than the block interval %vms", p.GenBlockTime, p.BlockInterval)
+	case p.LastGenBlockTime <= 0 || p.LastGenBlockTime > p.GenBlockTime:
+		return fmt.Errorf("last gen block time %vms should be positive and not more than the gen block time %vms", p.LastGenBlockTime, p.GenBlockTime)
+	}
+	return nil
+}
+
+// BlockIntervalDuration returns the interval of the blocks in a slot.
+func (p *ProductionConfig) BlockIntervalDuration() time.Duration {
+	return time.Duration(p.BlockInterval) * time.Millisecond
+}
+
+// GenBlockDuration returns the time limit of generating a block.
+func (p *ProductionConfig) GenBlockDuration() time.Duration {
+	return time.Duration(p.GenBlockTime) * time.Millisecond
+}
+
+// LastGenBlockDuration returns the time limit of generating the last 2 blocks of a slot.
+func (p *ProductionConfig) LastGenBlockDuration() time.Duration {
+	return time.Duration(p.LastGenBlockTime) * time.Millisecond
+}
+
+// SlotLength returns the length of a slot.
+func (p *ProductionConfig) SlotLength() time.Duration {
+	return time.Duration(p.BlockInterval*p.BlocksPerSlot) * time.Millisecond
+}
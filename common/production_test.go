@@ -0,0 +1,27 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProductionConfig_Validate(t *testing.T) {
+	p := DefaultProductionConfig()
+	assert.Nil(t, p.Validate())
+	assert.Equal(t, SlotLength*time.Second, p.SlotLength())
+	assert.Equal(t, 300*time.Millisecond, p.BlockIntervalDuration())
+
+	p = &ProductionConfig{BlockInterval: 500, BlocksPerSlot: 2, GenBlockTime: 400, LastGenBlockTime: 100}
+	assert.Nil(t, p.Validate())
+	assert.Equal(t, time.Second, p.SlotLength())
+
+	p.GenBlockTime = 500
+	assert.NotNil(t, p.Validate())
+	p.GenBlockTime = 50
+	assert.NotNil(t, p.Validate())
+	p.GenBlockTime = 400
+	p.BlocksPerSlot = 0
+	assert.NotNil(t, p.Validate())
+}
@@ -0,0 +1,120 @@
+package common
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeCert creates a certificate signed by parent, and writes it to dir/name.crt and dir/name.key.
+func writeCert(t *testing.T, dir, name string, template, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.Nil(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.Nil(t, err)
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, name+".crt"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, name+".key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	cert, err := x509.ParseCertificate(der)
+	require.Nil(t, err)
+	return cert, key
+}
+
+// handshake returns the error of tls handshake between server and client, through which the client reads a byte.
+func handshake(t *testing.T, server, client *tls.Config) error {
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", server)
+	require.Nil(t, err)
+	defer lis.Close()
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			return
+		}
+		conn.Write([]byte{0})
+		conn.Close()
+	}()
+	conn, err := tls.Dial("tcp", lis.Addr().String(), client)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = conn.Read(make([]byte, 1))
+	return err
+}
+
+func TestTLSConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tls")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	now := time.Now()
+	ca, caKey := writeCert(t, dir, "ca", &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil, nil)
+	writeCert(t, dir, "server", &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "server"},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, ca, caKey)
+	writeCert(t, dir, "client", &x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "client"},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, ca, caKey)
+	file := func(name string) string {
+		return filepath.Join(dir, name)
+	}
+
+	server, err := NewServerTLSConfig(file("server.crt"), file("server.key"), "")
+	require.Nil(t, err)
+	client, err := NewClientTLSConfig(file("ca.crt"), "", "")
+	require.Nil(t, err)
+	client.ServerName = "localhost"
+	assert.Nil(t, handshake(t, server, client))
+
+	system, err := NewClientTLSConfig("", "", "")
+	require.Nil(t, err)
+	system.ServerName = "localhost"
+	assert.NotNil(t, handshake(t, server, system))
+
+	mutual, err := NewServerTLSConfig(file("server.crt"), file("server.key"), file("ca.crt"))
+	require.Nil(t, err)
+	assert.NotNil(t, handshake(t, mutual, client))
+	client, err = NewClientTLSConfig(file("ca.crt"), file("client.crt"), file("client.key"))
+	require.Nil(t, err)
+	client.ServerName = "localhost"
+	assert.Nil(t, handshake(t, mutual, client))
+
+	_, err = NewServerTLSConfig(file("server.crt"), file("client.key"), "")
+	assert.NotNil(t, err)
+	_, err = NewClientTLSConfig(file("server.key"), "", "")
+	assert.NotNil(t, err)
+}
@@ -1,7 +1,10 @@
 package consensus
 
 import (
+	"fmt"
+
 	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/consensus/dev"
 	"github.com/iost-official/go-iost/consensus/pob"
 	"github.com/iost-official/go-iost/core/blockcache"
 	"github.com/iost-official/go-iost/core/global"
@@ -16,8 +19,20 @@ type Type uint8
 const (
 	_ Type = iota
 	Pob
+	Dev
 )
 
+// ParseType returns the consensus type of name, an empty name means pob.
+func ParseType(name string) (Type, error) {
+	switch name {
+	case "", "pob":
+		return Pob, nil
+	case "dev":
+		return Dev, nil
+	}
+	return 0, fmt.Errorf("unknown consensus type %v", name)
+}
+
 // Consensus is a consensus server.
 type Consensus interface {
 	Start() error
@@ -29,6 +44,8 @@ func New(cType Type, account *account.KeyPair, baseVariable global.BaseVariable,
 	switch cType {
 	case Pob:
 		return pob.New(account, baseVariable, blkcache, txPool, service)
+	case Dev:
+		return dev.New(account, baseVariable, blkcache, txPool)
 	default:
 		return pob.New(account, baseVariable, blkcache, txPool, service)
 	}
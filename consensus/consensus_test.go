@@ -0,0 +1,17 @@
+package consensus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseType(t *testing.T) {
+	for name, expect := range map[string]Type{"": Pob, "pob": Pob, "dev": Dev} {
+		cType, err := ParseType(name)
+		assert.Nil(t, err)
+		assert.Equal(t, expect, cType)
+	}
+	_, err := ParseType("pow")
+	assert.NotNil(t, err)
+}
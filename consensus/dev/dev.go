@@ -0,0 +1,287 @@
+package dev
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/consensus/cverifier"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/core/global"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/core/txpool"
+	"github.com/iost-official/go-iost/db"
+	"github.com/iost-official/go-iost/ilog"
+	"github.com/iost-official/go-iost/metrics"
+	"github.com/iost-official/go-iost/verifier"
+)
+
+var (
+	metricsSealedBlockCount = metrics.NewCounter("iost_dev_sealed_block", nil)
+)
+
+var (
+	errDuplicate = errors.New("duplicate block")
+	errSingle    = errors.New("single block")
+)
+
+var (
+	// pollInterval is the interval of checking the pending txs
+	pollInterval = 10 * time.Millisecond
+	genBlockTime = 2 * time.Second
+)
+
+// Dev is the consensus of a single node dev chain. The blocks are sealed by the account as
+// soon as txs arrive, or every interval if it's set, and are irreversible once sealed.
+type Dev struct {
+	account      *account.KeyPair
+	baseVariable global.BaseVariable
+	blockCache   blockcache.BlockCache
+	txPool       txpool.TxPool
+	verifyDB     db.MVCCDB
+	produceDB    db.MVCCDB
+	interval     time.Duration
+	gen          func() (*block.Block, error) // generates a block on the head
+	exitSignal   chan struct{}
+	wg           *sync.WaitGroup
+	mu           *sync.Mutex
+}
+
+// New init a new Dev.
+func New(account *account.KeyPair, baseVariable global.BaseVariable, blockCache blockcache.BlockCache, txPool txpool.TxPool) *Dev {
+	d := &Dev{
+		account:      account,
+		baseVariable: baseVariable,
+		blockCache:   blockCache,
+		txPool:       txPool,
+		verifyDB:     baseVariable.StateDB(),
+		produceDB:    baseVariable.StateDB().Fork(),
+		exitSignal:   make(chan struct{}),
+		wg:           new(sync.WaitGroup),
+		mu:           new(sync.Mutex),
+	}
+	d.gen = d.generateBlock
+	if conf := baseVariable.Config().Consensus; conf != nil {
+		d.interval = time.Duration(conf.DevBlockInterval) * time.Millisecond
+	}
+	if err := blockCache.Recover(d); err != nil {
+		ilog.Error("Failed to recover blockCache, err: ", err)
+		if err := blockCache.NewWAL(baseVariable.Config()); err != nil {
+			ilog.Error("Failed to NewWAL, err: ", err)
+		}
+	}
+	return d
+}
+
+// Start make the Dev run.
+func (d *Dev) Start() error {
+	// there is nothing to sync from
+	d.baseVariable.SetMode(global.ModeNormal)
+	d.wg.Add(1)
+	go d.sealLoop()
+	ilog.Infof("Dev consensus started, witness: %v, interval: %v", d.account.ReadablePubkey(), d.interval)
+	return nil
+}
+
+// Stop make the Dev stop.
+func (d *Dev) Stop() {
+	close(d.exitSignal)
+	d.wg.Wait()
+}
+
+func (d *Dev) sealLoop() {
+	defer d.wg.Done()
+	tick := pollInterval
+	if d.interval > 0 {
+		tick = d.interval
+	}
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+	// the pending txs which are left after failing to seal a block or sealing one without
+	// them, they aren't sealed again until other txs arrive or their time comes
+	var left map[string]int64
+	for {
+		select {
+		case <-ticker.C:
+			pTx, head := d.txPool.PendingTx()
+			if head == nil {
+				// the txpool isn't ready
+				continue
+			}
+			if d.interval == 0 && !hasNewTx(pTx, left, time.Now().UnixNano()) {
+				continue
+			}
+			blk, err := d.seal()
+			if err != nil {
+				ilog.Errorf("[dev] seal block failed, err:%v", err)
+				left = leftTxs(pTx, 0)
+				continue
+			}
+			pTx, _ = d.txPool.PendingTx()
+			// the txs may be left by the limits of a block, which are sealed in the next one.
+			// the first tx of a block is the base tx.
+			left = nil
+			if len(blk.Txs) <= 1 {
+				left = leftTxs(pTx, blk.Head.Time)
+			}
+			ilog.Infof("Seal block - num:%v, txs:%v, pendingtxs:%v, hash:%v",
+				blk.Head.Number, len(blk.Txs), pTx.Size(), common.Base58Encode(blk.HeadHash()))
+		case <-d.exitSignal:
+			return
+		}
+	}
+}
+
+// leftTxs returns the pending txs which aren't sealed in the block of the time, with the
+// time to retry them. The txs created after the block are retried at their time, the
+// others aren't retried.
+func leftTxs(pTx *txpool.SortedTxMap, blockTime int64) map[string]int64 {
+	left := make(map[string]int64, pTx.Size())
+	iter := pTx.Iter()
+	for t, ok := iter.Next(); ok; t, ok = iter.Next() {
+		var retry int64
+		if blockTime > 0 && t.Time > blockTime {
+			retry = t.Time
+		}
+		left[string(t.Hash())] = retry
+	}
+	return left
+}
+
+// hasNewTx returns whether there is a pending tx which isn't left or should be retried at
+// now, all the pending txs are new if left is nil.
+func hasNewTx(pTx *txpool.SortedTxMap, left map[string]int64, now int64) bool {
+	if pTx.Size() == 0 {
+		return false
+	}
+	if left == nil {
+		return true
+	}
+	iter := pTx.Iter()
+	for t, ok := iter.Next(); ok; t, ok = iter.Next() {
+		retry, found := left[string(t.Hash())]
+		if !found || (retry > 0 && now >= retry) {
+			return true
+		}
+	}
+	return false
+}
+
+// seal generates a block on the head, and makes it irreversible.
+func (d *Dev) seal() (*block.Block, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.txPool.Lock()
+	blk, err := d.gen()
+	d.txPool.Release()
+	if err != nil {
+		return nil, err
+	}
+	node := d.blockCache.Add(blk)
+	d.confirm(node)
+	metricsSealedBlockCount.Add(1, nil)
+	return blk, nil
+}
+
+func (d *Dev) generateBlock() (*block.Block, error) {
+	pTx, head := d.txPool.PendingTx()
+	topBlock := head.Block
+	t := time.Now().UnixNano()
+	if t <= topBlock.Head.Time {
+		t = topBlock.Head.Time + 1
+	}
+	blk := &block.Block{
+		Head: &block.BlockHead{
+			Version:    0,
+			ParentHash: topBlock.HeadHash(),
+			Info:       make([]byte, 0),
+			Number:     topBlock.Head.Number + 1,
+			Witness:    d.account.ReadablePubkey(),
+			Time:       t,
+		},
+		Txs:      []*tx.Tx{},
+		Receipts: []*tx.TxReceipt{},
+	}
+	d.produceDB.Checkout(string(topBlock.HeadHash()))
+
+	v := verifier.Verifier{}
+	dropList, _, err := v.Gen(blk, topBlock, d.produceDB, pTx, &verifier.Config{
+		Mode:        0,
+		Timeout:     genBlockTime,
+		TxTimeLimit: common.MaxTxTimeLimit,
+	})
+	if len(dropList) > 0 {
+		go d.txPool.DelTxList(dropList)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("gen block failed: %v", err)
+	}
+	blk.Head.TxMerkleHash = blk.CalculateTxMerkleHash()
+	blk.Head.TxReceiptMerkleHash = blk.CalculateTxReceiptMerkleHash()
+	if err := blk.CalculateHeadHash(); err != nil {
+		return nil, err
+	}
+	blk.Sign = d.account.Sign(blk.HeadHash())
+	d.produceDB.Tag(string(blk.HeadHash()))
+	if root, err := d.produceDB.StateRoot(string(blk.HeadHash())); err == nil {
+		blk.Head.StateRoot = root
+	}
+	return blk, nil
+}
+
+// confirm links the node and flushes it, as the blocks of the single node are irreversible.
+func (d *Dev) confirm(node *blockcache.BlockCacheNode) {
+	d.txPool.AddLinkedNode(node)
+	d.blockCache.Link(node)
+	d.blockCache.Flush(node)
+}
+
+// RecoverBlock recover block from block cache wal
+func (d *Dev) RecoverBlock(blk *block.Block, witnessList blockcache.WitnessList) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, err := d.blockCache.Find(blk.HeadHash()); err == nil {
+		return errDuplicate
+	}
+	parent, err := d.blockCache.Find(blk.Head.ParentHash)
+	if err != nil || parent.Type != blockcache.Linked {
+		return errSingle
+	}
+	hash, err := blk.Head.Hash()
+	if err != nil {
+		return err
+	}
+	blk.Sign.SetPubkey(account.DecodePubkey(blk.Head.Witness))
+	if !blk.Sign.Verify(hash) {
+		return fmt.Errorf("wrong signature of block %v", blk.Head.Number)
+	}
+	node := d.blockCache.AddWithWit(blk, witnessList)
+	if !d.verifyDB.Checkout(string(blk.HeadHash())) {
+		d.verifyDB.Checkout(string(blk.Head.ParentHash))
+		if err := d.verifyBlock(blk, parent.Block); err != nil {
+			d.blockCache.Del(node)
+			return err
+		}
+		d.verifyDB.Tag(string(blk.HeadHash()))
+	}
+	d.confirm(node)
+	return nil
+}
+
+func (d *Dev) verifyBlock(blk *block.Block, parent *block.Block) error {
+	if err := cverifier.VerifyBlockHead(blk, parent, d.blockCache.LinkedRoot().Block); err != nil {
+		return err
+	}
+	v := verifier.Verifier{}
+	return v.Verify(blk, parent, d.verifyDB, &verifier.Config{
+		Mode:        0,
+		Timeout:     genBlockTime,
+		TxTimeLimit: common.MaxTxTimeLimit,
+	})
+}
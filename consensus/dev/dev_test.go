@@ -0,0 +1,195 @@
+package dev
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/core/global"
+	"github.com/iost-official/go-iost/core/mocks"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/core/txpool"
+	"github.com/iost-official/go-iost/core/txpool/mock"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testBlockCache is a block cache of the linked chain only, which records the flushed blocks.
+type testBlockCache struct {
+	blockcache.BlockCache
+	mu      sync.Mutex
+	head    *blockcache.BlockCacheNode
+	flushed []*block.Block
+}
+
+func (bc *testBlockCache) Add(blk *block.Block) *blockcache.BlockCacheNode {
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+	return blockcache.NewBCN(bc.head, blk)
+}
+
+func (bc *testBlockCache) Link(node *blockcache.BlockCacheNode) {
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+	bc.head = node
+}
+
+func (bc *testBlockCache) Flush(node *blockcache.BlockCacheNode) {
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+	bc.flushed = append(bc.flushed, node.Block)
+}
+
+func (bc *testBlockCache) Head() *blockcache.BlockCacheNode {
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+	return bc.head
+}
+
+func (bc *testBlockCache) flushedBlocks() []*block.Block {
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+	return append([]*block.Block{}, bc.flushed...)
+}
+
+// newTestDev returns a Dev whose blocks pack at most one pending tx, and the pending txs
+// of the pool.
+func newTestDev(t *testing.T, ctl *gomock.Controller, interval time.Duration) (*Dev, *testBlockCache, *txpool.SortedTxMap) {
+	acc, err := account.NewKeyPair(nil, crypto.Ed25519)
+	require.Nil(t, err)
+	genesis := &block.Block{Head: &block.BlockHead{Number: 0}}
+	genesis.CalculateHeadHash()
+	bc := &testBlockCache{head: blockcache.NewBCN(nil, genesis)}
+	pending := txpool.NewSortedTxMap()
+
+	bv := core_mock.NewMockBaseVariable(ctl)
+	bv.EXPECT().SetMode(global.ModeNormal).AnyTimes()
+	pool := txpool_mock.NewMockTxPool(ctl)
+	pool.EXPECT().Lock().AnyTimes()
+	pool.EXPECT().Release().AnyTimes()
+	pool.EXPECT().PendingTx().AnyTimes().DoAndReturn(func() (*txpool.SortedTxMap, *blockcache.BlockCacheNode) {
+		return pending, bc.Head()
+	})
+	// the packed txs are removed from the pending txs
+	pool.EXPECT().AddLinkedNode(gomock.Any()).AnyTimes().DoAndReturn(func(node *blockcache.BlockCacheNode) error {
+		for _, t := range node.Txs {
+			pending.Del(t.Hash())
+		}
+		return nil
+	})
+
+	d := &Dev{
+		account:      acc,
+		baseVariable: bv,
+		blockCache:   bc,
+		txPool:       pool,
+		interval:     interval,
+		exitSignal:   make(chan struct{}),
+		wg:           new(sync.WaitGroup),
+		mu:           new(sync.Mutex),
+	}
+	d.gen = func() (*block.Block, error) {
+		pTx, head := d.txPool.PendingTx()
+		blk := &block.Block{
+			Head: &block.BlockHead{
+				ParentHash: head.HeadHash(),
+				Number:     head.Head.Number + 1,
+				Witness:    acc.ReadablePubkey(),
+				Time:       time.Now().UnixNano(),
+			},
+			Txs: []*tx.Tx{{Publisher: "base.iost"}},
+		}
+		if t, ok := pTx.Iter().Next(); ok {
+			blk.Txs = append(blk.Txs, t)
+		}
+		blk.CalculateHeadHash()
+		return blk, nil
+	}
+	return d, bc, pending
+}
+
+// waitBlocks waits until n blocks are flushed, and returns them.
+func waitBlocks(t *testing.T, bc *testBlockCache, n int) []*block.Block {
+	for i := 0; i < 100; i++ {
+		if blocks := bc.flushedBlocks(); len(blocks) >= n {
+			return blocks
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("%v blocks aren't sealed, got %v", n, len(bc.flushedBlocks()))
+	return nil
+}
+
+func TestDevSealOnTx(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	d, bc, pending := newTestDev(t, ctl, 0)
+	require.Nil(t, d.Start())
+	defer d.Stop()
+
+	// no block is sealed without txs
+	time.Sleep(5 * pollInterval)
+	assert.Empty(t, bc.flushedBlocks())
+
+	// the tx left by the first block is sealed in the next one without other txs
+	t1 := tx.NewTx(nil, nil, 100000, 100, time.Now().Add(time.Minute).UnixNano(), 0, 0)
+	t2 := tx.NewTx(nil, nil, 100000, 101, time.Now().Add(time.Minute).UnixNano(), 0, 0)
+	pending.Add(t1)
+	pending.Add(t2)
+	blocks := waitBlocks(t, bc, 2)
+	assert.Equal(t, int64(1), blocks[0].Head.Number)
+	assert.Equal(t, int64(2), blocks[1].Head.Number)
+	assert.Len(t, blocks[0].Txs, 2)
+	assert.Len(t, blocks[1].Txs, 2)
+	assert.Equal(t, blocks[1].Head.ParentHash, blocks[0].HeadHash())
+	assert.Equal(t, blocks[1].HeadHash(), bc.Head().HeadHash())
+
+	// every sealed block is flushed once
+	time.Sleep(5 * pollInterval)
+	assert.Len(t, bc.flushedBlocks(), 2)
+	assert.Equal(t, 0, pending.Size())
+}
+
+func TestDevSealOnInterval(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	d, bc, _ := newTestDev(t, ctl, 20*time.Millisecond)
+	require.Nil(t, d.Start())
+	defer d.Stop()
+
+	// the blocks are sealed without txs
+	blocks := waitBlocks(t, bc, 3)
+	for i, blk := range blocks[:3] {
+		assert.Equal(t, int64(i+1), blk.Head.Number)
+		assert.Len(t, blk.Txs, 1)
+	}
+}
+
+func TestHasNewTx(t *testing.T) {
+	now := time.Now().UnixNano()
+	pending := txpool.NewSortedTxMap()
+	assert.False(t, hasNewTx(pending, nil, now))
+	early := tx.NewTx(nil, nil, 100000, 100, now+int64(time.Minute), 0, 0)
+	early.Time = now - 1
+	pending.Add(early)
+	assert.True(t, hasNewTx(pending, nil, now))
+
+	// the tx created after the block is retried at its time
+	late := tx.NewTx(nil, nil, 100000, 101, now+int64(time.Minute), 0, 0)
+	late.Time = now + 10
+	pending.Add(late)
+	left := leftTxs(pending, now)
+	assert.False(t, hasNewTx(pending, left, now))
+	assert.True(t, hasNewTx(pending, left, now+10))
+
+	// the txs left by a failure aren't retried until other txs arrive
+	left = leftTxs(pending, 0)
+	assert.False(t, hasNewTx(pending, left, now+10))
+	pending.Add(tx.NewTx(nil, nil, 100000, 102, now+int64(time.Minute), 0, 0))
+	assert.True(t, hasNewTx(pending, left, now+10))
+}
@@ -23,13 +23,54 @@ var GenesisTxExecTime = 3 * time.Second
 
 // GenGenesisByFile is create a genesis block by config file
 func GenGenesisByFile(db db.MVCCDB, path string) (*block.Block, error) {
+	return GenGenesis(db, loadGenesisConfig(path))
+}
+
+func loadGenesisConfig(path string) *common.GenesisConfig {
 	v := common.LoadYamlAsViper(filepath.Join(path, "genesis.yml"))
 	genesisConfig := &common.GenesisConfig{}
 	if err := v.Unmarshal(genesisConfig); err != nil {
 		ilog.Fatalf("Unable to decode into struct, %v", err)
 	}
 	genesisConfig.ContractPath = filepath.Join(path, "contract")
-	return GenGenesis(db, genesisConfig)
+	return genesisConfig
+}
+
+// ProductionConfigByFile returns the block production parameters of the chain, which are in
+// the genesis config file and the info of the genesis block. They must be the same if the
+// genesis block is in the chain, which isn't if it's pruned or imported from a snapshot.
+func ProductionConfigByFile(chain block.Chain, path string) (*common.ProductionConfig, error) {
+	conf := loadGenesisConfig(path).Production
+	if conf == nil {
+		conf = common.DefaultProductionConfig()
+	}
+	if err := conf.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid production config: %v", err)
+	}
+	blk, err := chain.GetBlockByNumber(0)
+	if err != nil {
+		return conf, nil
+	}
+	chainConf, err := productionConfig(blk)
+	if err != nil {
+		return nil, err
+	}
+	if *chainConf != *conf {
+		return nil, fmt.Errorf("the production config %+v differs from %+v in the genesis block", *conf, *chainConf)
+	}
+	return conf, nil
+}
+
+// productionConfig returns the block production parameters in the info of the genesis block.
+func productionConfig(blk *block.Block) (*common.ProductionConfig, error) {
+	if len(blk.Head.Info) == 0 {
+		return common.DefaultProductionConfig(), nil
+	}
+	conf := &common.ProductionConfig{}
+	if err := json.Unmarshal(blk.Head.Info, conf); err != nil {
+		return nil, fmt.Errorf("invalid production config in the genesis block: %v", err)
+	}
+	return conf, nil
 }
 
 func compile(id string, path string, name string) (*contract.Contract, error) {
@@ -179,6 +220,19 @@ func GenGenesis(db db.MVCCDB, gConf *common.GenesisConfig) (*block.Block, error)
 		ilog.Fatalf("invalid genesis initial time string %v (%v).", gConf.InitialTimestamp, err)
 		return nil, err
 	}
+	// the production config is in the info of the genesis block, so that the chains of
+	// different configs have different genesis blocks. It's empty for the default config.
+	var info []byte
+	if gConf.Production != nil {
+		if err := gConf.Production.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid production config: %v", err)
+		}
+		if *gConf.Production != *common.DefaultProductionConfig() {
+			if info, err = json.Marshal(gConf.Production); err != nil {
+				return nil, err
+			}
+		}
+	}
 	trx, acc, err := genGenesisTx(gConf)
 	if err != nil {
 		return nil, err
@@ -187,6 +241,7 @@ func GenGenesis(db db.MVCCDB, gConf *common.GenesisConfig) (*block.Block, error)
 	blockHead := block.BlockHead{
 		Version:    0,
 		ParentHash: nil,
+		Info:       info,
 		Number:     0,
 		Witness:    acc.ID,
 		Time:       t.UnixNano(),
@@ -1,15 +1,24 @@
 package genesis
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/iost-official/go-iost/account"
 	"github.com/iost-official/go-iost/crypto"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/mocks"
 	"github.com/iost-official/go-iost/db"
 	"github.com/iost-official/go-iost/ilog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func randWitness(idx int) *common.Witness {
@@ -56,3 +65,76 @@ func TestGenGenesis(t *testing.T) {
 	fmt.Println(blk)
 	return
 }
+
+func TestGenGenesisInvalidProduction(t *testing.T) {
+	ilog.Stop()
+
+	d, err := db.NewMVCCDB("mvcc")
+	require.Nil(t, err)
+	defer func() {
+		d.Close()
+		os.RemoveAll("mvcc")
+	}()
+	_, err = GenGenesis(d, &common.GenesisConfig{
+		InitialTimestamp: "2006-01-02T15:04:05Z",
+		Production:       &common.ProductionConfig{BlockInterval: 500, BlocksPerSlot: 2, GenBlockTime: 500, LastGenBlockTime: 100},
+	})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "invalid production config")
+}
+
+func TestProductionConfigByFile(t *testing.T) {
+	ilog.Stop()
+
+	dir, err := ioutil.TempDir("", "genesis")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	writeConfig := func(production string) {
+		conf := "initialtimestamp: \"2006-01-02T15:04:05Z\"\n" + production
+		require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "genesis.yml"), []byte(conf), 0644))
+	}
+	custom := &common.ProductionConfig{BlockInterval: 500, BlocksPerSlot: 2, GenBlockTime: 400, LastGenBlockTime: 100}
+	info, err := json.Marshal(custom)
+	require.Nil(t, err)
+
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	chain := core_mock.NewMockChain(ctl)
+	genesis := &block.Block{Head: &block.BlockHead{Number: 0}}
+	chain.EXPECT().GetBlockByNumber(int64(0)).AnyTimes().DoAndReturn(func(int64) (*block.Block, error) {
+		if genesis == nil {
+			return nil, errors.New("not found")
+		}
+		return genesis, nil
+	})
+
+	// the default config matches the genesis block without info
+	writeConfig("")
+	conf, err := ProductionConfigByFile(chain, dir)
+	require.Nil(t, err)
+	assert.Equal(t, common.DefaultProductionConfig(), conf)
+
+	// the config differing from the genesis block is rejected
+	writeConfig("production:\n  blockinterval: 500\n  blocksperslot: 2\n  genblocktime: 400\n  lastgenblocktime: 100\n")
+	_, err = ProductionConfigByFile(chain, dir)
+	assert.NotNil(t, err)
+	genesis.Head.Info = info
+	conf, err = ProductionConfigByFile(chain, dir)
+	require.Nil(t, err)
+	assert.Equal(t, custom, conf)
+	writeConfig("")
+	_, err = ProductionConfigByFile(chain, dir)
+	assert.NotNil(t, err)
+
+	// the config in the file is used without the genesis block
+	genesis = nil
+	writeConfig("production:\n  blockinterval: 500\n  blocksperslot: 2\n  genblocktime: 400\n  lastgenblocktime: 100\n")
+	conf, err = ProductionConfigByFile(chain, dir)
+	require.Nil(t, err)
+	assert.Equal(t, custom, conf)
+
+	// the invalid config is rejected
+	writeConfig("production:\n  blockinterval: 500\n  blocksperslot: 2\n  genblocktime: 500\n  lastgenblocktime: 100\n")
+	_, err = ProductionConfigByFile(chain, dir)
+	assert.NotNil(t, err)
+}
@@ -72,11 +72,25 @@ func generateBlock(acc *account.KeyPair, txPool txpool.TxPool, db db.MVCCDB, lim
 	}
 	blk.Sign = acc.Sign(blk.HeadHash())
 	db.Tag(string(blk.HeadHash()))
+	// the state root isn't covered by the hash and signature of the block yet
+	blk.Head.StateRoot = stateRoot(db, &blk)
 	metricsGeneratedBlockCount.Add(1, nil)
 	generateTxsNum += len(blk.Txs)
 	return &blk, nil
 }
 
+// stateRoot returns the state root after the tagged block, or nil if it's disabled.
+func stateRoot(stateDB db.MVCCDB, blk *block.Block) []byte {
+	root, err := stateDB.StateRoot(string(blk.HeadHash()))
+	if err != nil {
+		if err != db.ErrStateRootDisabled {
+			ilog.Warnf("get state root of block %v failed: %v", blk.Head.Number, err)
+		}
+		return nil
+	}
+	return root
+}
+
 func verifyBasics(head *block.BlockHead, signature *crypto.Signature) error {
 
 	signature.SetPubkey(account.DecodePubkey(head.Witness))
@@ -139,12 +153,14 @@ func verifyBlock(blk *block.Block, parent *block.Block, lib *block.Block, txPool
 	v := verifier.Verifier{}
 	return v.Verify(blk, parent, db, &verifier.Config{
 		Mode:        0,
-		Timeout:     time.Millisecond * 250,
+		Timeout:     genBlockTime,
 		TxTimeLimit: time.Millisecond * 100,
 	})
 }
 
 func updateWaterMark(node *blockcache.BlockCacheNode) {
+	staticProperty.mu.Lock()
+	defer staticProperty.mu.Unlock()
 	node.ConfirmUntil = staticProperty.Watermark[node.Head.Witness]
 	if node.Head.Number >= staticProperty.Watermark[node.Head.Witness] {
 		staticProperty.Watermark[node.Head.Witness] = node.Head.Number + 1
@@ -161,19 +177,32 @@ func updateLib(node *blockcache.BlockCacheNode, bc blockcache.BlockCache) {
 
 func calculateConfirm(node *blockcache.BlockCacheNode, root *blockcache.BlockCacheNode) *blockcache.BlockCacheNode {
 	confirmLimit := staticProperty.NumberOfWitnesses*2/3 + 1
+	var confirmed *blockcache.BlockCacheNode
+	walkConfirm(node, root, func(node *blockcache.BlockCacheNode, confirmNum int64) bool {
+		if confirmNum >= confirmLimit {
+			confirmed = node
+			return false
+		}
+		return true
+	})
+	return confirmed
+}
+
+// walkConfirm calls fn with the nodes from node to the child of root, and the number of the
+// blocks confirming each of them, until fn returns false.
+func walkConfirm(node *blockcache.BlockCacheNode, root *blockcache.BlockCacheNode, fn func(*blockcache.BlockCacheNode, int64) bool) {
 	startNumber := node.Head.Number
 	var confirmNum int64
 	confirmUntilMap := make(map[int64]int64, startNumber-root.Head.Number)
-	for node != root {
+	for node != root && node != nil {
 		if node.ConfirmUntil <= node.Head.Number {
 			confirmNum++
 			confirmUntilMap[node.ConfirmUntil]++
 		}
-		if confirmNum >= confirmLimit {
-			return node
+		if !fn(node, confirmNum) {
+			return
 		}
 		confirmNum -= confirmUntilMap[node.Head.Number]
 		node = node.GetParent()
 	}
-	return nil
 }
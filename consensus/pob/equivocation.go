@@ -0,0 +1,132 @@
+package pob
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/event"
+	"github.com/iost-official/go-iost/ilog"
+	"github.com/iost-official/go-iost/metrics"
+)
+
+var metricsEquivocation = metrics.NewCounter("iost_pob_equivocation", []string{"witness"})
+
+// maxEquivocationAhead is the max number of blocks after the head of the blocks checked for
+// equivocation, which limits the blocks kept until they're irreversible.
+const maxEquivocationAhead int64 = 1000
+
+// checkEquivocation reports the equivocation if the witness of the block has signed a
+// different block of the same number, or a block of the same slot on another fork. The
+// signature of the block must have been verified. Only the blocks of the scheduled witnesses
+// are checked, as anyone can sign blocks with a new key.
+func (p *PoB) checkEquivocation(blk *block.Block) {
+	if !p.scheduled(blk) || blk.Head.Number > p.blockCache.Head().Head.Number+maxEquivocationAhead {
+		return
+	}
+	if e := p.findEquivocation(blk); e != nil {
+		p.reportEquivocation(e)
+	}
+	p.recordBlock(blk)
+}
+
+// scheduled returns whether the witness of the block owns the slot of the block in the
+// active or pending witness list.
+func (p *PoB) scheduled(blk *block.Block) bool {
+	if staticProperty.NumberOfWitnesses > 0 && witnessOfNanoSec(blk.Head.Time) == blk.Head.Witness {
+		return true
+	}
+	pending := p.blockCache.Head().Pending()
+	if len(pending) == 0 {
+		return false
+	}
+	return pending[slotOfNanoSec(blk.Head.Time)%int64(len(pending))] == blk.Head.Witness
+}
+
+func (p *PoB) findEquivocation(blk *block.Block) *block.Evidence {
+	hash := blk.HeadHash()
+	// the evidence of the same number is preferred, which is verifiable without the chain
+	var sameSlot *block.Block
+	for _, other := range p.witnessBlocks[blk.Head.Witness] {
+		if bytes.Equal(other.HeadHash(), hash) {
+			continue
+		}
+		if other.Head.Number == blk.Head.Number {
+			return block.NewEvidence(other, blk, time.Now().UnixNano())
+		}
+		if sameSlot == nil && slotOfNanoSec(other.Head.Time) == slotOfNanoSec(blk.Head.Time) && p.forked(other, blk) {
+			sameSlot = other
+		}
+	}
+	if sameSlot != nil {
+		return block.NewEvidence(sameSlot, blk, time.Now().UnixNano())
+	}
+	// the block of the same number may be irreversible already
+	if blk.Head.Number <= p.blockCache.LinkedRoot().Head.Number {
+		other, err := p.blockChain.GetBlockByNumber(blk.Head.Number)
+		if err == nil && other.Head.Witness == blk.Head.Witness && !bytes.Equal(other.HeadHash(), hash) {
+			return block.NewEvidence(other, blk, time.Now().UnixNano())
+		}
+	}
+	return nil
+}
+
+// forked returns whether the blocks of different numbers are on different forks. It's
+// false if it can't be told by the block cache.
+func (p *PoB) forked(a *block.Block, b *block.Block) bool {
+	if a.Head.Number > b.Head.Number {
+		a, b = b, a
+	}
+	node, err := p.blockCache.Find(b.Head.ParentHash)
+	if err != nil {
+		return false
+	}
+	for node != nil && node.Head.Number > a.Head.Number {
+		node = node.GetParent()
+	}
+	if node == nil || node.Head.Number != a.Head.Number {
+		return false
+	}
+	return !bytes.Equal(node.HeadHash(), a.HeadHash())
+}
+
+// recordBlock keeps the block until it's irreversible, to check the following blocks of
+// the witness against it.
+func (p *PoB) recordBlock(blk *block.Block) {
+	root := p.blockCache.LinkedRoot().Head.Number
+	for witness, blocks := range p.witnessBlocks {
+		kept := blocks[:0]
+		for _, b := range blocks {
+			if b.Head.Number > root {
+				kept = append(kept, b)
+			}
+		}
+		if len(kept) == 0 {
+			delete(p.witnessBlocks, witness)
+		} else {
+			p.witnessBlocks[witness] = kept
+		}
+	}
+	if blk.Head.Number > root {
+		p.witnessBlocks[blk.Head.Witness] = append(p.witnessBlocks[blk.Head.Witness], blk)
+	}
+}
+
+// reportEquivocation saves the evidence, and posts it to the metrics and the subscribers
+// if it's new.
+func (p *PoB) reportEquivocation(e *block.Evidence) {
+	ok, err := p.blockChain.PutEvidence(e)
+	if err != nil {
+		ilog.Errorf("save evidence of equivocation failed, witness:%v, err:%v", e.Witness(), err)
+		return
+	}
+	if !ok {
+		return
+	}
+	first, second := e.First.Block(), e.Second.Block()
+	ilog.Warnf("equivocation of witness %v, reason:%v, blocks:%v %v, hashes:%v %v", e.Witness(), e.Reason(), first.Head.Number, second.Head.Number,
+		common.Base58Encode(first.HeadHash()), common.Base58Encode(second.HeadHash()))
+	metricsEquivocation.Add(1, map[string]string{"witness": e.Witness()})
+	event.GetCollector().PostEquivocation(e)
+}
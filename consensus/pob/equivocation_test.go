@@ -0,0 +1,145 @@
+package pob
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testBlockCache is a block cache of linked nodes only.
+type testBlockCache struct {
+	blockcache.BlockCache
+	root  *blockcache.BlockCacheNode
+	nodes map[string]*blockcache.BlockCacheNode
+}
+
+func (bc *testBlockCache) Find(hash []byte) (*blockcache.BlockCacheNode, error) {
+	if node, ok := bc.nodes[string(hash)]; ok {
+		return node, nil
+	}
+	return nil, errors.New("block not found")
+}
+
+func (bc *testBlockCache) LinkedRoot() *blockcache.BlockCacheNode {
+	return bc.root
+}
+
+func (bc *testBlockCache) Head() *blockcache.BlockCacheNode {
+	return bc.root
+}
+
+func (bc *testBlockCache) add(parent *blockcache.BlockCacheNode, blk *block.Block) *blockcache.BlockCacheNode {
+	node := blockcache.NewBCN(parent, blk)
+	bc.nodes[string(blk.HeadHash())] = node
+	return node
+}
+
+func signedBlock(acc *account.KeyPair, parent *block.Block, slot int64, info string) *block.Block {
+	blk := &block.Block{
+		Head: &block.BlockHead{
+			ParentHash: parent.HeadHash(),
+			Info:       []byte(info),
+			Number:     parent.Head.Number + 1,
+			Witness:    acc.ReadablePubkey(),
+			Time:       slot * 3 * 1e9,
+		},
+	}
+	blk.CalculateHeadHash()
+	blk.Sign = acc.Sign(blk.HeadHash())
+	return blk
+}
+
+func TestEquivocation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "equivocation")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	chain, err := block.NewBlockChain(dir)
+	require.Nil(t, err)
+	defer chain.Close()
+
+	w0, _ := account.NewKeyPair(nil, crypto.Ed25519)
+	w1, _ := account.NewKeyPair(nil, crypto.Ed25519)
+	root := &block.Block{Head: &block.BlockHead{Number: 3}}
+	root.CalculateHeadHash()
+	bc := &testBlockCache{nodes: make(map[string]*blockcache.BlockCacheNode)}
+	bc.root = bc.add(nil, root)
+	// w0 owns the even slots and w1 owns the odd ones
+	staticProperty = newStaticProperty(w0, []string{w0.ReadablePubkey(), w1.ReadablePubkey()})
+	p := &PoB{
+		blockChain:    chain,
+		blockCache:    bc,
+		witnessBlocks: make(map[string][]*block.Block),
+	}
+
+	// the blocks of w0 in slot 10 on the same chain
+	a4 := signedBlock(w0, root, 10, "a")
+	a4Node := bc.add(bc.root, a4)
+	a5 := signedBlock(w0, a4, 10, "a")
+	bc.add(a4Node, a5)
+	p.checkEquivocation(a4)
+	p.checkEquivocation(a5)
+	evidences, err := chain.GetEvidences("", 10)
+	require.Nil(t, err)
+	assert.Empty(t, evidences)
+
+	// w0 signs another block 5 on the fork of w1 in the same slot
+	x4 := signedBlock(w1, root, 9, "x")
+	x4Node := bc.add(bc.root, x4)
+	b5 := signedBlock(w0, x4, 10, "b")
+	bc.add(x4Node, b5)
+	p.checkEquivocation(x4)
+	p.checkEquivocation(b5)
+	evidences, err = chain.GetEvidences(w0.ReadablePubkey(), 10)
+	require.Nil(t, err)
+	require.Len(t, evidences, 1)
+	assert.Nil(t, evidences[0].Verify(time.Duration(slotLength)))
+	assert.Equal(t, "same number", evidences[0].Reason())
+
+	// w0 signs block 6 on the fork in the same slot as block 4
+	b6 := signedBlock(w0, b5, 10, "b")
+	p.checkEquivocation(b6)
+	evidences, err = chain.GetEvidences(w0.ReadablePubkey(), 10)
+	require.Nil(t, err)
+	// the evidences are ordered by the first block number
+	require.Len(t, evidences, 2)
+	assert.Nil(t, evidences[0].Verify(time.Duration(slotLength)))
+	assert.Equal(t, "same slot", evidences[0].Reason())
+	assert.Equal(t, int64(4), evidences[0].First.Head.Number)
+	assert.Equal(t, int64(6), evidences[0].Second.Head.Number)
+
+	// the evidence is saved once
+	p.checkEquivocation(b5)
+	evidences, err = chain.GetEvidences("", 10)
+	require.Nil(t, err)
+	assert.Len(t, evidences, 2)
+	evidences, err = chain.GetEvidences(w1.ReadablePubkey(), 10)
+	require.Nil(t, err)
+	assert.Empty(t, evidences)
+
+	// the blocks of the witnesses not owning the slots or far ahead of the head are ignored
+	stranger, _ := account.NewKeyPair(nil, crypto.Ed25519)
+	p.checkEquivocation(signedBlock(stranger, root, 10, "s"))
+	p.checkEquivocation(signedBlock(stranger, root, 10, "t"))
+	p.checkEquivocation(signedBlock(w1, root, 10, "w"))
+	p.checkEquivocation(signedBlock(w1, &block.Block{Head: &block.BlockHead{Number: 3 + maxEquivocationAhead}}, 11, "w"))
+	evidences, err = chain.GetEvidences("", 10)
+	require.Nil(t, err)
+	assert.Len(t, evidences, 2)
+	assert.Empty(t, p.witnessBlocks[stranger.ReadablePubkey()])
+	assert.Len(t, p.witnessBlocks[w1.ReadablePubkey()], 1)
+
+	// the blocks are dropped after they're irreversible
+	bc.root = bc.nodes[string(a5.HeadHash())]
+	p.recordBlock(signedBlock(w1, a5, 11, "c"))
+	assert.Equal(t, []*block.Block{b6}, p.witnessBlocks[w0.ReadablePubkey()])
+	assert.Len(t, p.witnessBlocks[w1.ReadablePubkey()], 1)
+}
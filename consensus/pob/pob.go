@@ -1,6 +1,7 @@
 package pob
 
 import (
+	"bytes"
 	"errors"
 	"sync"
 	"time"
@@ -29,6 +30,7 @@ var (
 	metricsTransferCost          = metrics.NewGauge("iost_transfer_cost", nil)
 	metricsGenerateBlockTimeCost = metrics.NewGauge("iost_generate_block_time_cost", nil)
 	metricsDelayedBlock          = metrics.NewCounter("iost_delayed_block", nil)
+	metricsStateRootMismatch     = metrics.NewCounter("iost_pob_state_root_mismatch", nil)
 )
 
 var (
@@ -62,6 +64,7 @@ type PoB struct {
 	verifyDB         db.MVCCDB
 	produceDB        db.MVCCDB
 	blockReqMap      *sync.Map
+	witnessBlocks    map[string][]*block.Block // the unconfirmed blocks of each witness
 	exitSignal       chan struct{}
 	quitGenerateMode chan struct{}
 	chRecvBlock      chan p2p.IncomingMessage
@@ -84,6 +87,7 @@ func New(account *account.KeyPair, baseVariable global.BaseVariable, blockCache
 		verifyDB:         baseVariable.StateDB(),
 		produceDB:        baseVariable.StateDB().Fork(),
 		blockReqMap:      new(sync.Map),
+		witnessBlocks:    make(map[string][]*block.Block),
 		exitSignal:       make(chan struct{}),
 		quitGenerateMode: make(chan struct{}),
 		chRecvBlock:      p2pService.Register("consensus channel", p2p.NewBlock, p2p.SyncBlockResponse),
@@ -94,6 +98,11 @@ func New(account *account.KeyPair, baseVariable global.BaseVariable, blockCache
 		mu:               new(sync.RWMutex),
 	}
 	continuousNum = baseVariable.Continuous()
+	production := baseVariable.Production()
+	subSlotTime = production.BlockIntervalDuration()
+	genBlockTime = production.GenBlockDuration()
+	last2GenBlockTime = production.LastGenBlockDuration()
+	slotLength = int64(production.SlotLength())
 	staticProperty = newStaticProperty(p.account, blockCache.LinkedRoot().Active())
 	p.recoverBlockcache()
 	close(p.quitGenerateMode)
@@ -322,8 +331,9 @@ func (p *PoB) scheduleLoop() {
 			metricsMode.Set(float64(p.baseVariable.Mode()), nil)
 			t := time.Now()
 			pubkey := p.account.ReadablePubkey()
-			if !staticProperty.SlotUsed[t.Unix()] && p.baseVariable.Mode() == global.ModeNormal && witnessOfNanoSec(t.UnixNano()) == pubkey {
-				staticProperty.SlotUsed[t.Unix()] = true
+			slot := slotOfNanoSec(t.UnixNano())
+			if !staticProperty.SlotUsed[slot] && p.baseVariable.Mode() == global.ModeNormal && witnessOfSlot(slot) == pubkey {
+				staticProperty.SlotUsed[slot] = true
 				generateBlockTicker := time.NewTicker(subSlotTime)
 				generateTxsNum = 0
 				p.quitGenerateMode = make(chan struct{})
@@ -402,6 +412,7 @@ func (p *PoB) RecoverBlock(blk *block.Block, witnessList blockcache.WitnessList)
 	if err != nil {
 		return err
 	}
+	p.checkEquivocation(blk)
 	parent, err := p.blockCache.Find(blk.Head.ParentHash)
 	p.blockCache.AddWithWit(blk, witnessList)
 	if err == nil && parent.Type == blockcache.Linked {
@@ -422,6 +433,7 @@ func (p *PoB) handleRecvBlock(blk *block.Block) error {
 	if err != nil {
 		return err
 	}
+	p.checkEquivocation(blk)
 	parent, err := p.blockCache.Find(blk.Head.ParentHash)
 	p.blockCache.Add(blk)
 	if err == nil && parent.Type == blockcache.Linked {
@@ -444,6 +456,14 @@ func (p *PoB) addExistingBlock(blk *block.Block, parentBlock *block.Block, repla
 			return err
 		}
 		p.verifyDB.Tag(string(blk.HeadHash()))
+		// the state root isn't part of the consensus yet, a mismatch is only reported
+		if len(blk.Head.StateRoot) > 0 {
+			if root := stateRoot(p.verifyDB, blk); root != nil && !bytes.Equal(root, blk.Head.StateRoot) {
+				ilog.Warnf("state root mismatch, blockNum:%v, blockHash:%v, expected:%v, got:%v", blk.Head.Number, common.Base58Encode(blk.HeadHash()),
+					common.Base58Encode(root), common.Base58Encode(blk.Head.StateRoot))
+				metricsStateRootMismatch.Add(1, nil)
+			}
+		}
 	}
 	p.txPool.AddLinkedNode(node)
 	p.blockCache.Link(node)
@@ -2,6 +2,7 @@ package pob
 
 import (
 	"strings"
+	"sync"
 
 	"github.com/iost-official/go-iost/account"
 	"github.com/iost-official/go-iost/common"
@@ -16,6 +17,7 @@ type StaticProperty struct {
 	WitnessList       []string
 	Watermark         map[string]int64
 	SlotUsed          map[int64]bool
+	mu                sync.RWMutex // guards the witness list and the watermarks read by RPC
 }
 
 func newStaticProperty(account *account.KeyPair, witnessList []string) *StaticProperty {
@@ -32,6 +34,8 @@ func newStaticProperty(account *account.KeyPair, witnessList []string) *StaticPr
 }
 
 func (property *StaticProperty) updateWitness(witnessList []string) {
+	property.mu.Lock()
+	defer property.mu.Unlock()
 
 	property.NumberOfWitnesses = int64(len(witnessList))
 	property.WitnessList = witnessList
@@ -48,14 +52,16 @@ func (property *StaticProperty) isWitness(w string) bool {
 
 var (
 	second2nanosecond int64 = 1000000000
+	// slotLength is the length of a slot in nanoseconds
+	slotLength = common.SlotLength * second2nanosecond
 )
 
-func witnessOfNanoSec(nanosec int64) string {
-	return witnessOfSec(nanosec / second2nanosecond)
+func slotOfNanoSec(nanosec int64) int64 {
+	return nanosec / slotLength
 }
 
-func witnessOfSec(sec int64) string {
-	return witnessOfSlot(sec / common.SlotLength)
+func witnessOfNanoSec(nanosec int64) string {
+	return witnessOfSlot(slotOfNanoSec(nanosec))
 }
 
 func witnessOfSlot(slot int64) string {
@@ -65,8 +71,8 @@ func witnessOfSlot(slot int64) string {
 }
 
 func timeUntilNextSchedule(timeSec int64) int64 {
-	currentSlot := timeSec / (second2nanosecond * common.SlotLength)
-	return (currentSlot+1)*second2nanosecond*common.SlotLength - timeSec
+	currentSlot := slotOfNanoSec(timeSec)
+	return (currentSlot+1)*slotLength - timeSec
 }
 
 // GetStaticProperty return property. RPC needs it.
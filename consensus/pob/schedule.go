@@ -0,0 +1,102 @@
+package pob
+
+import (
+	"errors"
+
+	"github.com/iost-official/go-iost/core/blockcache"
+)
+
+// ErrNotRunning is returned when getting the schedule while the pob isn't running.
+var ErrNotRunning = errors.New("pob is not running")
+
+// Slot is a slot of the producer schedule.
+type Slot struct {
+	Slot    int64
+	Time    int64 // the start time of the slot in nanoseconds
+	Witness string
+}
+
+// WitnessState is the confirming state of a witness.
+type WitnessState struct {
+	Witness string
+	// the number after the last block of the witness, the witness confirms the blocks from
+	// the ConfirmUntil to the number of its next block
+	Watermark int64
+	// the last block of the witness after the LIB on the head chain, -1 if there is none
+	LastBlock    int64
+	ConfirmUntil int64
+}
+
+// PendingBlock is a block after the LIB on the head chain.
+type PendingBlock struct {
+	Number        int64
+	Hash          []byte
+	Witness       string
+	ConfirmUntil  int64
+	Confirmations int64 // the number of the blocks confirming it
+}
+
+// Schedule is the producer schedule and the confirming state of the blocks.
+type Schedule struct {
+	// the slots of a round starting from the current one
+	Slots     []*Slot
+	Witnesses []*WitnessState
+	// the blocks from the head to the child of the LIB, the LIB moves to the highest one of
+	// them with ConfirmLimit confirmations
+	PendingBlocks []*PendingBlock
+	ConfirmLimit  int64
+}
+
+// GetSchedule returns the producer schedule at the time in nanoseconds, with the confirming
+// state of the blocks from the head to the root.
+func GetSchedule(head *blockcache.BlockCacheNode, root *blockcache.BlockCacheNode, now int64) (*Schedule, error) {
+	if staticProperty == nil {
+		return nil, ErrNotRunning
+	}
+	staticProperty.mu.RLock()
+	defer staticProperty.mu.RUnlock()
+
+	n := staticProperty.NumberOfWitnesses
+	if n == 0 {
+		return nil, errors.New("empty witness list")
+	}
+	s := &Schedule{
+		ConfirmLimit: n*2/3 + 1,
+	}
+	slot := slotOfNanoSec(now)
+	for i := int64(0); i < n; i++ {
+		s.Slots = append(s.Slots, &Slot{
+			Slot:    slot + i,
+			Time:    (slot + i) * slotLength,
+			Witness: witnessOfSlot(slot + i),
+		})
+	}
+
+	lastBlocks := make(map[string]*blockcache.BlockCacheNode)
+	walkConfirm(head, root, func(node *blockcache.BlockCacheNode, confirmNum int64) bool {
+		if _, ok := lastBlocks[node.Head.Witness]; !ok {
+			lastBlocks[node.Head.Witness] = node
+		}
+		s.PendingBlocks = append(s.PendingBlocks, &PendingBlock{
+			Number:        node.Head.Number,
+			Hash:          node.HeadHash(),
+			Witness:       node.Head.Witness,
+			ConfirmUntil:  node.ConfirmUntil,
+			Confirmations: confirmNum,
+		})
+		return true
+	})
+	for _, w := range staticProperty.WitnessList {
+		state := &WitnessState{
+			Witness:   w,
+			Watermark: staticProperty.Watermark[w],
+			LastBlock: -1,
+		}
+		if node, ok := lastBlocks[w]; ok {
+			state.LastBlock = node.Head.Number
+			state.ConfirmUntil = node.ConfirmUntil
+		}
+		s.Witnesses = append(s.Witnesses, state)
+	}
+	return s, nil
+}
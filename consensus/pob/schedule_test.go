@@ -0,0 +1,59 @@
+package pob
+
+import (
+	"testing"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSchedule(t *testing.T) {
+	staticProperty = nil
+	_, err := GetSchedule(nil, nil, 0)
+	assert.Equal(t, ErrNotRunning, err)
+
+	acc, _ := account.NewKeyPair(nil, crypto.Secp256k1)
+	staticProperty = newStaticProperty(acc, []string{"id0", "id1", "id2", "id3", "id4"})
+	staticProperty.Watermark["id4"] = 6
+	root := &blockcache.BlockCacheNode{
+		Block: &block.Block{
+			Head: &block.BlockHead{
+				Number:  1,
+				Witness: "id0",
+			},
+		},
+	}
+	node := addNode(root, 2, 0, "id1")
+	node = addNode(node, 3, 0, "id2")
+	node = addNode(node, 4, 0, "id3")
+	head := addNode(node, 5, 3, "id4")
+
+	s, err := GetSchedule(head, root, 7*3*1e9+1)
+	require.Nil(t, err)
+	assert.Equal(t, int64(4), s.ConfirmLimit)
+
+	witnesses := []string{}
+	for _, slot := range s.Slots {
+		witnesses = append(witnesses, slot.Witness)
+	}
+	assert.Equal(t, []string{"id2", "id3", "id4", "id0", "id1"}, witnesses)
+	assert.Equal(t, int64(7), s.Slots[0].Slot)
+	assert.Equal(t, int64(8*3*1e9), s.Slots[1].Time)
+
+	assert.Equal(t, &WitnessState{Witness: "id0", LastBlock: -1}, s.Witnesses[0])
+	assert.Equal(t, &WitnessState{Witness: "id4", Watermark: 6, LastBlock: 5, ConfirmUntil: 3}, s.Witnesses[4])
+
+	// no block has enough confirmations, as calculateConfirm tells
+	assert.Nil(t, calculateConfirm(head, root))
+	confirmations := []int64{}
+	for _, b := range s.PendingBlocks {
+		confirmations = append(confirmations, b.Confirmations)
+	}
+	assert.Equal(t, []int64{1, 2, 3, 3}, confirmations)
+	assert.Equal(t, int64(5), s.PendingBlocks[0].Number)
+	assert.Equal(t, int64(2), s.PendingBlocks[3].Number)
+}
@@ -0,0 +1,245 @@
+package block
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/ilog"
+)
+
+var (
+	accountTxPrefix      = []byte("a")                    // accountTxPrefix + account + "/" + block number + tx index -> tx hash
+	accountTxIndexLength = []byte("AccountTxIndexLength") // the number of blocks that have been indexed by account
+	accountTxIndexStart  = []byte("AccountTxIndexStart")  // the first block of the chain imported from a snapshot
+
+	// ErrAccountTxIndexDisabled is returned when querying the account tx index without enabling it.
+	ErrAccountTxIndexDisabled = errors.New("account tx index is disabled")
+)
+
+// tokenReceiptAccounts is the positions of accounts in the receipt content of token functions.
+var tokenReceiptAccounts = map[string][]int{
+	"token.iost/transfer":       {1, 2},
+	"token.iost/transferFreeze": {1, 2},
+	"token.iost/issue":          {1},
+	"token.iost/destroy":        {1},
+}
+
+// AccountTx is an entry in the account tx index.
+type AccountTx struct {
+	BlockNumber int64
+	Index       int64
+	TxHash      []byte
+}
+
+func accountTxKeyPrefix(account string) []byte {
+	key := make([]byte, 0, len(accountTxPrefix)+len(account)+17)
+	key = append(key, accountTxPrefix...)
+	key = append(key, account...)
+	return append(key, '/')
+}
+
+func accountTxKey(account string, number int64, index int64) []byte {
+	key := accountTxKeyPrefix(account)
+	key = append(key, common.Int64ToBytes(number)...)
+	return append(key, common.Int64ToBytes(index)...)
+}
+
+// TxAccounts returns the accounts touched by a tx, including the publisher,
+// the signers and the accounts appearing in token receipts.
+func TxAccounts(t *tx.Tx, r *tx.TxReceipt) []string {
+	accounts := make([]string, 0)
+	seen := make(map[string]bool)
+	add := func(acc string) {
+		if acc != "" && !seen[acc] {
+			seen[acc] = true
+			accounts = append(accounts, acc)
+		}
+	}
+	add(t.Publisher)
+	for _, s := range t.Signers {
+		add(strings.Split(s, "@")[0])
+	}
+	if r == nil {
+		return accounts
+	}
+	for _, re := range r.Receipts {
+		for _, acc := range ReceiptAccounts(re) {
+			add(acc)
+		}
+	}
+	return accounts
+}
+
+// ReceiptAccounts returns the accounts appearing in a token receipt.
+func ReceiptAccounts(r *tx.Receipt) []string {
+	pos, ok := tokenReceiptAccounts[r.FuncName]
+	if !ok {
+		return nil
+	}
+	var args []interface{}
+	if err := json.Unmarshal([]byte(r.Content), &args); err != nil {
+		return nil
+	}
+	accounts := make([]string, 0, len(pos))
+	for _, p := range pos {
+		if p < len(args) {
+			if acc, ok := args[p].(string); ok {
+				accounts = append(accounts, acc)
+			}
+		}
+	}
+	return accounts
+}
+
+// putAccountTxIndex writes the account tx index of the block into the current batch.
+func (bc *BlockChain) putAccountTxIndex(blk *Block) {
+	for i, t := range blk.Txs {
+		var r *tx.TxReceipt
+		if i < len(blk.Receipts) {
+			r = blk.Receipts[i]
+		}
+		tHash := t.Hash()
+		for _, acc := range TxAccounts(t, r) {
+			bc.blockChainDB.Put(accountTxKey(acc, blk.Head.Number, int64(i)), tHash)
+		}
+	}
+}
+
+func (bc *BlockChain) accountTxIndexLength() int64 {
+	b, err := bc.blockChainDB.Get(accountTxIndexLength)
+	if err != nil || len(b) == 0 {
+		return 0
+	}
+	return common.BytesToInt64(b)
+}
+
+// accountTxIndexStart returns the number of the first block to index, which isn't 0 if
+// the chain is imported from a snapshot without the blocks before it.
+func (bc *BlockChain) accountTxIndexStart() int64 {
+	b, err := bc.blockChainDB.Get(accountTxIndexStart)
+	if err != nil || len(b) == 0 {
+		return 0
+	}
+	return common.BytesToInt64(b)
+}
+
+// putAccountTxIndexStart writes the first block of the chain into the current batch if
+// the chain is empty, and returns the number of blocks that have been indexed.
+func (bc *BlockChain) putAccountTxIndexStart(blk *Block) int64 {
+	if bc.Length() != 0 || blk.Head.Number == 0 {
+		return bc.accountTxIndexLength()
+	}
+	start := common.Int64ToBytes(blk.Head.Number)
+	bc.blockChainDB.Put(accountTxIndexStart, start)
+	bc.blockChainDB.Put(accountTxIndexLength, start)
+	return blk.Head.Number
+}
+
+// EnableAccountTxIndex enables the account tx index. Blocks pushed before
+// enabling are indexed in background.
+func (bc *BlockChain) EnableAccountTxIndex() {
+	bc.writeMu.Lock()
+	bc.rw.Lock()
+	bc.accountIndex = true
+	bc.rw.Unlock()
+	bc.writeMu.Unlock()
+	go func() {
+		if err := bc.RebuildAccountTxIndex(false); err != nil {
+			ilog.Errorf("rebuild account tx index failed. err=%v", err)
+		}
+	}()
+}
+
+// AccountTxIndexEnabled returns whether the account tx index is enabled.
+func (bc *BlockChain) AccountTxIndexEnabled() bool {
+	bc.rw.RLock()
+	defer bc.rw.RUnlock()
+	return bc.accountIndex
+}
+
+// RebuildAccountTxIndex indexes the blocks which haven't been indexed by account.
+// If reset is true, all the blocks will be indexed again.
+func (bc *BlockChain) RebuildAccountTxIndex(reset bool) error {
+	if reset {
+		bc.writeMu.Lock()
+		err := bc.blockChainDB.Put(accountTxIndexLength, common.Int64ToBytes(bc.accountTxIndexStart()))
+		bc.writeMu.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+	start := bc.accountTxIndexLength()
+	for {
+		bc.writeMu.Lock()
+		number := bc.accountTxIndexLength()
+		if number >= bc.Length() {
+			bc.writeMu.Unlock()
+			break
+		}
+		err := bc.indexAccountTx(number)
+		bc.writeMu.Unlock()
+		if err != nil {
+			return err
+		}
+		if (number+1)%10000 == 0 {
+			ilog.Infof("account tx index rebuilt to block %d", number)
+		}
+	}
+	if end := bc.accountTxIndexLength(); end > start {
+		ilog.Infof("account tx index rebuilt from block %d to %d", start, end-1)
+	}
+	return nil
+}
+
+// indexAccountTx indexes the block of the given number, writeMu should be held.
+// The pruned blocks are skipped.
+func (bc *BlockChain) indexAccountTx(number int64) error {
+	blk, err := bc.GetBlockByNumber(number)
+	if perr, ok := err.(*PrunedError); ok {
+		return bc.blockChainDB.Put(accountTxIndexLength, common.Int64ToBytes(perr.Oldest))
+	}
+	if err != nil {
+		return fmt.Errorf("fail to get block %d, %v", number, err)
+	}
+	if err := bc.blockChainDB.BeginBatch(); err != nil {
+		return err
+	}
+	bc.putAccountTxIndex(blk)
+	bc.blockChainDB.Put(accountTxIndexLength, common.Int64ToBytes(number+1))
+	return bc.blockChainDB.CommitBatch()
+}
+
+// GetAccountTxs returns at most limit entries of the account tx index, starting
+// from the index-th tx of block number.
+func (bc *BlockChain) GetAccountTxs(account string, number int64, index int64, limit int) ([]*AccountTx, error) {
+	if !bc.AccountTxIndexEnabled() {
+		return nil, ErrAccountTxIndexDisabled
+	}
+	prefix := accountTxKeyPrefix(account)
+	end := accountTxKeyPrefix(account)
+	end[len(end)-1]++
+	iter := bc.blockChainDB.NewIteratorByRange(accountTxKey(account, number, index), end)
+	defer iter.Release()
+	ret := make([]*AccountTx, 0)
+	for len(ret) < limit && iter.Next() {
+		key := iter.Key()[len(prefix):]
+		if len(key) != 16 {
+			continue
+		}
+		hash := make([]byte, len(iter.Value()))
+		copy(hash, iter.Value())
+		ret = append(ret, &AccountTx{
+			BlockNumber: common.BytesToInt64(key[:8]),
+			Index:       common.BytesToInt64(key[8:]),
+			TxHash:      hash,
+		})
+	}
+	if err := iter.Error(); err != nil {
+		return nil, fmt.Errorf("fail to iterate account tx index: %v", err)
+	}
+	return ret, nil
+}
@@ -0,0 +1,102 @@
+package block
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func newAccountTestBlock(number int64, publishers ...string) *Block {
+	blk := &Block{
+		Head: &BlockHead{
+			Version:    0,
+			ParentHash: []byte("parent hash"),
+			Number:     number,
+			Witness:    "witness",
+		},
+		Sign: &crypto.Signature{},
+	}
+	for i, p := range publishers {
+		t := tx.NewTx([]*tx.Action{tx.NewAction("token.iost", "transfer", "[]")}, []string{"signer@active"}, 100000, 100, int64(i), 0, 0)
+		t.Publisher = p
+		r := tx.NewTxReceipt(t.Hash())
+		r.Receipts = append(r.Receipts, &tx.Receipt{
+			FuncName: "token.iost/transfer",
+			Content:  `["iost","` + p + `","receiver","1.0",""]`,
+		})
+		blk.Txs = append(blk.Txs, t)
+		blk.Receipts = append(blk.Receipts, r)
+	}
+	blk.CalculateHeadHash()
+	return blk
+}
+
+func TestTxAccounts(t *testing.T) {
+	blk := newAccountTestBlock(0, "alice")
+	assert.Equal(t, []string{"alice", "signer", "receiver"}, TxAccounts(blk.Txs[0], blk.Receipts[0]))
+	assert.Equal(t, []string{"alice", "signer"}, TxAccounts(blk.Txs[0], nil))
+}
+
+func TestAccountTxIndex(t *testing.T) {
+	dir, err := ioutil.TempDir("", "account_index")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	bc, err := NewBlockChain(dir)
+	assert.Nil(t, err)
+	defer bc.Close()
+
+	assert.Nil(t, bc.Push(newAccountTestBlock(0, "alice", "bob")))
+	assert.Nil(t, bc.Push(newAccountTestBlock(1, "bob")))
+
+	_, err = bc.GetAccountTxs("alice", 0, 0, 10)
+	assert.Equal(t, ErrAccountTxIndexDisabled, err)
+
+	// blocks pushed before enabling are indexed by rebuilding
+	bc.EnableAccountTxIndex()
+	assert.Nil(t, bc.RebuildAccountTxIndex(false))
+	assert.Nil(t, bc.Push(newAccountTestBlock(2, "alice", "carol", "bob")))
+
+	txs, err := bc.GetAccountTxs("alice", 0, 0, 10)
+	assert.Nil(t, err)
+	assert.Len(t, txs, 2)
+	assert.Equal(t, int64(0), txs[0].BlockNumber)
+	assert.Equal(t, int64(2), txs[1].BlockNumber)
+	assert.Equal(t, int64(0), txs[1].Index)
+
+	txs, err = bc.GetAccountTxs("bob", 0, 0, 10)
+	assert.Nil(t, err)
+	assert.Len(t, txs, 3)
+	blk, err := bc.GetBlockByNumber(2)
+	assert.Nil(t, err)
+	assert.Equal(t, blk.Txs[2].Hash(), txs[2].TxHash)
+
+	// pagination
+	txs, err = bc.GetAccountTxs("bob", 1, 0, 1)
+	assert.Nil(t, err)
+	assert.Len(t, txs, 1)
+	assert.Equal(t, int64(1), txs[0].BlockNumber)
+	txs, err = bc.GetAccountTxs("bob", 1, 1, 10)
+	assert.Nil(t, err)
+	assert.Len(t, txs, 1)
+	assert.Equal(t, int64(2), txs[0].BlockNumber)
+
+	txs, err = bc.GetAccountTxs("receiver", 0, 0, 10)
+	assert.Nil(t, err)
+	assert.Len(t, txs, 6)
+	txs, err = bc.GetAccountTxs("carol", 0, 0, 10)
+	assert.Nil(t, err)
+	assert.Len(t, txs, 1)
+	txs, err = bc.GetAccountTxs("car", 0, 0, 10)
+	assert.Nil(t, err)
+	assert.Len(t, txs, 0)
+
+	assert.Nil(t, bc.RebuildAccountTxIndex(true))
+	txs, err = bc.GetAccountTxs("signer", 0, 0, 10)
+	assert.Nil(t, err)
+	assert.Len(t, txs, 6)
+}
@@ -2,6 +2,8 @@ package block
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/iost-official/go-iost/common"
@@ -22,6 +24,9 @@ type BlockHead struct { // nolint
 	Witness             string
 	Time                int64
 	GasUsage            int64
+	// StateRoot is the root of the state after the block, which isn't covered by the hash
+	// of the block until it's part of the consensus.
+	StateRoot []byte
 }
 
 // ToPb convert BlockHead to proto buf data structure.
@@ -35,6 +40,7 @@ func (b *BlockHead) ToPb() *blockpb.BlockHead {
 		Number:              b.Number,
 		Witness:             b.Witness,
 		Time:                b.Time,
+		StateRoot:           b.StateRoot,
 	}
 }
 
@@ -62,6 +68,7 @@ func (b *BlockHead) FromPb(bh *blockpb.BlockHead) *BlockHead {
 	b.Number = bh.Number
 	b.Witness = bh.Witness
 	b.Time = bh.Time
+	b.StateRoot = bh.StateRoot
 	return b
 }
 
@@ -90,6 +97,11 @@ func (b *BlockHead) Hash() ([]byte, error) {
 	return common.Sha3(b.ToBytes()), nil
 }
 
+// Slot returns the slot of the witness producing the block.
+func (b *BlockHead) Slot(slotLength time.Duration) int64 {
+	return b.Time / int64(slotLength)
+}
+
 // Block is the implementation of block
 type Block struct {
 	hash          []byte
@@ -129,6 +141,30 @@ func (b *Block) CalculateTxReceiptMerkleHash() []byte {
 	return m.RootHash()
 }
 
+// TxMerklePath returns the merkle path of the index-th transaction in the tx merkle tree.
+func (b *Block) TxMerklePath(index int) ([][]byte, error) {
+	if index < 0 || index >= len(b.Txs) {
+		return nil, fmt.Errorf("tx index %d out of range", index)
+	}
+	m := merkletree.MerkleTree{}
+	hashes := make([][]byte, 0, len(b.Txs))
+	for _, tx := range b.Txs {
+		hashes = append(hashes, tx.Hash())
+	}
+	m.Build(hashes)
+	return m.MerklePath(hashes[index])
+}
+
+// TxReceiptMerklePath returns the merkle path of the index-th receipt in the tx receipt merkle tree.
+func (b *Block) TxReceiptMerklePath(index int) ([][]byte, error) {
+	if index < 0 || index >= len(b.Receipts) {
+		return nil, fmt.Errorf("receipt index %d out of range", index)
+	}
+	m := merkletree.TXRMerkleTree{}
+	m.Build(b.Receipts)
+	return m.MerklePath(b.Receipts[index].Hash())
+}
+
 // Encode is marshal
 func (b *Block) Encode() ([]byte, error) {
 	br := &blockpb.Block{
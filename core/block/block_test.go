@@ -26,6 +26,28 @@ func TestBlockHeadSerialize(t *testing.T) {
 	})
 }
 
+func TestBlockHeadStateRoot(t *testing.T) {
+	convey.Convey("Test of block head state root", t, func() {
+		head := BlockHead{
+			Number:     1,
+			ParentHash: []byte("parent"),
+		}
+		hash, err := head.Hash()
+		convey.So(err, convey.ShouldBeNil)
+		head.StateRoot = []byte("root")
+		withRoot, err := head.Hash()
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(bytes.Equal(hash, withRoot), convey.ShouldBeTrue)
+
+		b, err := head.Encode()
+		convey.So(err, convey.ShouldBeNil)
+		var headRead BlockHead
+		err = headRead.Decode(b)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(bytes.Equal(head.StateRoot, headRead.StateRoot), convey.ShouldBeTrue)
+	})
+}
+
 func TestBlockSerialize(t *testing.T) {
 	convey.Convey("test Push", t, func() {
 		blk := Block{
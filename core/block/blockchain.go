@@ -18,6 +18,11 @@ type BlockChain struct { //nolint:golint
 	rw           sync.RWMutex
 	length       int64
 	txTotal      int64
+
+	writeMu      sync.Mutex // serializes the batch writes
+	accountIndex bool
+	pruner       *pruner
+	prunedBody   int64
 }
 
 var (
@@ -35,7 +40,12 @@ var (
 
 // NewBlockChain returns a Chain instance
 func NewBlockChain(path string) (Chain, error) {
-	levelDB, err := kv.NewStorage(path, kv.LevelDBStorage)
+	return NewBlockChainWithStorage(path, kv.LevelDBStorage)
+}
+
+// NewBlockChainWithStorage returns a Chain instance on the storage of the specify type
+func NewBlockChainWithStorage(path string, storageType kv.StorageType) (Chain, error) {
+	levelDB, err := kv.NewStorage(path, storageType)
 	if err != nil {
 		return nil, fmt.Errorf("fail to init blockchaindb, %v", err)
 	}
@@ -66,11 +76,18 @@ func NewBlockChain(path string) (Chain, error) {
 			return nil, errors.New("fail to put tx total")
 		}
 	}
+	prunedBody, err := levelDB.Get(blockPrunedBody)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get pruned body, %v", err)
+	}
 	BC := &BlockChain{
 		blockChainDB: levelDB,
 		length:       length,
 		txTotal:      txTotal,
 	}
+	if len(prunedBody) > 0 {
+		BC.prunedBody = common.BytesToInt64(prunedBody)
+	}
 	BC.CheckLength()
 	return BC, err
 }
@@ -105,6 +122,9 @@ func (bc *BlockChain) TxTotal() int64 {
 
 // Push save the block to database
 func (bc *BlockChain) Push(block *Block) error {
+	bc.writeMu.Lock()
+	defer bc.writeMu.Unlock()
+
 	err := bc.blockChainDB.BeginBatch()
 	if err != nil {
 		return errors.New("fail to begin batch")
@@ -145,12 +165,18 @@ func (bc *BlockChain) Push(block *Block) error {
 			}
 		}
 	}
+	indexed := bc.putAccountTxIndexStart(block)
+	if bc.accountIndex && indexed == number {
+		bc.putAccountTxIndex(block)
+		bc.blockChainDB.Put(accountTxIndexLength, common.Int64ToBytes(number+1))
+	}
 	err = bc.blockChainDB.CommitBatch()
 	if err != nil {
 		return fmt.Errorf("fail to put block, err:%s", err)
 	}
 	bc.SetLength(number + 1)
 	bc.SetTxTotal(txTotal + int64(len(block.Txs)))
+	bc.notifyPruner()
 	return nil
 }
 
@@ -205,17 +231,31 @@ func (bc *BlockChain) GetBlockByHash(hash []byte) (*Block, error) {
 	if err != nil {
 		return nil, errors.New("fail to decode blockByte")
 	}
+	if err := bc.prunedError(blk.Head.Number); err != nil {
+		return nil, err
+	}
+	if err := bc.loadBlockBody(&blk, hash); err != nil {
+		// the body may be pruned after the header is read
+		if perr := bc.prunedError(blk.Head.Number); perr != nil {
+			return nil, perr
+		}
+		return nil, err
+	}
+	return &blk, nil
+}
+
+func (bc *BlockChain) loadBlockBody(blk *Block, hash []byte) error {
 	if blk.TxHashes != nil {
 		blk.Txs = make([]*tx.Tx, len(blk.TxHashes))
 		txsMap, err := bc.getBlockTxsMap(hash)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		for i, hash := range blk.TxHashes {
 			if tx, ok := txsMap[string(hash)]; ok {
 				blk.Txs[i] = tx
 			} else {
-				return nil, fmt.Errorf("miss the tx, tx hash: %s", hash)
+				return fmt.Errorf("miss the tx, tx hash: %s", hash)
 			}
 		}
 	}
@@ -223,21 +263,24 @@ func (bc *BlockChain) GetBlockByHash(hash []byte) (*Block, error) {
 		blk.Receipts = make([]*tx.TxReceipt, len(blk.ReceiptHashes))
 		receiptMap, err := bc.getBlockReceiptMap(hash)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		for i, hash := range blk.ReceiptHashes {
 			if tr, ok := receiptMap[string(hash)]; ok {
 				blk.Receipts[i] = tr
 			} else {
-				return nil, fmt.Errorf("miss the tx receipt, tx receipt hash: %s", hash)
+				return fmt.Errorf("miss the tx receipt, tx receipt hash: %s", hash)
 			}
 		}
 	}
-	return &blk, nil
+	return nil
 }
 
 // GetBlockByNumber is get block by number
 func (bc *BlockChain) GetBlockByNumber(number int64) (*Block, error) {
+	if err := bc.prunedError(number); err != nil {
+		return nil, err
+	}
 	hash, err := bc.GetHashByNumber(number)
 	if err != nil {
 		return nil, err
@@ -285,14 +328,20 @@ func (bc *BlockChain) getBlockReceiptMap(hash []byte) (map[string]*tx.TxReceipt,
 	return receiptMap, nil
 }
 
-// GetTx gets tx with tx's hash.
+// GetTx gets tx with tx's hash. The delay tx whose block isn't in the chain,
+// e.g. the chain is imported from a snapshot, is got from the delay txs.
 func (bc *BlockChain) GetTx(hash []byte) (*tx.Tx, error) {
 	tx := tx.Tx{}
 	bTx, err := bc.blockChainDB.Get(append(txPrefix, hash...))
 	if err != nil {
 		return nil, fmt.Errorf("failed to Get the tx: %v", err)
 	}
-	txData, err := bc.blockChainDB.Get(append(bTxPrefix, bTx...))
+	var txData []byte
+	if len(bTx) == 0 {
+		txData, err = bc.blockChainDB.Get(append(delaytxPrefix, hash...))
+	} else {
+		txData, err = bc.blockChainDB.Get(append(bTxPrefix, bTx...))
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to Get the tx: %v", err)
 	}
@@ -353,6 +402,18 @@ func (bc *BlockChain) GetReceiptByTxHash(hash []byte) (*tx.TxReceipt, error) {
 	return &re, nil
 }
 
+// GetBlockByTxHash gets the block which contains the tx.
+func (bc *BlockChain) GetBlockByTxHash(hash []byte) (*Block, error) {
+	bTx, err := bc.blockChainDB.Get(append(txPrefix, hash...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Get the tx: %v", err)
+	}
+	if len(bTx) <= len(hash) {
+		return nil, fmt.Errorf("failed to Get the tx: not found")
+	}
+	return bc.GetBlockByHash(bTx[:len(bTx)-len(hash)])
+}
+
 // HasReceipt checks if database has receipt.
 func (bc *BlockChain) HasReceipt(hash []byte) (bool, error) {
 	return bc.blockChainDB.Has(append(receiptPrefix, hash...))
@@ -365,6 +426,7 @@ func (bc *BlockChain) Size() (int64, error) {
 
 // Close is close database
 func (bc *BlockChain) Close() {
+	bc.stopPruner()
 	bc.blockChainDB.Close()
 }
 
@@ -383,6 +445,14 @@ func (bc *BlockChain) AllDelaytx() ([]*tx.Tx, error) {
 	return ret, nil
 }
 
+// PutDelaytx saves the delay transaction which is not executed yet.
+func (bc *BlockChain) PutDelaytx(t *tx.Tx) error {
+	bc.writeMu.Lock()
+	defer bc.writeMu.Unlock()
+
+	return bc.blockChainDB.Put(append(delaytxPrefix, t.Hash()...), t.Encode())
+}
+
 // Draw the graph about blockchain
 func (bc *BlockChain) Draw(start int64, end int64) string {
 	ret := ""
@@ -0,0 +1,224 @@
+package block
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	blockpb "github.com/iost-official/go-iost/core/block/pb"
+	"github.com/iost-official/go-iost/crypto"
+)
+
+var evidencePrefix = []byte("e") // evidencePrefix + witness + "/" + block number + hashes -> evidence
+
+// SignedHead is a block head with the signature of its witness.
+type SignedHead struct {
+	Head *BlockHead
+	Sign *crypto.Signature
+}
+
+// Evidence is the proof of an equivocation, that a witness signed two different blocks of
+// the same number or the same slot.
+type Evidence struct {
+	First  *SignedHead
+	Second *SignedHead
+	Time   int64 // the time of detecting the equivocation
+}
+
+// NewEvidence returns the evidence of the two blocks, ordered by number and hash.
+func NewEvidence(a *Block, b *Block, t int64) *Evidence {
+	if a.Head.Number > b.Head.Number || (a.Head.Number == b.Head.Number && bytes.Compare(a.HeadHash(), b.HeadHash()) > 0) {
+		a, b = b, a
+	}
+	return &Evidence{
+		First:  &SignedHead{Head: a.Head, Sign: a.Sign},
+		Second: &SignedHead{Head: b.Head, Sign: b.Sign},
+		Time:   t,
+	}
+}
+
+// Witness returns the witness who signed the blocks.
+func (e *Evidence) Witness() string {
+	return e.First.Head.Witness
+}
+
+// SameNumber returns whether the blocks have the same number, otherwise they're produced
+// in the same slot.
+func (e *Evidence) SameNumber() bool {
+	return e.First.Head.Number == e.Second.Head.Number
+}
+
+// Reason returns the reason of the equivocation.
+func (e *Evidence) Reason() string {
+	if e.SameNumber() {
+		return "same number"
+	}
+	return "same slot"
+}
+
+// Verify checks that the two heads are different, signed by the same witness, and have
+// the same number or slot of the length. Two blocks in the same slot are an equivocation
+// only if they're on different forks, which is checked against the chain by the caller.
+func (e *Evidence) Verify(slotLength time.Duration) error {
+	if e.First == nil || e.Second == nil || e.First.Head == nil || e.Second.Head == nil || e.First.Sign == nil || e.Second.Sign == nil {
+		return errors.New("incomplete evidence")
+	}
+	if e.First.Head.Witness != e.Second.Head.Witness {
+		return errors.New("different witnesses")
+	}
+	first, err := e.First.verify()
+	if err != nil {
+		return err
+	}
+	second, err := e.Second.verify()
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(first, second) {
+		return errors.New("same block")
+	}
+	if !e.SameNumber() && e.First.Head.Slot(slotLength) != e.Second.Head.Slot(slotLength) {
+		return errors.New("different numbers and slots")
+	}
+	return nil
+}
+
+// verify checks the signature of the witness, and returns the hash of the head.
+func (s *SignedHead) verify() ([]byte, error) {
+	hash, err := s.Head.Hash()
+	if err != nil {
+		return nil, err
+	}
+	sign := *s.Sign
+	sign.SetPubkey(account.DecodePubkey(s.Head.Witness))
+	if !sign.Verify(hash) {
+		return nil, fmt.Errorf("wrong signature of block %v", s.Head.Number)
+	}
+	return hash, nil
+}
+
+// Block returns the block of the head without the body.
+func (s *SignedHead) Block() *Block {
+	blk := &Block{Head: s.Head, Sign: s.Sign}
+	blk.CalculateHeadHash()
+	return blk
+}
+
+func (s *SignedHead) toPb() *blockpb.SignedHead {
+	return &blockpb.SignedHead{
+		Head: s.Head.ToPb(),
+		Sign: s.Sign.ToPb(),
+	}
+}
+
+func (s *SignedHead) fromPb(sh *blockpb.SignedHead) *SignedHead {
+	s.Head = &BlockHead{}
+	s.Sign = &crypto.Signature{}
+	if sh.Head != nil {
+		s.Head.FromPb(sh.Head)
+	}
+	if sh.Sign != nil {
+		s.Sign.FromPb(sh.Sign)
+	}
+	return s
+}
+
+// Encode is marshal
+func (e *Evidence) Encode() ([]byte, error) {
+	b, err := proto.Marshal(&blockpb.Evidence{
+		First:  e.First.toPb(),
+		Second: e.Second.toPb(),
+		Time:   e.Time,
+	})
+	if err != nil {
+		return nil, errors.New("fail to encode evidence")
+	}
+	return b, nil
+}
+
+// Decode is unmarshal
+func (e *Evidence) Decode(b []byte) error {
+	ev := &blockpb.Evidence{}
+	if err := proto.Unmarshal(b, ev); err != nil {
+		return errors.New("fail to decode evidence")
+	}
+	if ev.First == nil || ev.Second == nil {
+		return errors.New("incomplete evidence")
+	}
+	e.First = new(SignedHead).fromPb(ev.First)
+	e.Second = new(SignedHead).fromPb(ev.Second)
+	e.Time = ev.Time
+	return nil
+}
+
+func evidenceKeyPrefix(witness string) []byte {
+	key := make([]byte, 0, len(evidencePrefix)+len(witness)+1)
+	key = append(key, evidencePrefix...)
+	key = append(key, witness...)
+	return append(key, '/')
+}
+
+func evidenceKey(e *Evidence) ([]byte, error) {
+	first, err := e.First.Head.Hash()
+	if err != nil {
+		return nil, err
+	}
+	second, err := e.Second.Head.Hash()
+	if err != nil {
+		return nil, err
+	}
+	key := evidenceKeyPrefix(e.Witness())
+	key = append(key, common.Int64ToBytes(e.First.Head.Number)...)
+	key = append(key, first...)
+	return append(key, second...), nil
+}
+
+// PutEvidence saves the evidence of an equivocation, and returns whether it's new.
+func (bc *BlockChain) PutEvidence(e *Evidence) (bool, error) {
+	key, err := evidenceKey(e)
+	if err != nil {
+		return false, err
+	}
+	b, err := e.Encode()
+	if err != nil {
+		return false, err
+	}
+	bc.writeMu.Lock()
+	defer bc.writeMu.Unlock()
+
+	ok, err := bc.blockChainDB.Has(key)
+	if err != nil || ok {
+		return false, err
+	}
+	if err := bc.blockChainDB.Put(key, b); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// GetEvidences returns at most limit evidences of the witness ordered by block number, or
+// the evidences of all the witnesses if witness is empty.
+func (bc *BlockChain) GetEvidences(witness string, limit int) ([]*Evidence, error) {
+	prefix := evidencePrefix
+	if witness != "" {
+		prefix = evidenceKeyPrefix(witness)
+	}
+	iter := bc.blockChainDB.NewIteratorByPrefix(prefix)
+	defer iter.Release()
+	ret := make([]*Evidence, 0)
+	for len(ret) < limit && iter.Next() {
+		e := &Evidence{}
+		if err := e.Decode(iter.Value()); err != nil {
+			return nil, err
+		}
+		ret = append(ret, e)
+	}
+	if err := iter.Error(); err != nil {
+		return nil, fmt.Errorf("fail to iterate evidences: %v", err)
+	}
+	return ret, nil
+}
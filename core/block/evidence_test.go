@@ -0,0 +1,55 @@
+package block
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newEvidenceTestBlock(acc *account.KeyPair, number int64, t int64, info string) *Block {
+	blk := &Block{
+		Head: &BlockHead{
+			ParentHash: []byte("parent hash"),
+			Info:       []byte(info),
+			Number:     number,
+			Witness:    acc.ReadablePubkey(),
+			Time:       t,
+		},
+	}
+	blk.CalculateHeadHash()
+	blk.Sign = acc.Sign(blk.HeadHash())
+	return blk
+}
+
+func TestEvidence(t *testing.T) {
+	acc, _ := account.NewKeyPair(nil, crypto.Secp256k1)
+	other, _ := account.NewKeyPair(nil, crypto.Secp256k1)
+	a := newEvidenceTestBlock(acc, 10, 30e9, "a")
+	b := newEvidenceTestBlock(acc, 10, 40e9, "b")
+
+	e := NewEvidence(b, a, 1)
+	assert.Nil(t, e.Verify(3*time.Second))
+	assert.Equal(t, acc.ReadablePubkey(), e.Witness())
+	assert.Equal(t, "same number", e.Reason())
+
+	buf, err := e.Encode()
+	require.Nil(t, err)
+	decoded := &Evidence{}
+	require.Nil(t, decoded.Decode(buf))
+	assert.Nil(t, decoded.Verify(3*time.Second))
+	assert.Equal(t, e.First.Block().HeadHash(), decoded.First.Block().HeadHash())
+	assert.Equal(t, e.Second.Block().HeadHash(), decoded.Second.Block().HeadHash())
+	assert.Equal(t, int64(1), decoded.Time)
+
+	assert.Nil(t, NewEvidence(a, newEvidenceTestBlock(acc, 11, 32e9, "c"), 1).Verify(3*time.Second))
+	assert.NotNil(t, NewEvidence(a, newEvidenceTestBlock(acc, 11, 33e9, "c"), 1).Verify(3*time.Second))
+	assert.NotNil(t, NewEvidence(a, a, 1).Verify(3*time.Second))
+	assert.NotNil(t, NewEvidence(a, newEvidenceTestBlock(other, 10, 30e9, "b"), 1).Verify(3*time.Second))
+	forged := newEvidenceTestBlock(acc, 10, 30e9, "b")
+	forged.Sign = other.Sign(forged.HeadHash())
+	assert.NotNil(t, NewEvidence(a, forged, 1).Verify(3*time.Second))
+}
@@ -18,9 +18,20 @@ type Chain interface {
 	HasTx(hash []byte) (bool, error)
 	GetReceipt(Hash []byte) (*tx.TxReceipt, error)
 	GetReceiptByTxHash(Hash []byte) (*tx.TxReceipt, error)
+	GetBlockByTxHash(hash []byte) (*Block, error)
 	HasReceipt(hash []byte) (bool, error)
 	Size() (int64, error)
 	Close()
 	AllDelaytx() ([]*tx.Tx, error)
+	PutDelaytx(t *tx.Tx) error
 	Draw(int64, int64) string
+	EnableAccountTxIndex()
+	AccountTxIndexEnabled() bool
+	RebuildAccountTxIndex(reset bool) error
+	GetAccountTxs(account string, number int64, index int64, limit int) ([]*AccountTx, error)
+	EnablePruning(keepBlocks int64, keepHeaders int64)
+	VerifyBlock(number int64, repair bool) (*VerifyResult, error)
+	Truncate(length int64) error
+	PutEvidence(e *Evidence) (bool, error)
+	GetEvidences(witness string, limit int) ([]*Evidence, error)
 }
@@ -0,0 +1,44 @@
+package block
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/iost-official/go-iost/core/merkletree"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockMerklePath(t *testing.T) {
+	blk := newAccountTestBlock(0, "alice", "bob", "carol")
+	txRoot := blk.CalculateTxMerkleHash()
+	receiptRoot := blk.CalculateTxReceiptMerkleHash()
+	for i := range blk.Txs {
+		mp, err := blk.TxMerklePath(i)
+		assert.Nil(t, err)
+		assert.True(t, merkletree.VerifyMerklePath(blk.Txs[i].Hash(), int64(i), mp, txRoot))
+		mp, err = blk.TxReceiptMerklePath(i)
+		assert.Nil(t, err)
+		assert.True(t, merkletree.VerifyMerklePath(blk.Receipts[i].Hash(), int64(i), mp, receiptRoot))
+	}
+	_, err := blk.TxMerklePath(3)
+	assert.NotNil(t, err)
+}
+
+func TestGetBlockByTxHash(t *testing.T) {
+	dir, err := ioutil.TempDir("", "blockchain")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	bc, err := NewBlockChain(dir)
+	assert.Nil(t, err)
+	defer bc.Close()
+
+	blk := newAccountTestBlock(0, "alice", "bob")
+	assert.Nil(t, bc.Push(blk))
+	b, err := bc.GetBlockByTxHash(blk.Txs[1].Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, blk.HeadHash(), b.HeadHash())
+	_, err = bc.GetBlockByTxHash([]byte("not exist"))
+	assert.NotNil(t, err)
+}
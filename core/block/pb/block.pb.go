@@ -56,6 +56,7 @@ type BlockHead struct {
 	Number               int64    `protobuf:"varint,6,opt,name=number,proto3" json:"number,omitempty"`
 	Witness              string   `protobuf:"bytes,7,opt,name=witness,proto3" json:"witness,omitempty"`
 	Time                 int64    `protobuf:"varint,8,opt,name=time,proto3" json:"time,omitempty"`
+	StateRoot            []byte   `protobuf:"bytes,9,opt,name=stateRoot,proto3" json:"stateRoot,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -142,6 +143,13 @@ func (m *BlockHead) GetTime() int64 {
 	return 0
 }
 
+func (m *BlockHead) GetStateRoot() []byte {
+	if m != nil {
+		return m.StateRoot
+	}
+	return nil
+}
+
 type Block struct {
 	Head                 *BlockHead       `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
 	Sign                 *pb.Signature    `protobuf:"bytes,2,opt,name=sign,proto3" json:"sign,omitempty"`
@@ -229,41 +237,149 @@ func (m *Block) GetBlockType() BlockType {
 	return BlockType_NORMAL
 }
 
+type SignedHead struct {
+	Head                 *BlockHead    `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
+	Sign                 *pb.Signature `protobuf:"bytes,2,opt,name=sign,proto3" json:"sign,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SignedHead) Reset()         { *m = SignedHead{} }
+func (m *SignedHead) String() string { return proto.CompactTextString(m) }
+func (*SignedHead) ProtoMessage()    {}
+func (*SignedHead) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc6664e18d413fc7, []int{2}
+}
+
+func (m *SignedHead) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignedHead.Unmarshal(m, b)
+}
+func (m *SignedHead) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignedHead.Marshal(b, m, deterministic)
+}
+func (m *SignedHead) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedHead.Merge(m, src)
+}
+func (m *SignedHead) XXX_Size() int {
+	return xxx_messageInfo_SignedHead.Size(m)
+}
+func (m *SignedHead) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedHead.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedHead proto.InternalMessageInfo
+
+func (m *SignedHead) GetHead() *BlockHead {
+	if m != nil {
+		return m.Head
+	}
+	return nil
+}
+
+func (m *SignedHead) GetSign() *pb.Signature {
+	if m != nil {
+		return m.Sign
+	}
+	return nil
+}
+
+type Evidence struct {
+	First                *SignedHead `protobuf:"bytes,1,opt,name=first,proto3" json:"first,omitempty"`
+	Second               *SignedHead `protobuf:"bytes,2,opt,name=second,proto3" json:"second,omitempty"`
+	Time                 int64       `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *Evidence) Reset()         { *m = Evidence{} }
+func (m *Evidence) String() string { return proto.CompactTextString(m) }
+func (*Evidence) ProtoMessage()    {}
+func (*Evidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc6664e18d413fc7, []int{3}
+}
+
+func (m *Evidence) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Evidence.Unmarshal(m, b)
+}
+func (m *Evidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Evidence.Marshal(b, m, deterministic)
+}
+func (m *Evidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Evidence.Merge(m, src)
+}
+func (m *Evidence) XXX_Size() int {
+	return xxx_messageInfo_Evidence.Size(m)
+}
+func (m *Evidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_Evidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Evidence proto.InternalMessageInfo
+
+func (m *Evidence) GetFirst() *SignedHead {
+	if m != nil {
+		return m.First
+	}
+	return nil
+}
+
+func (m *Evidence) GetSecond() *SignedHead {
+	if m != nil {
+		return m.Second
+	}
+	return nil
+}
+
+func (m *Evidence) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("blockpb.BlockType", BlockType_name, BlockType_value)
 	proto.RegisterType((*BlockHead)(nil), "blockpb.BlockHead")
 	proto.RegisterType((*Block)(nil), "blockpb.Block")
+	proto.RegisterType((*SignedHead)(nil), "blockpb.SignedHead")
+	proto.RegisterType((*Evidence)(nil), "blockpb.Evidence")
 }
 
 func init() { proto.RegisterFile("core/block/pb/block.proto", fileDescriptor_dc6664e18d413fc7) }
 
 var fileDescriptor_dc6664e18d413fc7 = []byte{
-	// 427 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4f, 0x6f, 0xd3, 0x40,
-	0x10, 0xc5, 0x71, 0xed, 0x3a, 0xce, 0x24, 0x40, 0x34, 0x48, 0x68, 0xc9, 0x01, 0x59, 0x51, 0x41,
-	0x16, 0xa8, 0x76, 0x15, 0x38, 0x71, 0x2b, 0xa7, 0x1c, 0xfa, 0x47, 0xda, 0xf6, 0xc2, 0xd1, 0x76,
-	0x37, 0xc9, 0xaa, 0x89, 0x77, 0xb5, 0xbb, 0x01, 0xf7, 0x6b, 0xf0, 0x5d, 0xb9, 0xa3, 0x1d, 0x3b,
-	0xa1, 0x45, 0x48, 0xdc, 0xf6, 0xbd, 0xf9, 0xed, 0x78, 0xde, 0x78, 0xe1, 0x4d, 0xad, 0x8c, 0x28,
-	0xaa, 0x8d, 0xaa, 0xef, 0x0b, 0x5d, 0x75, 0x87, 0x5c, 0x1b, 0xe5, 0x14, 0x0e, 0x48, 0xe8, 0x6a,
-	0xfa, 0x65, 0x25, 0xdd, 0x7a, 0x57, 0xe5, 0xb5, 0xda, 0x16, 0x52, 0x59, 0x77, 0xaa, 0x96, 0x4b,
-	0x59, 0xcb, 0x72, 0x53, 0xac, 0xd4, 0xa9, 0x37, 0x8a, 0xda, 0x3c, 0x68, 0xa7, 0x7c, 0x03, 0x2b,
-	0x57, 0x4d, 0xe9, 0x76, 0x46, 0x74, 0x4d, 0xa6, 0x9f, 0xff, 0x7f, 0xd7, 0x0f, 0xe0, 0x5a, 0x7f,
-	0xd9, 0xb5, 0xdd, 0xad, 0xd9, 0xaf, 0x00, 0x86, 0x5f, 0xfd, 0xd7, 0x17, 0xa2, 0xbc, 0x43, 0x06,
-	0x83, 0xef, 0xc2, 0x58, 0xa9, 0x1a, 0x16, 0xa4, 0x41, 0x16, 0xf2, 0xbd, 0xc4, 0xb7, 0x00, 0xba,
-	0x34, 0xa2, 0x71, 0x8b, 0xd2, 0xae, 0xd9, 0x51, 0x1a, 0x64, 0x63, 0xfe, 0xc8, 0xc1, 0x19, 0x8c,
-	0x5d, 0x7b, 0x29, 0xcc, 0xfd, 0x46, 0x10, 0x11, 0x12, 0xf1, 0xc4, 0xc3, 0x33, 0x78, 0xe5, 0x5a,
-	0x2e, 0x6a, 0x21, 0xb5, 0x7b, 0x84, 0x46, 0x84, 0xfe, 0xab, 0x84, 0x08, 0x91, 0x6c, 0x96, 0x8a,
-	0x1d, 0x13, 0x42, 0x67, 0x7c, 0x0d, 0x71, 0xb3, 0xdb, 0x56, 0xc2, 0xb0, 0x98, 0x46, 0xec, 0x95,
-	0x9f, 0xfd, 0x87, 0x74, 0x8d, 0xb0, 0x96, 0x0d, 0xd2, 0x20, 0x1b, 0xf2, 0xbd, 0xf4, 0x5d, 0x9c,
-	0xdc, 0x0a, 0x96, 0x10, 0x4f, 0xe7, 0xd9, 0xcf, 0x23, 0x38, 0xa6, 0xdc, 0xf8, 0x1e, 0xa2, 0xb5,
-	0x28, 0xef, 0x28, 0xf0, 0x68, 0x8e, 0x79, 0xff, 0x2f, 0xf2, 0xc3, 0x56, 0x38, 0xd5, 0xf1, 0x04,
-	0x22, 0xbf, 0x72, 0xca, 0x3e, 0x9a, 0x4f, 0x72, 0x2b, 0x57, 0xba, 0xca, 0x6f, 0xf6, 0x7f, 0x81,
-	0x53, 0x15, 0xa7, 0x10, 0xba, 0xd6, 0xb2, 0x30, 0x0d, 0xb3, 0xd1, 0x3c, 0xc9, 0x5d, 0xab, 0xab,
-	0xfc, 0xb6, 0xe5, 0xde, 0xc4, 0x8f, 0x90, 0x98, 0x2e, 0xa2, 0x65, 0x11, 0x01, 0x2f, 0x0f, 0x40,
-	0xe7, 0xf3, 0x03, 0x80, 0x53, 0x48, 0x5c, 0xeb, 0x97, 0x20, 0x2c, 0x3b, 0x4e, 0xc3, 0x6c, 0xcc,
-	0x0f, 0x1a, 0x4f, 0xe0, 0x79, 0xcf, 0xf5, 0x40, 0x4c, 0xc0, 0x53, 0x13, 0xcf, 0x60, 0x48, 0x59,
-	0x6e, 0x1f, 0xb4, 0xa0, 0x95, 0xbc, 0xf8, 0x3b, 0x9d, 0xaf, 0xf0, 0x3f, 0xd0, 0x87, 0x77, 0xfd,
-	0x5b, 0xf0, 0x02, 0x01, 0xe2, 0xab, 0x6b, 0x7e, 0x79, 0x7e, 0x31, 0x79, 0x86, 0x63, 0x48, 0xae,
-	0xaf, 0x2e, 0xbe, 0x2d, 0xce, 0x6f, 0x16, 0x93, 0xa0, 0x8a, 0xe9, 0xe9, 0x7c, 0xfa, 0x1d, 0x00,
-	0x00, 0xff, 0xff, 0xb4, 0x8b, 0xe6, 0x72, 0xd2, 0x02, 0x00, 0x00,
+	// 496 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x86, 0x49, 0x9c, 0x38, 0xce, 0x24, 0x40, 0x34, 0x95, 0xd0, 0x12, 0x21, 0x14, 0x45, 0x05,
+	0x05, 0xaa, 0x3a, 0x55, 0xe0, 0xc4, 0xad, 0x48, 0x48, 0x39, 0xf4, 0x43, 0xda, 0xf6, 0x02, 0x37,
+	0x7f, 0x6c, 0x92, 0x55, 0x13, 0xaf, 0xb5, 0xbb, 0x09, 0xee, 0xdf, 0x80, 0x3f, 0x8c, 0x76, 0xec,
+	0x38, 0x2d, 0x02, 0x71, 0xe1, 0xb6, 0xf3, 0xce, 0xb3, 0xe3, 0x9d, 0x77, 0xc6, 0xf0, 0x32, 0x51,
+	0x5a, 0x4c, 0xe3, 0xb5, 0x4a, 0xee, 0xa6, 0x79, 0x5c, 0x1e, 0xc2, 0x5c, 0x2b, 0xab, 0xb0, 0x43,
+	0x41, 0x1e, 0x0f, 0x3f, 0x2d, 0xa5, 0x5d, 0x6d, 0xe3, 0x30, 0x51, 0x9b, 0xa9, 0x54, 0xc6, 0x9e,
+	0xaa, 0xc5, 0x42, 0x26, 0x32, 0x5a, 0x4f, 0x97, 0xea, 0xd4, 0x09, 0xd3, 0x44, 0xdf, 0xe7, 0x56,
+	0xb9, 0x02, 0x46, 0x2e, 0xb3, 0xc8, 0x6e, 0xb5, 0x28, 0x8b, 0x0c, 0x3f, 0xfe, 0xfb, 0xae, 0x7b,
+	0x80, 0x2d, 0xdc, 0x65, 0x5b, 0x94, 0xb7, 0xc6, 0x3f, 0x9b, 0xd0, 0xfd, 0xec, 0xbe, 0x3e, 0x17,
+	0x51, 0x8a, 0x0c, 0x3a, 0x3b, 0xa1, 0x8d, 0x54, 0x19, 0x6b, 0x8c, 0x1a, 0x13, 0x8f, 0xef, 0x43,
+	0x7c, 0x0d, 0x90, 0x47, 0x5a, 0x64, 0x76, 0x1e, 0x99, 0x15, 0x6b, 0x8e, 0x1a, 0x93, 0x3e, 0x7f,
+	0xa0, 0xe0, 0x18, 0xfa, 0xb6, 0xb8, 0x14, 0xfa, 0x6e, 0x2d, 0x88, 0xf0, 0x88, 0x78, 0xa4, 0xe1,
+	0x19, 0x1c, 0xd9, 0x82, 0x8b, 0x44, 0xc8, 0xdc, 0x3e, 0x40, 0x5b, 0x84, 0xfe, 0x29, 0x85, 0x08,
+	0x2d, 0x99, 0x2d, 0x14, 0x6b, 0x13, 0x42, 0x67, 0x7c, 0x01, 0x7e, 0xb6, 0xdd, 0xc4, 0x42, 0x33,
+	0x9f, 0x9e, 0x58, 0x45, 0xee, 0xed, 0xdf, 0xa5, 0xcd, 0x84, 0x31, 0xac, 0x33, 0x6a, 0x4c, 0xba,
+	0x7c, 0x1f, 0xba, 0x2a, 0x56, 0x6e, 0x04, 0x0b, 0x88, 0xa7, 0x33, 0xbe, 0x82, 0xae, 0xb1, 0x91,
+	0x15, 0x5c, 0x29, 0xcb, 0xba, 0x54, 0xfe, 0x20, 0x8c, 0x7f, 0x34, 0xa1, 0x4d, 0xae, 0xe0, 0x5b,
+	0x68, 0xad, 0x44, 0x94, 0x92, 0x1d, 0xbd, 0x19, 0x86, 0xd5, 0xa4, 0xc2, 0xda, 0x33, 0x4e, 0x79,
+	0x3c, 0x86, 0x96, 0x1b, 0x08, 0x39, 0xd3, 0x9b, 0x0d, 0x42, 0x23, 0x97, 0x79, 0x1c, 0xde, 0xec,
+	0x67, 0xc4, 0x29, 0x8b, 0x43, 0xf0, 0x6c, 0x61, 0x98, 0x37, 0xf2, 0x26, 0xbd, 0x59, 0x10, 0xda,
+	0x22, 0x8f, 0xc3, 0xdb, 0x82, 0x3b, 0x11, 0x4f, 0x20, 0xd0, 0xa5, 0x01, 0x86, 0xb5, 0x08, 0x78,
+	0x5e, 0x03, 0xa5, 0xce, 0x6b, 0x00, 0x87, 0x10, 0xd8, 0xc2, 0x59, 0x24, 0x0c, 0x6b, 0x8f, 0xbc,
+	0x49, 0x9f, 0xd7, 0x31, 0x1e, 0xc3, 0xd3, 0x8a, 0xab, 0x00, 0x9f, 0x80, 0xc7, 0x22, 0x9e, 0x41,
+	0x97, 0x7a, 0xb9, 0xbd, 0xcf, 0x05, 0x19, 0xf6, 0xec, 0xf7, 0xee, 0x5c, 0x86, 0x1f, 0xa0, 0xf1,
+	0x37, 0x00, 0xd7, 0x8f, 0x48, 0x69, 0x55, 0xfe, 0xab, 0x31, 0xe3, 0x1d, 0x04, 0x5f, 0x76, 0x32,
+	0x15, 0x59, 0x22, 0xf0, 0x1d, 0xb4, 0x17, 0x52, 0x1b, 0x5b, 0x95, 0x3e, 0xaa, 0x4b, 0x1f, 0xbe,
+	0xce, 0x4b, 0x02, 0x4f, 0xc0, 0x37, 0x22, 0x51, 0x59, 0xca, 0x9a, 0x7f, 0x67, 0x2b, 0xa4, 0x5e,
+	0x03, 0xef, 0xb0, 0x06, 0xef, 0xdf, 0x54, 0xdb, 0xef, 0x1a, 0x44, 0x00, 0xff, 0xea, 0x9a, 0x5f,
+	0x9e, 0x5f, 0x0c, 0x9e, 0x60, 0x1f, 0x82, 0xeb, 0xab, 0x8b, 0xaf, 0xf3, 0xf3, 0x9b, 0xf9, 0xa0,
+	0x11, 0xfb, 0xf4, 0xb3, 0x7c, 0xf8, 0x35, 0x00, 0xd9, 0xe5, 0x87, 0xf7, 0xc4, 0x03, 0x00, 0x00,
 }
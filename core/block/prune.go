@@ -0,0 +1,277 @@
+package block
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/ilog"
+)
+
+const (
+	// MinPruneKeepBlocks is the minimum number of the newest blocks whose bodies are kept by
+	// pruning, so that the duplicated txs which haven't expired can still be found.
+	MinPruneKeepBlocks int64 = 1000
+
+	pruneChunkSize       = 100    // the number of blocks pruned in a batch
+	pruneCompactInterval = 100000 // the number of pruned blocks which triggers a compaction
+)
+
+var (
+	blockPrunedBody   = []byte("BlockPrunedBody")   // the bodies of blocks in [1, BlockPrunedBody) are pruned
+	blockPrunedHeader = []byte("BlockPrunedHeader") // the headers of blocks in [1, BlockPrunedHeader) are pruned
+)
+
+// PrunedError is returned when getting a block which has been pruned.
+type PrunedError struct {
+	Number int64 // the number of the requested block
+	Oldest int64 // the number of the oldest block which isn't pruned, except the genesis block
+}
+
+func (e *PrunedError) Error() string {
+	return fmt.Sprintf("block %d is pruned, the oldest available block is %d", e.Number, e.Oldest)
+}
+
+type pruner struct {
+	keepBlocks  int64
+	keepHeaders int64
+	notify      chan struct{}
+	quit        chan struct{}
+	wg          sync.WaitGroup
+}
+
+// prunedError returns the error if the body of the block is pruned, otherwise nil.
+func (bc *BlockChain) prunedError(number int64) error {
+	bc.rw.RLock()
+	defer bc.rw.RUnlock()
+	if number > 0 && number < bc.prunedBody {
+		return &PrunedError{Number: number, Oldest: bc.prunedBody}
+	}
+	return nil
+}
+
+// EnablePruning prunes the bodies of blocks except the newest keepBlocks blocks and the
+// genesis block in background. The headers are kept for the newest keepHeaders blocks,
+// or all the blocks if keepHeaders is 0. The delay txs which aren't executed are kept.
+func (bc *BlockChain) EnablePruning(keepBlocks int64, keepHeaders int64) {
+	if keepBlocks < MinPruneKeepBlocks {
+		ilog.Warnf("prune keeps at least %d blocks instead of %d", MinPruneKeepBlocks, keepBlocks)
+		keepBlocks = MinPruneKeepBlocks
+	}
+	if keepHeaders != 0 && keepHeaders < keepBlocks {
+		ilog.Warnf("prune keeps the headers of at least %d blocks instead of %d", keepBlocks, keepHeaders)
+		keepHeaders = keepBlocks
+	}
+	bc.writeMu.Lock()
+	defer bc.writeMu.Unlock()
+	if bc.pruner != nil {
+		return
+	}
+	p := &pruner{
+		keepBlocks:  keepBlocks,
+		keepHeaders: keepHeaders,
+		notify:      make(chan struct{}, 1),
+		quit:        make(chan struct{}),
+	}
+	bc.pruner = p
+	p.wg.Add(1)
+	go bc.pruneLoop(p)
+	p.notify <- struct{}{}
+}
+
+// notifyPruner wakes up the pruner after a block is pushed, writeMu should be held.
+func (bc *BlockChain) notifyPruner() {
+	if bc.pruner == nil {
+		return
+	}
+	select {
+	case bc.pruner.notify <- struct{}{}:
+	default:
+	}
+}
+
+// stopPruner stops the pruner and waits for the batch being pruned.
+func (bc *BlockChain) stopPruner() {
+	bc.writeMu.Lock()
+	p := bc.pruner
+	bc.pruner = nil
+	bc.writeMu.Unlock()
+	if p != nil {
+		close(p.quit)
+		p.wg.Wait()
+	}
+}
+
+func (bc *BlockChain) pruneLoop(p *pruner) {
+	defer p.wg.Done()
+	var uncompacted int64
+	for {
+		select {
+		case <-p.quit:
+			return
+		case <-p.notify:
+		}
+		n, err := bc.prune(p)
+		if err != nil {
+			ilog.Errorf("prune blocks failed. err=%v", err)
+			continue
+		}
+		uncompacted += n
+		if uncompacted >= pruneCompactInterval {
+			uncompacted = 0
+			if err := bc.blockChainDB.Compact(); err != nil {
+				ilog.Errorf("compact blockchain db failed. err=%v", err)
+			}
+		}
+	}
+}
+
+// prune prunes the bodies and headers which are out of the kept range, and returns the
+// number of bodies and headers pruned.
+func (bc *BlockChain) prune(p *pruner) (int64, error) {
+	var total int64
+	for _, kind := range []struct {
+		name string
+		keep int64
+		key  []byte
+		next func([]int64, [][]byte, int64) error
+	}{
+		{"bodies", p.keepBlocks, blockPrunedBody, bc.pruneBodies},
+		{"headers", p.keepHeaders, blockPrunedHeader, bc.pruneHeaders},
+	} {
+		if kind.keep == 0 {
+			continue
+		}
+		for {
+			select {
+			case <-p.quit:
+				return total, nil
+			default:
+			}
+			bc.writeMu.Lock()
+			end := bc.Length() - kind.keep
+			start, err := bc.prunedNumber(kind.key)
+			if err != nil || start >= end {
+				bc.writeMu.Unlock()
+				if err != nil {
+					return total, err
+				}
+				break
+			}
+			numbers, hashes, err := bc.blockHashes(start, end, pruneChunkSize)
+			if err == nil {
+				if len(numbers) == pruneChunkSize {
+					end = numbers[len(numbers)-1] + 1
+				}
+				err = kind.next(numbers, hashes, end)
+			}
+			bc.writeMu.Unlock()
+			if err != nil {
+				return total, fmt.Errorf("fail to prune %s of blocks [%d, %d), %v", kind.name, start, end, err)
+			}
+			total += int64(len(numbers))
+		}
+	}
+	if total > 0 {
+		ilog.Infof("pruned %d bodies and headers of blocks, the oldest block with body is %d", total, bc.prunedBodyNumber())
+	}
+	return total, nil
+}
+
+func (bc *BlockChain) prunedNumber(key []byte) (int64, error) {
+	b, err := bc.blockChainDB.Get(key)
+	if err != nil {
+		return 0, err
+	}
+	if len(b) == 0 {
+		return 1, nil
+	}
+	return common.BytesToInt64(b), nil
+}
+
+func (bc *BlockChain) prunedBodyNumber() int64 {
+	bc.rw.RLock()
+	defer bc.rw.RUnlock()
+	return bc.prunedBody
+}
+
+// blockHashes returns the numbers and hashes of at most limit blocks in [start, end).
+func (bc *BlockChain) blockHashes(start, end int64, limit int) ([]int64, [][]byte, error) {
+	iter := bc.blockChainDB.NewIteratorByRange(
+		append(blockNumberPrefix, common.Int64ToBytes(start)...),
+		append(blockNumberPrefix, common.Int64ToBytes(end)...),
+	)
+	defer iter.Release()
+	var numbers []int64
+	var hashes [][]byte
+	for len(numbers) < limit && iter.Next() {
+		numbers = append(numbers, common.BytesToInt64(iter.Key()[len(blockNumberPrefix):]))
+		hashes = append(hashes, append([]byte{}, iter.Value()...))
+	}
+	return numbers, hashes, iter.Error()
+}
+
+// pruneBodies deletes the txs, receipts and their indexes of the blocks, and marks the
+// bodies of blocks before end as pruned, writeMu should be held. The delay txs are kept
+// until they are executed or canceled.
+func (bc *BlockChain) pruneBodies(numbers []int64, hashes [][]byte, end int64) error {
+	// the blocks are loaded before the batch, which can't be discarded on errors
+	indexed := bc.accountTxIndexLength()
+	keys := make([][]byte, 0)
+	for i, hash := range hashes {
+		blk, err := bc.GetBlockByHash(hash)
+		if err != nil {
+			return err
+		}
+		for j, t := range blk.Txs {
+			tHash := t.Hash()
+			rHash := blk.Receipts[j].Hash()
+			keys = append(keys,
+				append(txPrefix, tHash...),
+				append(bTxPrefix, append(hash, tHash...)...),
+				append(txReceiptPrefix, tHash...),
+				append(receiptPrefix, rHash...),
+				append(bReceiptPrefix, append(hash, rHash...)...),
+			)
+			if numbers[i] < indexed {
+				for _, acc := range TxAccounts(t, blk.Receipts[j]) {
+					keys = append(keys, accountTxKey(acc, numbers[i], int64(j)))
+				}
+			}
+		}
+	}
+	if err := bc.blockChainDB.BeginBatch(); err != nil {
+		return err
+	}
+	for _, key := range keys {
+		bc.blockChainDB.Delete(key)
+	}
+	bc.blockChainDB.Put(blockPrunedBody, common.Int64ToBytes(end))
+
+	// the readers see the blocks as pruned before they are deleted
+	bc.rw.Lock()
+	old := bc.prunedBody
+	bc.prunedBody = end
+	bc.rw.Unlock()
+	if err := bc.blockChainDB.CommitBatch(); err != nil {
+		bc.rw.Lock()
+		bc.prunedBody = old
+		bc.rw.Unlock()
+		return err
+	}
+	return nil
+}
+
+// pruneHeaders deletes the headers of the blocks, and marks the headers of blocks before
+// end as pruned, writeMu should be held.
+func (bc *BlockChain) pruneHeaders(numbers []int64, hashes [][]byte, end int64) error {
+	if err := bc.blockChainDB.BeginBatch(); err != nil {
+		return err
+	}
+	for i, hash := range hashes {
+		bc.blockChainDB.Delete(append(blockNumberPrefix, common.Int64ToBytes(numbers[i])...))
+		bc.blockChainDB.Delete(append(blockPrefix, hash...))
+	}
+	bc.blockChainDB.Put(blockPrunedHeader, common.Int64ToBytes(end))
+	return bc.blockChainDB.CommitBatch()
+}
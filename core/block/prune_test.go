@@ -0,0 +1,85 @@
+package block
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrune(t *testing.T) {
+	dir, err := ioutil.TempDir("", "prune")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	chain, err := NewBlockChain(dir)
+	require.Nil(t, err)
+	chain.EnableAccountTxIndex()
+	delaytx := tx.NewTx([]*tx.Action{tx.NewAction("token.iost", "transfer", "[]")}, nil, 100000, 100, 0, 1000, 0)
+	var blks []*Block
+	for i := int64(0); i < 10; i++ {
+		blk := newAccountTestBlock(i, "alice")
+		if i == 2 {
+			blk.Txs = append(blk.Txs, delaytx)
+			blk.Receipts = append(blk.Receipts, tx.NewTxReceipt(delaytx.Hash()))
+			blk.CalculateHeadHash()
+		}
+		require.Nil(t, chain.Push(blk))
+		blks = append(blks, blk)
+	}
+
+	bc := chain.(*BlockChain)
+	n, err := bc.prune(&pruner{keepBlocks: 5, keepHeaders: 7, quit: make(chan struct{})})
+	require.Nil(t, err)
+	assert.Equal(t, int64(6), n)
+
+	check := func(bc Chain) {
+		_, err := bc.GetBlockByNumber(3)
+		assert.Equal(t, &PrunedError{Number: 3, Oldest: 5}, err)
+		_, err = bc.GetBlockByHash(blks[4].HeadHash())
+		assert.Equal(t, &PrunedError{Number: 4, Oldest: 5}, err)
+		for _, i := range []int64{0, 5, 9} {
+			blk, err := bc.GetBlockByNumber(i)
+			assert.Nil(t, err)
+			assert.Equal(t, blks[i].HeadHash(), blk.HeadHash())
+		}
+		_, err = bc.GetHashByNumber(3)
+		assert.Nil(t, err)
+		_, err = bc.GetHashByNumber(2)
+		assert.NotNil(t, err)
+
+		_, err = bc.GetTx(blks[3].Txs[0].Hash())
+		assert.NotNil(t, err)
+		_, err = bc.GetReceiptByTxHash(blks[3].Txs[0].Hash())
+		assert.NotNil(t, err)
+		_, err = bc.GetTx(blks[5].Txs[0].Hash())
+		assert.Nil(t, err)
+
+		// the delay tx isn't executed, so it's kept
+		referred, err := bc.GetTx(delaytx.Hash())
+		assert.Nil(t, err)
+		assert.Equal(t, delaytx.Hash(), referred.Hash())
+		delaytxs, err := bc.AllDelaytx()
+		assert.Nil(t, err)
+		assert.Len(t, delaytxs, 1)
+
+		txs, err := bc.GetAccountTxs("alice", 0, 0, 20)
+		assert.Nil(t, err)
+		assert.Len(t, txs, 6)
+	}
+	check(bc)
+
+	// the pruned blocks are persisted
+	bc.Close()
+	chain, err = NewBlockChain(dir)
+	require.Nil(t, err)
+	chain.EnableAccountTxIndex()
+	chain.EnablePruning(5, 7)
+	check(chain)
+	assert.Nil(t, chain.RebuildAccountTxIndex(true))
+	check(chain)
+	chain.Close()
+}
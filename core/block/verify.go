@@ -0,0 +1,211 @@
+package block
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/iost-official/go-iost/common"
+)
+
+// VerifyResult is the result of verifying a block in the database.
+type VerifyResult struct {
+	Block    *Block   // the block, which is nil if its header is pruned
+	Pruned   bool     // whether the body of the block is pruned
+	Broken   bool     // whether the data of the block is lost or corrupted, which can't be repaired
+	Problems []string // the inconsistencies of the block
+
+	BrokenIndexes int // the number of broken index entries
+	Repaired      int // the number of index entries rewritten
+}
+
+func (r *VerifyResult) addProblem(format string, args ...interface{}) {
+	r.Problems = append(r.Problems, fmt.Sprintf(format, args...))
+}
+
+func (r *VerifyResult) broken(format string, args ...interface{}) {
+	r.Broken = true
+	r.addProblem(format, args...)
+}
+
+type indexEntry struct {
+	key   []byte
+	value []byte
+}
+
+// VerifyBlock checks the hash, number and merkle roots of the block, and the tx and receipt
+// indexes of its body. If repair is true, the broken indexes are rewritten.
+func (bc *BlockChain) VerifyBlock(number int64, repair bool) (*VerifyResult, error) {
+	r := &VerifyResult{}
+	hash, err := bc.blockChainDB.Get(append(blockNumberPrefix, common.Int64ToBytes(number)...))
+	if err != nil {
+		return nil, err
+	}
+	if len(hash) == 0 {
+		prunedHeader, err := bc.prunedNumber(blockPrunedHeader)
+		if err != nil {
+			return nil, err
+		}
+		if number > 0 && number < prunedHeader {
+			r.Pruned = true
+		} else {
+			r.broken("block %d is missing", number)
+		}
+		return r, nil
+	}
+	blockByte, err := bc.blockChainDB.Get(append(blockPrefix, hash...))
+	if err != nil {
+		return nil, err
+	}
+	if len(blockByte) == 0 {
+		r.broken("header of block %d is missing, hash %v", number, common.Base58Encode(hash))
+		return r, nil
+	}
+	var blk Block
+	if err := blk.Decode(blockByte); err != nil {
+		r.broken("header of block %d is corrupted, %v", number, err)
+		return r, nil
+	}
+	if !bytes.Equal(blk.HeadHash(), hash) {
+		r.broken("hash of block %d is %v, but indexed as %v", number, common.Base58Encode(blk.HeadHash()), common.Base58Encode(hash))
+		return r, nil
+	}
+	if blk.Head.Number != number {
+		r.broken("block %v has number %d, but indexed as %d", common.Base58Encode(hash), blk.Head.Number, number)
+		return r, nil
+	}
+	r.Block = &blk
+	if bc.prunedError(number) != nil {
+		r.Pruned = true
+		return r, nil
+	}
+	if len(blk.TxHashes) != len(blk.ReceiptHashes) {
+		r.broken("block %d has %d txs but %d receipts", number, len(blk.TxHashes), len(blk.ReceiptHashes))
+		return r, nil
+	}
+	if err := bc.loadBlockBody(&blk, hash); err != nil {
+		r.broken("body of block %d is broken, %v", number, err)
+		return r, nil
+	}
+	if !bytes.Equal(blk.CalculateTxMerkleHash(), blk.Head.TxMerkleHash) {
+		r.broken("tx merkle hash of block %d mismatches", number)
+	}
+	if !bytes.Equal(blk.CalculateTxReceiptMerkleHash(), blk.Head.TxReceiptMerkleHash) {
+		r.broken("tx receipt merkle hash of block %d mismatches", number)
+	}
+
+	var broken []indexEntry
+	for i, t := range blk.Txs {
+		tHash := blk.TxHashes[i]
+		rHash := blk.ReceiptHashes[i]
+		if !bytes.Equal(t.Hash(), tHash) || !bytes.Equal(blk.Receipts[i].Hash(), rHash) {
+			r.broken("tx %d of block %d is corrupted", i, number)
+			continue
+		}
+		for _, e := range []indexEntry{
+			{append(txPrefix, tHash...), append(hash, tHash...)},
+			{append(txReceiptPrefix, tHash...), append(hash, rHash...)},
+			{append(receiptPrefix, rHash...), append(hash, rHash...)},
+		} {
+			v, err := bc.blockChainDB.Get(e.key)
+			if err != nil {
+				return nil, err
+			}
+			if !bytes.Equal(v, e.value) {
+				r.addProblem("index %q of tx %v in block %d is broken", e.key[:1], common.Base58Encode(tHash), number)
+				broken = append(broken, e)
+			}
+		}
+	}
+	if repair && len(broken) > 0 {
+		bc.writeMu.Lock()
+		defer bc.writeMu.Unlock()
+		if err := bc.blockChainDB.BeginBatch(); err != nil {
+			return nil, err
+		}
+		for _, e := range broken {
+			bc.blockChainDB.Put(e.key, e.value)
+		}
+		if err := bc.blockChainDB.CommitBatch(); err != nil {
+			return nil, err
+		}
+		r.Repaired = len(broken)
+	}
+	r.BrokenIndexes = len(broken)
+	return r, nil
+}
+
+// Truncate removes the blocks whose numbers are not less than length, with their txs,
+// receipts and indexes. The delay txs executed or canceled by the removed blocks are not
+// restored.
+func (bc *BlockChain) Truncate(length int64) error {
+	bc.writeMu.Lock()
+	defer bc.writeMu.Unlock()
+
+	if length < 0 || length > bc.Length() {
+		return fmt.Errorf("invalid length %d, the length of blockchain is %d", length, bc.Length())
+	}
+	txTotal := bc.TxTotal()
+	for number := bc.Length() - 1; number >= length; number-- {
+		if err := bc.blockChainDB.BeginBatch(); err != nil {
+			return err
+		}
+		numberKey := append(blockNumberPrefix, common.Int64ToBytes(number)...)
+		hash, err := bc.blockChainDB.Get(numberKey)
+		if err != nil {
+			bc.blockChainDB.CommitBatch()
+			return err
+		}
+		if len(hash) > 0 {
+			txTotal -= bc.deleteBlock(hash)
+			bc.blockChainDB.Delete(numberKey)
+		}
+		if txTotal < 0 {
+			txTotal = 0
+		}
+		bc.blockChainDB.Put(blockLength, common.Int64ToBytes(number))
+		bc.blockChainDB.Put(blockTxTotal, common.Int64ToBytes(txTotal))
+		if bc.accountTxIndexLength() > number {
+			bc.blockChainDB.Put(accountTxIndexLength, common.Int64ToBytes(number))
+		}
+		if err := bc.blockChainDB.CommitBatch(); err != nil {
+			return err
+		}
+		bc.SetLength(number)
+		bc.SetTxTotal(txTotal)
+	}
+	return nil
+}
+
+// deleteBlock deletes the block with its body in the current batch, and returns the
+// number of txs deleted. The broken parts of the block are skipped.
+func (bc *BlockChain) deleteBlock(hash []byte) int64 {
+	blockByte, _ := bc.blockChainDB.Get(append(blockPrefix, hash...))
+	bc.blockChainDB.Delete(append(blockPrefix, hash...))
+	var blk Block
+	if len(blockByte) == 0 || blk.Decode(blockByte) != nil {
+		return 0
+	}
+	txs, _ := bc.getBlockTxsMap(hash)
+	receipts, _ := bc.getBlockReceiptMap(hash)
+	for i, tHash := range blk.TxHashes {
+		bc.blockChainDB.Delete(append(txPrefix, tHash...))
+		bc.blockChainDB.Delete(append(bTxPrefix, append(hash, tHash...)...))
+		bc.blockChainDB.Delete(append(txReceiptPrefix, tHash...))
+		t, ok := txs[string(tHash)]
+		if !ok || i >= len(blk.ReceiptHashes) {
+			continue
+		}
+		if t.Delay > 0 {
+			bc.blockChainDB.Delete(append(delaytxPrefix, tHash...))
+		}
+		re := receipts[string(blk.ReceiptHashes[i])]
+		for _, acc := range TxAccounts(t, re) {
+			bc.blockChainDB.Delete(accountTxKey(acc, blk.Head.Number, int64(i)))
+		}
+	}
+	for _, rHash := range blk.ReceiptHashes {
+		bc.blockChainDB.Delete(append(receiptPrefix, rHash...))
+		bc.blockChainDB.Delete(append(bReceiptPrefix, append(hash, rHash...)...))
+	}
+	return int64(len(blk.TxHashes))
+}
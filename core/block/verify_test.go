@@ -0,0 +1,82 @@
+package block
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyBlock(t *testing.T) {
+	dir, err := ioutil.TempDir("", "verify")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	chain, err := NewBlockChain(dir)
+	require.Nil(t, err)
+	defer chain.Close()
+	var blks []*Block
+	for i := int64(0); i < 4; i++ {
+		blk := newAccountTestBlock(i, "alice", "bob")
+		blk.Head.TxMerkleHash = blk.CalculateTxMerkleHash()
+		blk.Head.TxReceiptMerkleHash = blk.CalculateTxReceiptMerkleHash()
+		blk.CalculateHeadHash()
+		require.Nil(t, chain.Push(blk))
+		blks = append(blks, blk)
+	}
+	bc := chain.(*BlockChain)
+
+	r, err := bc.VerifyBlock(1, false)
+	require.Nil(t, err)
+	assert.Empty(t, r.Problems)
+	assert.Equal(t, blks[1].HeadHash(), r.Block.HeadHash())
+
+	// the broken index is repaired
+	tHash := blks[1].Txs[1].Hash()
+	require.Nil(t, bc.blockChainDB.Delete(append(txPrefix, tHash...)))
+	r, err = bc.VerifyBlock(1, false)
+	require.Nil(t, err)
+	assert.False(t, r.Broken)
+	assert.Equal(t, 1, r.BrokenIndexes)
+	assert.Len(t, r.Problems, 1)
+	_, err = bc.GetTx(tHash)
+	assert.NotNil(t, err)
+	r, err = bc.VerifyBlock(1, true)
+	require.Nil(t, err)
+	assert.Equal(t, 1, r.Repaired)
+	_, err = bc.GetTx(tHash)
+	assert.Nil(t, err)
+	r, err = bc.VerifyBlock(1, false)
+	require.Nil(t, err)
+	assert.Empty(t, r.Problems)
+
+	// the lost tx can't be repaired
+	hash := blks[2].HeadHash()
+	require.Nil(t, bc.blockChainDB.Delete(append(bTxPrefix, append(hash, blks[2].Txs[0].Hash()...)...)))
+	r, err = bc.VerifyBlock(2, true)
+	require.Nil(t, err)
+	assert.True(t, r.Broken)
+	r, err = bc.VerifyBlock(4, false)
+	require.Nil(t, err)
+	assert.True(t, r.Broken)
+	assert.Nil(t, r.Block)
+
+	require.Nil(t, bc.Truncate(2))
+	assert.Equal(t, int64(2), bc.Length())
+	assert.Equal(t, int64(4), bc.TxTotal())
+	_, err = bc.GetBlockByNumber(2)
+	assert.NotNil(t, err)
+	has, err := bc.HasTx(blks[3].Txs[0].Hash())
+	assert.Nil(t, err)
+	assert.False(t, has)
+	length, err := bc.blockChainDB.Get(blockLength)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), common.BytesToInt64(length))
+	top, err := bc.Top()
+	require.Nil(t, err)
+	assert.Equal(t, blks[1].HeadHash(), top.HeadHash())
+	assert.NotNil(t, bc.Truncate(3))
+}
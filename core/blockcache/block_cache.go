@@ -11,6 +11,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/event"
 	"github.com/iost-official/go-iost/core/global"
 	"github.com/iost-official/go-iost/db"
 	"github.com/iost-official/go-iost/db/wal"
@@ -130,6 +131,40 @@ func decodeBCN(b []byte) (block block.Block, witnessList WitnessList, err error)
 	return
 }
 
+// WALEntry is the summary of an entry of the block cache WAL.
+type WALEntry struct {
+	Type   BcMessageType
+	Number int64  // the number of the linked block, -1 for the other types
+	Hash   []byte // the hash of the linked block or the new root
+}
+
+// DecodeWALEntry decodes the data of an entry of the block cache WAL.
+func DecodeWALEntry(data []byte) (*WALEntry, error) {
+	var bcMessage BcMessage
+	if err := proto.Unmarshal(data, &bcMessage); err != nil {
+		return nil, err
+	}
+	e := &WALEntry{Type: bcMessage.Type, Number: -1}
+	switch bcMessage.Type {
+	case BcMessageType_LinkType:
+		var bcRaw BlockCacheRaw
+		if err := proto.Unmarshal(bcMessage.Data, &bcRaw); err != nil {
+			return nil, err
+		}
+		var blk block.Block
+		if err := blk.Decode(bcRaw.BlockBytes); err != nil {
+			return nil, err
+		}
+		e.Number = blk.Head.Number
+		e.Hash = blk.HeadHash()
+	case BcMessageType_SetRootType:
+		e.Hash = bcMessage.Data
+	default:
+		return nil, fmt.Errorf("unknown message type %v", bcMessage.Type)
+	}
+	return e, nil
+}
+
 // NewBCN return a new block cache node instance
 func NewBCN(parent *BlockCacheNode, blk *block.Block) *BlockCacheNode {
 	bcn := &BlockCacheNode{
@@ -232,9 +267,25 @@ func (bc *BlockCacheImpl) hmdel(hash []byte) {
 	bc.hash2node.Delete(string(hash))
 }
 
+// WALDir returns the directory of the block cache WAL of the config.
+func WALDir(config *common.Config) string {
+	return config.DB.LdbPath + blockCacheWALDir
+}
+
+func createWAL(config *common.Config, walPath string) (*wal.WAL, error) {
+	w, err := wal.Create(walPath, []byte("block_cache_wal"))
+	if err != nil {
+		return nil, err
+	}
+	if config.DB.WALCompression {
+		w.EnableCompression()
+	}
+	return w, nil
+}
+
 // NewBlockCache return a new BlockCache instance
 func NewBlockCache(baseVariable global.BaseVariable) (*BlockCacheImpl, error) {
-	w, err := wal.Create(baseVariable.Config().DB.LdbPath+blockCacheWALDir, []byte("block_cache_wal"))
+	w, err := createWAL(baseVariable.Config(), WALDir(baseVariable.Config()))
 	if err != nil {
 		return nil, err
 	}
@@ -276,10 +327,10 @@ func NewBlockCache(baseVariable global.BaseVariable) (*BlockCacheImpl, error) {
 
 // NewWAL New wal when old one is not recoverable. Move Old File into Corrupted for later analysis.
 func (bc *BlockCacheImpl) NewWAL(config *common.Config) (err error) {
-	walPath := config.DB.LdbPath + blockCacheWALDir
-	corruptWalPath := config.DB.LdbPath + blockCacheWALDir + "Corrupted"
+	walPath := WALDir(config)
+	corruptWalPath := walPath + "Corrupted"
 	os.Rename(walPath, corruptWalPath)
-	bc.wal, err = wal.Create(walPath, []byte("block_cache_wal"))
+	bc.wal, err = createWAL(config, walPath)
 	return
 
 }
@@ -290,7 +341,7 @@ func (bc *BlockCacheImpl) Recover(p conAlgo) (err error) {
 		//Get All entries
 		_, entries, err := bc.wal.ReadAll()
 		if err != nil {
-			return err
+			return fmt.Errorf("read wal failed, run `iserver wal verify` to locate the bad entry: %v", err)
 		}
 		ilog.Info("Recover block start")
 		for i, entry := range entries {
@@ -299,7 +350,7 @@ func (bc *BlockCacheImpl) Recover(p conAlgo) (err error) {
 			}
 			err := bc.apply(entry, p)
 			if err != nil {
-				return err
+				return fmt.Errorf("apply wal entry %d failed: %v", entry.Index, err)
 			}
 		}
 	}
@@ -365,6 +416,7 @@ func (bc *BlockCacheImpl) Link(bcn *BlockCacheNode) {
 	if bcn.Head.Number > bc.Head().Head.Number {
 		bc.SetHead(bcn)
 	}
+	event.GetCollector().PostBlock(event.NewBlock, bcn.Block)
 }
 
 func (bc *BlockCacheImpl) setHead(h *BlockCacheNode) error {
@@ -524,7 +576,7 @@ func (bc *BlockCacheImpl) flush(retain *BlockCacheNode) error {
 			return err
 		}
 		ilog.Debug("confirm: ", retain.Head.Number)
-		err = bc.baseVariable.StateDB().Flush(string(retain.HeadHash()))
+		err = bc.baseVariable.StateDB().FlushBlock(string(retain.HeadHash()), retain.Head.Number)
 
 		if err != nil {
 			ilog.Errorf("flush mvcc error: %v", err)
@@ -534,6 +586,7 @@ func (bc *BlockCacheImpl) flush(retain *BlockCacheNode) error {
 		retain.SetParent(nil)
 		retain.LibWitnessHandle()
 		bc.SetLinkedRoot(retain)
+		event.GetCollector().PostBlock(event.IrreversibleBlock, retain.Block)
 
 		metricsTxTotal.Set(float64(bc.baseVariable.BlockChain().TxTotal()), nil)
 
@@ -54,6 +54,7 @@ func TestBlockCache(t *testing.T) {
 	s3 := genBlock(s2, "w4", 4)
 	statedb := db_mock.NewMockMVCCDB(ctl)
 	statedb.EXPECT().Flush(Any()).AnyTimes().Return(nil)
+	statedb.EXPECT().FlushBlock(Any(), Any()).AnyTimes().Return(nil)
 	statedb.EXPECT().Fork().AnyTimes().Return(statedb)
 	statedb.EXPECT().Checkout(Any()).AnyTimes().Return(true)
 	statedb.EXPECT().Size().AnyTimes().Return(int64(10000), nil)
@@ -190,6 +191,7 @@ func TestVote(t *testing.T) {
 
 	statedb := db_mock.NewMockMVCCDB(ctl)
 	statedb.EXPECT().Flush(Any()).AnyTimes().Return(nil)
+	statedb.EXPECT().FlushBlock(Any(), Any()).AnyTimes().Return(nil)
 	statedb.EXPECT().Fork().AnyTimes().Return(statedb)
 	statedb.EXPECT().Checkout(Any()).AnyTimes().Return(true)
 
@@ -0,0 +1,127 @@
+package event
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/ilog"
+)
+
+// BlockData is the data of NewBlock and IrreversibleBlock events.
+type BlockData struct {
+	Number     int64  `json:"number"`
+	Hash       string `json:"hash"`
+	ParentHash string `json:"parent_hash"`
+	Witness    string `json:"witness"`
+	Time       int64  `json:"time"`
+	TxCount    int    `json:"tx_count"`
+}
+
+// TxDroppedData is the data of TxDropped events.
+type TxDroppedData struct {
+	Hash   string `json:"hash"`
+	Reason string `json:"reason"`
+}
+
+// EquivocationData is the data of Equivocation events.
+type EquivocationData struct {
+	Witness string              `json:"witness"`
+	Reason  string              `json:"reason"`
+	Blocks  []*EquivocationHead `json:"blocks"`
+}
+
+// EquivocationHead is a block head of an equivocation.
+type EquivocationHead struct {
+	Number int64  `json:"number"`
+	Hash   string `json:"hash"`
+	Time   int64  `json:"time"`
+}
+
+// ReceiptMeta returns the meta of a receipt in the tx published by publisher.
+func ReceiptMeta(publisher string, r *tx.Receipt, irreversible bool) *Meta {
+	contractID := r.FuncName
+	if i := strings.LastIndex(contractID, "/"); i >= 0 {
+		contractID = contractID[:i]
+	}
+	return &Meta{
+		ContractID:   contractID,
+		FuncName:     r.FuncName,
+		Accounts:     append([]string{publisher}, block.ReceiptAccounts(r)...),
+		Irreversible: irreversible,
+	}
+}
+
+// PostBlock posts a NewBlock or IrreversibleBlock event. The receipts of an
+// irreversible block are posted as irreversible ContractReceipt events.
+func (ec *Collector) PostBlock(topic Topic, blk *block.Block) {
+	data, err := json.Marshal(&BlockData{
+		Number:     blk.Head.Number,
+		Hash:       common.Base58Encode(blk.HeadHash()),
+		ParentHash: common.Base58Encode(blk.Head.ParentHash),
+		Witness:    blk.Head.Witness,
+		Time:       blk.Head.Time,
+		TxCount:    len(blk.Txs),
+	})
+	if err != nil {
+		ilog.Errorf("marshal block event failed. err=%v", err)
+		return
+	}
+	e := NewEvent(topic, string(data))
+	e.BlockNumber = blk.Head.Number
+	go func() {
+		ec.sendEvent(e, nil)
+		if topic != IrreversibleBlock {
+			return
+		}
+		for i, r := range blk.Receipts {
+			if i >= len(blk.Txs) {
+				break
+			}
+			for _, rec := range r.Receipts {
+				e := NewEvent(ContractReceipt, rec.Content)
+				e.BlockNumber = blk.Head.Number
+				ec.sendEvent(e, ReceiptMeta(blk.Txs[i].Publisher, rec, true))
+			}
+		}
+	}()
+}
+
+// PostTxDropped posts a TxDropped event.
+func (ec *Collector) PostTxDropped(t *tx.Tx, reason string) {
+	data, err := json.Marshal(&TxDroppedData{
+		Hash:   common.Base58Encode(t.Hash()),
+		Reason: reason,
+	})
+	if err != nil {
+		ilog.Errorf("marshal tx dropped event failed. err=%v", err)
+		return
+	}
+	ec.Post(NewEvent(TxDropped, string(data)), &Meta{Accounts: []string{t.Publisher}})
+}
+
+// PostEquivocation posts an Equivocation event of the evidence.
+func (ec *Collector) PostEquivocation(e *block.Evidence) {
+	d := &EquivocationData{
+		Witness: e.Witness(),
+		Reason:  e.Reason(),
+	}
+	for _, s := range []*block.SignedHead{e.First, e.Second} {
+		blk := s.Block()
+		d.Blocks = append(d.Blocks, &EquivocationHead{
+			Number: blk.Head.Number,
+			Hash:   common.Base58Encode(blk.HeadHash()),
+			Time:   blk.Head.Time,
+		})
+	}
+	data, err := json.Marshal(d)
+	if err != nil {
+		ilog.Errorf("marshal equivocation event failed. err=%v", err)
+		return
+	}
+	ev := NewEvent(Equivocation, string(data))
+	ev.BlockNumber = e.Second.Head.Number
+	ec.Post(ev, nil)
+}
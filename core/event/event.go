@@ -8,7 +8,7 @@ import (
 	"github.com/iost-official/go-iost/ilog"
 )
 
-// EventChSize is the size of subscriber's read channel.
+// EventChSize is the number of events buffered for a subscriber.
 const EventChSize = 100
 
 // Topic defines different event topics.
@@ -18,14 +18,32 @@ type Topic int
 const (
 	ContractReceipt Topic = iota
 	ContractEvent
+	NewBlock
+	IrreversibleBlock
+	TxDropped
+	Equivocation
 )
 
+// Overflow is the topic of the last event sent to a subscriber which falls behind.
+// Events are no longer sent to the subscriber after overflow.
+const Overflow Topic = -1
+
 func (t Topic) String() string {
 	switch t {
 	case ContractReceipt:
 		return "ContractReceipt"
 	case ContractEvent:
 		return "ContractEvent"
+	case NewBlock:
+		return "NewBlock"
+	case IrreversibleBlock:
+		return "IrreversibleBlock"
+	case TxDropped:
+		return "TxDropped"
+	case Equivocation:
+		return "Equivocation"
+	case Overflow:
+		return "Overflow"
 	default:
 		return "unknown_topic:" + strconv.Itoa(int(t))
 	}
@@ -33,9 +51,10 @@ func (t Topic) String() string {
 
 // Event is the struct sent to subscriber.
 type Event struct {
-	Topic Topic
-	Data  string
-	Time  int64
+	Topic       Topic
+	Data        string
+	Time        int64
+	BlockNumber int64
 }
 
 // NewEvent generate new event with topic and data
@@ -47,9 +66,25 @@ func NewEvent(topic Topic, data string) *Event {
 	}
 }
 
+// hasFinality returns whether the events of the topic are sent according to the finality
+// of subscription. Contract events are only posted when the block is executed, so they are
+// never sent to the subscriptions of irreversible blocks.
+func (t Topic) hasFinality() bool {
+	return t == ContractReceipt || t == ContractEvent
+}
+
+// accountsOnly returns whether only the accounts of filters are applied to the events of
+// the topic, which have no contract or function.
+func (t Topic) accountsOnly() bool {
+	return t == TxDropped
+}
+
 // Meta is the information abount event.
 type Meta struct {
-	ContractID string
+	ContractID   string
+	FuncName     string   // contract function name, such as token.iost/transfer
+	Accounts     []string // accounts involved in the event
+	Irreversible bool     // whether the event comes from an irreversible block
 }
 
 // Match checks whether the given meta argument is matched to self.
@@ -61,13 +96,58 @@ func (m *Meta) Match(meta *Meta) bool {
 	if m.ContractID != "" && m.ContractID != meta.ContractID {
 		return false
 	}
+	if m.FuncName != "" && m.FuncName != meta.FuncName {
+		return false
+	}
+	if len(m.Accounts) > 0 && !containsAny(meta.Accounts, m.Accounts) {
+		return false
+	}
 	return true
 }
 
+func (m *Meta) irreversible() bool {
+	return m != nil && m.Irreversible
+}
+
+func containsAny(s []string, targets []string) bool {
+	for _, a := range s {
+		for _, b := range targets {
+			if a == b {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // Subscription is a struct used for listening specific topics
 type Subscription struct {
 	C      chan<- *Event
 	filter *Meta
+
+	mu         *sync.Mutex
+	overflowed *bool
+}
+
+// send sends the event to the subscriber without blocking. One slot of the
+// channel is reserved for the overflow event.
+func (s *Subscription) send(e *Event) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if *s.overflowed {
+		return false
+	}
+	if len(s.C) < cap(s.C)-1 {
+		s.C <- e
+		return true
+	}
+	*s.overflowed = true
+	s.C <- &Event{
+		Topic:       Overflow,
+		Time:        time.Now().UnixNano(),
+		BlockNumber: e.BlockNumber,
+	}
+	return false
 }
 
 var ec *Collector
@@ -88,10 +168,11 @@ func GetCollector() *Collector {
 
 // Subscribe registers a subscription in event collector.
 func (ec *Collector) Subscribe(id int64, topics []Topic, filter *Meta) <-chan *Event {
-	c := make(chan *Event, EventChSize)
+	c := make(chan *Event, EventChSize+1)
+	mu, overflowed := new(sync.Mutex), new(bool)
 	for _, topic := range topics {
 		m, _ := ec.subMap.LoadOrStore(topic, new(sync.Map))
-		m.(*sync.Map).Store(id, &Subscription{c, filter})
+		m.(*sync.Map).Store(id, &Subscription{C: c, filter: filter, mu: mu, overflowed: overflowed})
 		ilog.Debugf("Subscribe id = %d, topic = %s, filter = %v", id, topic, filter)
 	}
 	return c
@@ -112,12 +193,17 @@ func (ec *Collector) sendEvent(e *Event, meta *Meta) {
 	if m, exist := ec.subMap.Load(e.Topic); exist {
 		m.(*sync.Map).Range(func(k, v interface{}) bool {
 			sub := v.(*Subscription)
-			if sub.filter != nil && !sub.filter.Match(meta) {
+			filter := sub.filter
+			if filter != nil && e.Topic.accountsOnly() {
+				filter = &Meta{Accounts: filter.Accounts}
+			}
+			if filter != nil && !filter.Match(meta) {
+				return true
+			}
+			if e.Topic.hasFinality() && sub.filter.irreversible() != meta.irreversible() {
 				return true
 			}
-			select {
-			case sub.C <- e:
-			default:
+			if !sub.send(e) {
 				ilog.Debugf("sending event failed. id=%d, topic=%s", k.(int64), e.Topic)
 			}
 			return true
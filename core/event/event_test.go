@@ -88,6 +88,7 @@ func TestEventCollectorFullPost(t *testing.T) {
 	ch := ec.Subscribe(1, []event.Topic{event.ContractEvent}, nil)
 
 	count := int32(0)
+	overflow := int32(0)
 
 	for i := 0; i < event.EventChSize+100; i++ {
 		ec.Post(event.NewEvent(event.ContractEvent, "test1"), &event.Meta{ContractID: "token.iost"})
@@ -100,6 +101,10 @@ func TestEventCollectorFullPost(t *testing.T) {
 		for {
 			select {
 			case e := <-ch:
+				if e.Topic == event.Overflow {
+					atomic.AddInt32(&overflow, 1)
+					continue
+				}
 				assert.Equal(t, event.ContractEvent, e.Topic)
 				atomic.AddInt32(&count, 1)
 			}
@@ -109,4 +114,54 @@ func TestEventCollectorFullPost(t *testing.T) {
 	time.Sleep(time.Millisecond * 100)
 
 	assert.EqualValues(t, event.EventChSize, atomic.LoadInt32(&count))
+	assert.EqualValues(t, 1, atomic.LoadInt32(&overflow))
+
+	ec.Post(event.NewEvent(event.ContractEvent, "test2"), &event.Meta{ContractID: "token.iost"})
+	time.Sleep(time.Millisecond * 100)
+	assert.EqualValues(t, event.EventChSize, atomic.LoadInt32(&count))
+	ec.Unsubscribe(1, []event.Topic{event.ContractEvent})
+}
+
+func TestEventCollectorFilter(t *testing.T) {
+	ilog.Stop()
+	ec := event.GetCollector()
+
+	topics := []event.Topic{event.ContractReceipt, event.ContractEvent}
+	topics1 := []event.Topic{event.ContractReceipt, event.ContractEvent, event.TxDropped}
+	ch1 := ec.Subscribe(11, topics1, &event.Meta{FuncName: "token.iost/transfer", Accounts: []string{"alice"}})
+	ch2 := ec.Subscribe(12, topics, &event.Meta{Irreversible: true})
+	ch3 := ec.Subscribe(13, []event.Topic{event.NewBlock}, &event.Meta{ContractID: "token.iost"})
+	defer ec.Unsubscribe(11, topics1)
+	defer ec.Unsubscribe(12, topics)
+	defer ec.Unsubscribe(13, []event.Topic{event.NewBlock})
+
+	transfer := &event.Meta{ContractID: "token.iost", FuncName: "token.iost/transfer", Accounts: []string{"bob", "alice"}}
+	ec.Post(event.NewEvent(event.ContractReceipt, "test1"), transfer)
+	ec.Post(event.NewEvent(event.ContractReceipt, "test2"), &event.Meta{ContractID: "token.iost", FuncName: "token.iost/issue", Accounts: []string{"alice"}})
+	ec.Post(event.NewEvent(event.ContractReceipt, "test3"), &event.Meta{ContractID: "token.iost", FuncName: "token.iost/transfer", Accounts: []string{"bob"}})
+	irreversible := *transfer
+	irreversible.Irreversible = true
+	ec.Post(event.NewEvent(event.ContractReceipt, "test4"), &irreversible)
+	ec.Post(event.NewEvent(event.ContractEvent, "test5"), transfer)
+	ec.Post(event.NewEvent(event.NewBlock, "test6"), nil)
+	// only the accounts are applied to dropped txs
+	ec.Post(event.NewEvent(event.TxDropped, "test7"), &event.Meta{Accounts: []string{"alice"}})
+	ec.Post(event.NewEvent(event.TxDropped, "test8"), &event.Meta{Accounts: []string{"bob"}})
+
+	time.Sleep(time.Millisecond * 100)
+
+	recv := func(ch <-chan *event.Event) []string {
+		ret := make([]string, 0)
+		for {
+			select {
+			case e := <-ch:
+				ret = append(ret, e.Data)
+			default:
+				return ret
+			}
+		}
+	}
+	assert.ElementsMatch(t, []string{"test1", "test5", "test7"}, recv(ch1))
+	assert.ElementsMatch(t, []string{"test4"}, recv(ch2))
+	assert.ElementsMatch(t, []string{"test6"}, recv(ch3))
 }
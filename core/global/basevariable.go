@@ -7,6 +7,8 @@ import (
 	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/db"
+	"github.com/iost-official/go-iost/db/kv"
+	"github.com/iost-official/go-iost/db/mvcc"
 )
 
 // TMode type of mode
@@ -37,33 +39,55 @@ func (m TMode) String() string {
 
 // BaseVariableImpl is the implementation of BaseVariable
 type BaseVariableImpl struct {
-	blockChain    block.Chain
-	stateDB       db.MVCCDB
-	mode          TMode
-	modeMutex     *sync.RWMutex
-	continuousNum int
-	config        *common.Config
+	blockChain block.Chain
+	stateDB    db.MVCCDB
+	mode       TMode
+	modeMutex  *sync.RWMutex
+	production *common.ProductionConfig
+	config     *common.Config
 }
 
 // New return a BaseVariable instance
 func New(conf *common.Config) (*BaseVariableImpl, error) {
-	blockChain, err := block.NewBlockChain(conf.DB.LdbPath + "BlockChainDB")
+	storageType, err := kv.ParseStorageType(conf.DB.StorageType)
+	if err != nil {
+		return nil, fmt.Errorf("invalid storage type, stop the program. err: %v", err)
+	}
+	blockChain, err := block.NewBlockChainWithStorage(conf.DB.LdbPath+"BlockChainDB", storageType)
 	if err != nil {
 		return nil, fmt.Errorf("new blockchain failed, stop the program. err: %v", err)
 	}
+	if conf.DB.AccountTxIndex {
+		blockChain.EnableAccountTxIndex()
+	}
+	if conf.DB.PruneBlocks > 0 {
+		blockChain.EnablePruning(conf.DB.PruneBlocks, conf.DB.PruneHeaders)
+	}
 
-	stateDB, err := db.NewMVCCDB(conf.DB.LdbPath + "StateDB")
+	cacheType, err := mvcc.ParseCacheType(conf.DB.CacheType)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cache type, stop the program. err: %v", err)
+	}
+	stateDB, err := db.NewCacheMVCCDB(conf.DB.LdbPath+"StateDB", cacheType, storageType)
 	if err != nil {
 		return nil, fmt.Errorf("new statedb failed, stop the program. err: %v", err)
 	}
+	if conf.DB.Archive {
+		stateDB.EnableArchive()
+	}
+	if conf.DB.StateRoot {
+		if err := stateDB.EnableStateRoot(); err != nil {
+			return nil, fmt.Errorf("enable state root failed, stop the program. err: %v", err)
+		}
+	}
 
 	return &BaseVariableImpl{
-		blockChain:    blockChain,
-		stateDB:       stateDB,
-		mode:          ModeInit,
-		modeMutex:     new(sync.RWMutex),
-		continuousNum: 10,
-		config:        conf,
+		blockChain: blockChain,
+		stateDB:    stateDB,
+		mode:       ModeInit,
+		modeMutex:  new(sync.RWMutex),
+		production: common.DefaultProductionConfig(),
+		config:     conf,
 	}, nil
 }
 
@@ -84,7 +108,18 @@ func (g *BaseVariableImpl) Config() *common.Config {
 
 // Continuous return the number of continue blocks
 func (g *BaseVariableImpl) Continuous() int {
-	return g.continuousNum
+	return int(g.production.BlocksPerSlot)
+}
+
+// Production return the block production parameters of the chain
+func (g *BaseVariableImpl) Production() *common.ProductionConfig {
+	return g.production
+}
+
+// SetProduction sets the block production parameters of the chain, which should be called
+// before the consensus starts.
+func (g *BaseVariableImpl) SetProduction(p *common.ProductionConfig) {
+	g.production = p
 }
 
 // Mode return the mode
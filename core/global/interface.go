@@ -16,4 +16,5 @@ type BaseVariable interface {
 	Mode() TMode
 	SetMode(m TMode)
 	Continuous() int
+	Production() *common.ProductionConfig
 }
@@ -1,6 +1,7 @@
 package merkletree
 
 import (
+	"bytes"
 	"encoding/hex"
 	"errors"
 	"github.com/iost-official/go-iost/common"
@@ -69,6 +70,28 @@ func (m *MerkleTree) MerklePath(hash []byte) ([][]byte, error) {
 	return mp, nil
 }
 
+// VerifyMerklePath checks whether the leaf is the index-th leaf of the merkle tree with the
+// given root, by hashing the leaf with the merkle path returned by MerklePath.
+func VerifyMerklePath(leaf []byte, index int64, mp [][]byte, rootHash []byte) bool {
+	if len(mp) == 0 {
+		return index == 0 && bytes.Equal(common.Sha3(append(append([]byte{}, leaf...), leaf...)), rootHash)
+	}
+	if len(mp) > 62 || index < 0 || index >= int64(1)<<uint(len(mp)) {
+		return false
+	}
+	idx := index + int64(1)<<uint(len(mp)) - 1
+	hash := leaf
+	for _, p := range mp {
+		if idx%2 == 1 {
+			hash = common.Sha3(append(append([]byte{}, hash...), p...))
+		} else {
+			hash = common.Sha3(append(append([]byte{}, p...), hash...))
+		}
+		idx = (idx - 1) / 2
+	}
+	return bytes.Equal(hash, rootHash)
+}
+
 // MerkleProve is prove of the merkle tree
 //func (m *MerkleTree) MerkleProve(hash []byte, rootHash []byte, mp [][]byte) (bool, error) {
 //	if hash == nil {
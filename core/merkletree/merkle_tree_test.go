@@ -96,3 +96,29 @@ func BenchmarkMerklePath(b *testing.B) { // 183ns
 		}
 	}
 }
+
+func TestVerifyMerklePath(t *testing.T) {
+	for n := 1; n <= 9; n++ {
+		data := make([][]byte, n)
+		for i := range data {
+			data[i] = RandHash(32)
+		}
+		m := MerkleTree{}
+		m.Build(data)
+		for i, datum := range data {
+			mp, err := m.MerklePath(datum)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !VerifyMerklePath(datum, int64(i), mp, m.RootHash()) {
+				t.Errorf("verify leaf %d of %d failed", i, n)
+			}
+			if i+1 < n && VerifyMerklePath(datum, int64(i+1), mp, m.RootHash()) {
+				t.Errorf("verify leaf %d of %d with wrong index succeeded", i, n)
+			}
+			if VerifyMerklePath(RandHash(32), int64(i), mp, m.RootHash()) {
+				t.Errorf("verify wrong leaf %d of %d succeeded", i, n)
+			}
+		}
+	}
+}
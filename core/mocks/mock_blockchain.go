@@ -34,6 +34,18 @@ func (m *MockChain) EXPECT() *MockChainMockRecorder {
 	return m.recorder
 }
 
+// AccountTxIndexEnabled mocks base method
+func (m *MockChain) AccountTxIndexEnabled() bool {
+	ret := m.ctrl.Call(m, "AccountTxIndexEnabled")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// AccountTxIndexEnabled indicates an expected call of AccountTxIndexEnabled
+func (mr *MockChainMockRecorder) AccountTxIndexEnabled() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountTxIndexEnabled", reflect.TypeOf((*MockChain)(nil).AccountTxIndexEnabled))
+}
+
 // AllDelaytx mocks base method
 func (m *MockChain) AllDelaytx() ([]*tx.Tx, error) {
 	ret := m.ctrl.Call(m, "AllDelaytx")
@@ -79,6 +91,39 @@ func (mr *MockChainMockRecorder) Draw(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Draw", reflect.TypeOf((*MockChain)(nil).Draw), arg0, arg1)
 }
 
+// EnableAccountTxIndex mocks base method
+func (m *MockChain) EnableAccountTxIndex() {
+	m.ctrl.Call(m, "EnableAccountTxIndex")
+}
+
+// EnableAccountTxIndex indicates an expected call of EnableAccountTxIndex
+func (mr *MockChainMockRecorder) EnableAccountTxIndex() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableAccountTxIndex", reflect.TypeOf((*MockChain)(nil).EnableAccountTxIndex))
+}
+
+// EnablePruning mocks base method
+func (m *MockChain) EnablePruning(arg0, arg1 int64) {
+	m.ctrl.Call(m, "EnablePruning", arg0, arg1)
+}
+
+// EnablePruning indicates an expected call of EnablePruning
+func (mr *MockChainMockRecorder) EnablePruning(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnablePruning", reflect.TypeOf((*MockChain)(nil).EnablePruning), arg0, arg1)
+}
+
+// GetAccountTxs mocks base method
+func (m *MockChain) GetAccountTxs(arg0 string, arg1, arg2 int64, arg3 int) ([]*block.AccountTx, error) {
+	ret := m.ctrl.Call(m, "GetAccountTxs", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*block.AccountTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountTxs indicates an expected call of GetAccountTxs
+func (mr *MockChainMockRecorder) GetAccountTxs(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountTxs", reflect.TypeOf((*MockChain)(nil).GetAccountTxs), arg0, arg1, arg2, arg3)
+}
+
 // GetBlockByHash mocks base method
 func (m *MockChain) GetBlockByHash(arg0 []byte) (*block.Block, error) {
 	ret := m.ctrl.Call(m, "GetBlockByHash", arg0)
@@ -105,6 +150,32 @@ func (mr *MockChainMockRecorder) GetBlockByNumber(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockByNumber", reflect.TypeOf((*MockChain)(nil).GetBlockByNumber), arg0)
 }
 
+// GetBlockByTxHash mocks base method
+func (m *MockChain) GetBlockByTxHash(arg0 []byte) (*block.Block, error) {
+	ret := m.ctrl.Call(m, "GetBlockByTxHash", arg0)
+	ret0, _ := ret[0].(*block.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockByTxHash indicates an expected call of GetBlockByTxHash
+func (mr *MockChainMockRecorder) GetBlockByTxHash(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockByTxHash", reflect.TypeOf((*MockChain)(nil).GetBlockByTxHash), arg0)
+}
+
+// GetEvidences mocks base method
+func (m *MockChain) GetEvidences(arg0 string, arg1 int) ([]*block.Evidence, error) {
+	ret := m.ctrl.Call(m, "GetEvidences", arg0, arg1)
+	ret0, _ := ret[0].([]*block.Evidence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvidences indicates an expected call of GetEvidences
+func (mr *MockChainMockRecorder) GetEvidences(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvidences", reflect.TypeOf((*MockChain)(nil).GetEvidences), arg0, arg1)
+}
+
 // GetHashByNumber mocks base method
 func (m *MockChain) GetHashByNumber(arg0 int64) ([]byte, error) {
 	ret := m.ctrl.Call(m, "GetHashByNumber", arg0)
@@ -207,6 +278,43 @@ func (mr *MockChainMockRecorder) Push(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockChain)(nil).Push), arg0)
 }
 
+// PutDelaytx mocks base method
+func (m *MockChain) PutDelaytx(arg0 *tx.Tx) error {
+	ret := m.ctrl.Call(m, "PutDelaytx", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutDelaytx indicates an expected call of PutDelaytx
+func (mr *MockChainMockRecorder) PutDelaytx(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutDelaytx", reflect.TypeOf((*MockChain)(nil).PutDelaytx), arg0)
+}
+
+// PutEvidence mocks base method
+func (m *MockChain) PutEvidence(arg0 *block.Evidence) (bool, error) {
+	ret := m.ctrl.Call(m, "PutEvidence", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutEvidence indicates an expected call of PutEvidence
+func (mr *MockChainMockRecorder) PutEvidence(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutEvidence", reflect.TypeOf((*MockChain)(nil).PutEvidence), arg0)
+}
+
+// RebuildAccountTxIndex mocks base method
+func (m *MockChain) RebuildAccountTxIndex(arg0 bool) error {
+	ret := m.ctrl.Call(m, "RebuildAccountTxIndex", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RebuildAccountTxIndex indicates an expected call of RebuildAccountTxIndex
+func (mr *MockChainMockRecorder) RebuildAccountTxIndex(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildAccountTxIndex", reflect.TypeOf((*MockChain)(nil).RebuildAccountTxIndex), arg0)
+}
+
 // Size mocks base method
 func (m *MockChain) Size() (int64, error) {
 	ret := m.ctrl.Call(m, "Size")
@@ -233,6 +341,18 @@ func (mr *MockChainMockRecorder) Top() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Top", reflect.TypeOf((*MockChain)(nil).Top))
 }
 
+// Truncate mocks base method
+func (m *MockChain) Truncate(arg0 int64) error {
+	ret := m.ctrl.Call(m, "Truncate", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Truncate indicates an expected call of Truncate
+func (mr *MockChainMockRecorder) Truncate(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Truncate", reflect.TypeOf((*MockChain)(nil).Truncate), arg0)
+}
+
 // TxTotal mocks base method
 func (m *MockChain) TxTotal() int64 {
 	ret := m.ctrl.Call(m, "TxTotal")
@@ -244,3 +364,16 @@ func (m *MockChain) TxTotal() int64 {
 func (mr *MockChainMockRecorder) TxTotal() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxTotal", reflect.TypeOf((*MockChain)(nil).TxTotal))
 }
+
+// VerifyBlock mocks base method
+func (m *MockChain) VerifyBlock(arg0 int64, arg1 bool) (*block.VerifyResult, error) {
+	ret := m.ctrl.Call(m, "VerifyBlock", arg0, arg1)
+	ret0, _ := ret[0].(*block.VerifyResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyBlock indicates an expected call of VerifyBlock
+func (mr *MockChainMockRecorder) VerifyBlock(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyBlock", reflect.TypeOf((*MockChain)(nil).VerifyBlock), arg0, arg1)
+}
@@ -84,6 +84,18 @@ func (mr *MockBaseVariableMockRecorder) Mode() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Mode", reflect.TypeOf((*MockBaseVariable)(nil).Mode))
 }
 
+// Production mocks base method
+func (m *MockBaseVariable) Production() *common.ProductionConfig {
+	ret := m.ctrl.Call(m, "Production")
+	ret0, _ := ret[0].(*common.ProductionConfig)
+	return ret0
+}
+
+// Production indicates an expected call of Production
+func (mr *MockBaseVariableMockRecorder) Production() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Production", reflect.TypeOf((*MockBaseVariable)(nil).Production))
+}
+
 // SetMode mocks base method
 func (m *MockBaseVariable) SetMode(arg0 global.TMode) {
 	m.ctrl.Call(m, "SetMode", arg0)
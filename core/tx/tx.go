@@ -19,11 +19,15 @@ import (
 const (
 	minGasRatio = 100
 	maxGasRatio = 10000
-	minGasLimit = 500000
-	maxGasLimit = 200000000
 	txSizeLimit = 65536
 )
 
+// The range of gas limit, in units of 0.01 gas.
+const (
+	MinGasLimit = 500000
+	MaxGasLimit = 200000000
+)
+
 // values
 var (
 	MaxExpiration = int64(90 * time.Second)
@@ -400,8 +404,8 @@ func (t *Tx) CheckGas() error {
 	if t.GasRatio < minGasRatio || t.GasRatio > maxGasRatio {
 		return fmt.Errorf("gas ratio illegal, should in [%v, %v]", minGasRatio/ratio, maxGasRatio/ratio)
 	}
-	if t.GasLimit < minGasLimit || t.GasLimit > maxGasLimit {
-		return fmt.Errorf("gas limit illegal, should in [%v, %v]", minGasLimit/ratio, maxGasLimit/ratio)
+	if t.GasLimit < MinGasLimit || t.GasLimit > MaxGasLimit {
+		return fmt.Errorf("gas limit illegal, should in [%v, %v]", MinGasLimit/ratio, MaxGasLimit/ratio)
 	}
 	return nil
 }
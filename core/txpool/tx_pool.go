@@ -10,6 +10,7 @@ import (
 	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/core/event"
 	"github.com/iost-official/go-iost/core/global"
 	"github.com/iost-official/go-iost/core/tx"
 	"github.com/iost-official/go-iost/ilog"
@@ -251,6 +252,7 @@ func (pool *TxPImpl) DelTx(hash []byte) error {
 func (pool *TxPImpl) DelTxList(delList []*tx.Tx) {
 	for _, t := range delList {
 		pool.pendingTx.Del(t.Hash())
+		event.GetCollector().PostTxDropped(t, "dropped by block producer")
 	}
 }
 
@@ -398,6 +400,7 @@ func (pool *TxPImpl) clearTimeoutTx() {
 	for ok {
 		if t.IsExpired(time.Now().UnixNano()) && !t.IsDefer() {
 			pool.pendingTx.Del(t.Hash())
+			event.GetCollector().PostTxDropped(t, "expired")
 		}
 		t, ok = iter.Next()
 	}
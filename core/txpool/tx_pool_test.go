@@ -82,6 +82,7 @@ func TestNewTxPImpl(t *testing.T) {
 
 		statedb := db_mock.NewMockMVCCDB(ctl)
 		statedb.EXPECT().Flush(Any()).AnyTimes().Return(nil)
+		statedb.EXPECT().FlushBlock(Any(), Any()).AnyTimes().Return(nil)
 		statedb.EXPECT().Fork().AnyTimes().Return(statedb)
 		statedb.EXPECT().Checkout(Any()).AnyTimes().Return(true)
 		statedb.EXPECT().Close().AnyTimes()
@@ -248,6 +249,7 @@ func TestNewTxPImplB(t *testing.T) {
 
 		statedb := db_mock.NewMockMVCCDB(ctl)
 		statedb.EXPECT().Flush(Any()).AnyTimes().Return(nil)
+		statedb.EXPECT().FlushBlock(Any(), Any()).AnyTimes().Return(nil)
 		statedb.EXPECT().Fork().AnyTimes().Return(statedb)
 		statedb.EXPECT().Checkout(Any()).AnyTimes().Return(true)
 		statedb.EXPECT().Close().AnyTimes()
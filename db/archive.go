@@ -0,0 +1,285 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/ilog"
+)
+
+// The layout of archive in storage. Each flushed item is kept as
+// archivePrefix + table + "/" + key + (MaxInt64 - block number), so that the
+// first version found from a block number is the latest one at that block.
+var (
+	archivePrefix    = []byte(string(SEPARATOR) + "a" + string(SEPARATOR))
+	archiveStartKey  = []byte(string(SEPARATOR) + "archive_start")
+	archiveHeightKey = []byte(string(SEPARATOR) + "archive_height")
+)
+
+// The marks of archived values.
+const (
+	archiveValueMark   byte = 'v'
+	archiveDeletedMark byte = 'd'
+)
+
+// error of archive
+var (
+	ErrArchiveDisabled = errors.New("archive mode is disabled")
+	ErrReadOnly        = errors.New("state is read only")
+)
+
+func archiveKey(key []byte, number int64) []byte {
+	k := make([]byte, 0, len(archivePrefix)+len(key)+8)
+	k = append(k, archivePrefix...)
+	k = append(k, key...)
+	return append(k, common.Int64ToBytes(math.MaxInt64-number)...)
+}
+
+// archiveKeyLimit returns the upper bound of all the versions of key.
+func archiveKeyLimit(key []byte) []byte {
+	k := make([]byte, 0, len(archivePrefix)+len(key)+9)
+	k = append(k, archivePrefix...)
+	k = append(k, key...)
+	return append(k, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0)
+}
+
+func archiveValue(item *Item) []byte {
+	if item.deleted {
+		return []byte{archiveDeletedMark}
+	}
+	return append([]byte{archiveValueMark}, item.value...)
+}
+
+func (m *CacheMVCCDB) archiveNumber(key []byte) (int64, bool, error) {
+	v, err := m.storage.Get(key)
+	if err != nil {
+		return 0, false, err
+	}
+	if len(v) != 8 {
+		return 0, false, nil
+	}
+	return common.BytesToInt64(v), true, nil
+}
+
+// EnableArchive makes the blocks flushed by FlushBlock kept in archive.
+func (m *CacheMVCCDB) EnableArchive() {
+	m.rwmu.Lock()
+	defer m.rwmu.Unlock()
+
+	m.archive = true
+}
+
+func (m *CacheMVCCDB) archiveEnabled() bool {
+	m.rwmu.RLock()
+	defer m.rwmu.RUnlock()
+
+	return m.archive
+}
+
+// prepareArchive prepares the archive for block number before the batches of the
+// block, and returns the start of the archive which is put in the last batch of the
+// block. If the previous block isn't archived, the archive restarts from the persisted
+// state, which is the state of the previous block. The state is copied in batches of
+// flushBatchSize, and the copy is restarted if it's interrupted, since the start isn't
+// written until the block is flushed.
+func (m *CacheMVCCDB) prepareArchive(number int64) (int64, error) {
+	height, ok, err := m.archiveNumber(archiveHeightKey)
+	if err != nil {
+		return 0, err
+	}
+	if ok && height == number-1 {
+		start, ok, err := m.archiveNumber(archiveStartKey)
+		if err != nil || ok {
+			return start, err
+		}
+	}
+	if number == 0 {
+		return 0, nil
+	}
+	start := number - 1
+	ilog.Infof("archive the state at block %d", start)
+	count := 0
+	if err := m.storage.BeginBatch(); err != nil {
+		return 0, err
+	}
+	for _, r := range [][2][]byte{{nil, []byte{SEPARATOR}}, {[]byte{SEPARATOR + 1}, nil}} {
+		iter := m.storage.NewIteratorByRange(r[0], r[1])
+		for iter.Next() {
+			err := m.storage.Put(archiveKey(iter.Key(), start), append([]byte{archiveValueMark}, iter.Value()...))
+			if err == nil {
+				if count++; count%flushBatchSize == 0 {
+					if err = m.storage.CommitBatch(); err == nil {
+						err = m.storage.BeginBatch()
+					}
+				}
+			}
+			if err != nil {
+				iter.Release()
+				return 0, err
+			}
+		}
+		iter.Release()
+		if err := iter.Error(); err != nil {
+			return 0, err
+		}
+	}
+	return start, m.storage.CommitBatch()
+}
+
+// putArchiveRange puts the start and the height of the archive in the current batch.
+func (m *CacheMVCCDB) putArchiveRange(start int64, height int64) error {
+	if err := m.storage.Put(archiveStartKey, common.Int64ToBytes(start)); err != nil {
+		return err
+	}
+	return m.storage.Put(archiveHeightKey, common.Int64ToBytes(height))
+}
+
+// StateAt returns the read only state after the block of number is applied.
+func (m *CacheMVCCDB) StateAt(number int64) (*StateView, error) {
+	if !m.archiveEnabled() {
+		return nil, ErrArchiveDisabled
+	}
+	// the archive of the pending flushes is readable after they are written
+	if err := m.flusher.wait(); err != nil {
+		return nil, err
+	}
+	start, ok, err := m.archiveNumber(archiveStartKey)
+	if err != nil {
+		return nil, err
+	}
+	height, ok2, err := m.archiveNumber(archiveHeightKey)
+	if err != nil {
+		return nil, err
+	}
+	if !ok || !ok2 {
+		return nil, fmt.Errorf("block %d is not archived, the archive is empty", number)
+	}
+	if number < start || number > height {
+		return nil, fmt.Errorf("block %d is not archived, the archive holds blocks [%d, %d]", number, start, height)
+	}
+	return &StateView{m: m, number: number}, nil
+}
+
+// RollbackTo rewrites the flushed state to the state after the block of number, which is
+// read from archive, and tags it with t. The archive after the block is dropped. The state
+// db must not be in use.
+func (m *CacheMVCCDB) RollbackTo(t string, number int64) error {
+	view, err := m.StateAt(number)
+	if err != nil {
+		return err
+	}
+	// the items changed after the block are restored, the others are unchanged
+	changed := make(map[string]bool)
+	var dropped [][]byte
+	iter := m.storage.NewIteratorByPrefix(archivePrefix)
+	for iter.Next() {
+		k := iter.Key()
+		if len(k) < len(archivePrefix)+8 {
+			continue
+		}
+		if math.MaxInt64-common.BytesToInt64(k[len(k)-8:]) > number {
+			changed[string(k[len(archivePrefix):len(k)-8])] = true
+			dropped = append(dropped, append([]byte{}, k...))
+		}
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	if err := m.storage.BeginBatch(); err != nil {
+		return err
+	}
+	for k := range changed {
+		v, ok, err := view.get([]byte(k))
+		if err != nil {
+			m.storage.CommitBatch()
+			return err
+		}
+		if ok {
+			m.storage.Put([]byte(k), v)
+		} else {
+			m.storage.Delete([]byte(k))
+		}
+	}
+	for _, k := range dropped {
+		m.storage.Delete(k)
+	}
+	m.storage.Put(archiveHeightKey, common.Int64ToBytes(number))
+	m.storage.Put(tagKey, []byte(t))
+	if err := m.storage.CommitBatch(); err != nil {
+		return err
+	}
+
+	m.rwmu.Lock()
+	m.cm.AddTag(m.head, t)
+	m.rwmu.Unlock()
+	return m.reloadStateTree()
+}
+
+// StateView is the read only state at a block, which is read from archive.
+type StateView struct {
+	m      *CacheMVCCDB
+	number int64
+}
+
+// Number returns the block number of the state.
+func (s *StateView) Number() int64 {
+	return s.number
+}
+
+// Get returns the value of specify key and table
+func (s *StateView) Get(table string, key string) (string, error) {
+	if !s.m.isValidTable(table) {
+		return "", ErrTableNotValid
+	}
+	v, _, err := s.get([]byte(table + string(SEPARATOR) + key))
+	return string(v), err
+}
+
+// get returns the value of the item and whether it exists.
+func (s *StateView) get(k []byte) ([]byte, bool, error) {
+	iter := s.m.storage.NewIteratorByRange(archiveKey(k, s.number), archiveKeyLimit(k))
+	defer iter.Release()
+	for iter.Next() {
+		if len(iter.Key()) != len(archivePrefix)+len(k)+8 {
+			continue
+		}
+		v := iter.Value()
+		if len(v) == 0 || v[0] != archiveValueMark {
+			return nil, false, nil
+		}
+		return v[1:], true, nil
+	}
+	if err := iter.Error(); err != nil {
+		return nil, false, fmt.Errorf("failed to get from archive: %v", err)
+	}
+	return nil, false, nil
+}
+
+// Has returns whether the specified key exists in the table
+func (s *StateView) Has(table string, key string) (bool, error) {
+	v, err := s.Get(table, key)
+	if err != nil {
+		return false, err
+	}
+	return v != "", nil
+}
+
+// Put returns ErrReadOnly
+func (s *StateView) Put(table string, key string, value string) error {
+	return ErrReadOnly
+}
+
+// Del returns ErrReadOnly
+func (s *StateView) Del(table string, key string) error {
+	return ErrReadOnly
+}
+
+// Commit does nothing
+func (s *StateView) Commit() {}
+
+// Rollback does nothing
+func (s *StateView) Rollback() {}
@@ -0,0 +1,406 @@
+package db
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/ilog"
+	"github.com/iost-official/go-iost/metrics"
+)
+
+// A flush writes the items changed since the last flushed commit. A flush with more items
+// than flushBatchSize is written in several batches. Before the items are overwritten, their
+// old values are saved as undoPrefix + key, and flushingKey is set to the tag being flushed.
+// The tag and flushingKey are updated in the last batch, so the state interrupted in the
+// middle is restored from the undo log on opening.
+var (
+	flushingKey = []byte(string(SEPARATOR) + "flushing")
+	undoPrefix  = []byte(string(SEPARATOR) + "u" + string(SEPARATOR))
+)
+
+const (
+	flushBatchSize = 10000 // the max number of items written in a batch
+	flushQueueSize = 64    // the max number of pending flushes, Flush blocks if it's full
+)
+
+// The marks of undo values.
+const (
+	undoValueMark   byte = 'v'
+	undoDeletedMark byte = 'd'
+)
+
+// ErrClosed is returned when flushing a closed mvccdb.
+var ErrClosed = errors.New("mvccdb is closed")
+
+var (
+	metricsFlushLatency = metrics.NewSummary("iost_statedb_flush_latency", nil) // in milliseconds
+	metricsFlushSize    = metrics.NewSummary("iost_statedb_flush_size", nil)    // the number of items
+	metricsFlushPending = metrics.NewGauge("iost_statedb_flush_pending", nil)
+)
+
+type flushRequest struct {
+	commit  *Commit
+	tag     string
+	number  int64
+	archive bool
+	root    []byte        // the state root of commit, nil if the state root is disabled
+	fn      func() error  // the request without commit runs fn after the former ones are done
+	err     error         // the error of fn
+	done    chan struct{} // the request without commit is closed when fn returns
+}
+
+// flusher writes the flushed commits into storage in order, which is shared by the forks.
+type flusher struct {
+	m       *CacheMVCCDB
+	queue   chan *flushRequest
+	closeMu sync.RWMutex
+	closed  bool
+	errMu   sync.Mutex
+	err     error
+	wg      sync.WaitGroup
+}
+
+func newFlusher(m *CacheMVCCDB) *flusher {
+	f := &flusher{
+		m:     m,
+		queue: make(chan *flushRequest, flushQueueSize),
+	}
+	f.wg.Add(1)
+	go f.loop()
+	return f
+}
+
+func (f *flusher) loop() {
+	defer f.wg.Done()
+	for r := range f.queue {
+		metricsFlushPending.Set(float64(len(f.queue)), nil)
+		if r.commit == nil {
+			if r.fn != nil {
+				r.err = r.fn()
+			}
+			close(r.done)
+			continue
+		}
+		// the following flushes are skipped after a failure, as they depend on it
+		if f.error() != nil {
+			continue
+		}
+		start := time.Now()
+		n, err := f.m.writeFlush(r)
+		if err != nil {
+			ilog.Errorf("flush state of %v failed: %v", common.Base58Encode([]byte(r.tag)), err)
+			f.errMu.Lock()
+			f.err = err
+			f.errMu.Unlock()
+			continue
+		}
+		metricsFlushLatency.Observe(float64(time.Since(start).Nanoseconds())/1e6, nil)
+		metricsFlushSize.Observe(float64(n), nil)
+	}
+}
+
+func (f *flusher) error() error {
+	f.errMu.Lock()
+	defer f.errMu.Unlock()
+
+	return f.err
+}
+
+// push queues the request, and returns the error of the former flushes.
+func (f *flusher) push(r *flushRequest) error {
+	f.closeMu.RLock()
+	defer f.closeMu.RUnlock()
+
+	if f.closed {
+		return ErrClosed
+	}
+	if err := f.error(); err != nil {
+		return err
+	}
+	f.queue <- r
+	metricsFlushPending.Set(float64(len(f.queue)), nil)
+	return nil
+}
+
+// wait waits for the pending flushes, and returns the error of them.
+func (f *flusher) wait() error {
+	return f.do(nil)
+}
+
+// do runs fn in the flusher after the pending flushes, so that fn sees the flushed state
+// and no flush runs at the same time. It returns the error of the flushes or fn.
+func (f *flusher) do(fn func() error) error {
+	r := &flushRequest{fn: fn, done: make(chan struct{})}
+	if err := f.push(r); err != nil {
+		return err
+	}
+	<-r.done
+	if err := f.error(); err != nil {
+		return err
+	}
+	return r.err
+}
+
+// close waits for the pending flushes and stops the flusher.
+func (f *flusher) close() error {
+	f.closeMu.Lock()
+	if f.closed {
+		f.closeMu.Unlock()
+		return f.error()
+	}
+	f.closed = true
+	close(f.queue)
+	f.closeMu.Unlock()
+
+	f.wg.Wait()
+	return f.error()
+}
+
+// changedItems returns the items changed after the last flushed commit to the commit,
+// which are sorted by key.
+func (m *CacheMVCCDB) changedItems(commit *Commit) []*Item {
+	last := m.cm.First()
+	changed := make(map[string]*Item)
+	for c := commit; c != nil && c != last; c = c.parent {
+		for k, item := range c.dirtyItems() {
+			if _, ok := changed[k]; !ok {
+				changed[k] = item
+			}
+		}
+	}
+	keys := make([]string, 0, len(changed))
+	for k := range changed {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	items := make([]*Item, 0, len(keys))
+	for _, k := range keys {
+		items = append(items, changed[k])
+	}
+	return items
+}
+
+// writeFlush writes the items changed by the flushed commit, and returns the number of them.
+func (m *CacheMVCCDB) writeFlush(r *flushRequest) (int, error) {
+	changes, err := m.stateTreeChanges(r.commit)
+	if err != nil {
+		return 0, err
+	}
+	if changes != nil {
+		// the new nodes are written first, which are garbage if the flush is interrupted
+		if err := m.writeNodes(changes.added); err != nil {
+			return 0, err
+		}
+		r.root = changes.tree.Root()
+	}
+	items := m.changedItems(r.commit)
+	if len(items) <= flushBatchSize {
+		err = m.writeBatch(r, items)
+	} else {
+		err = m.writeBatches(r, items)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	m.roots.mu.Lock()
+	defer m.roots.mu.Unlock()
+	m.cm.FreeBefore(r.commit)
+	if changes != nil {
+		changes.tree.Rebase(m.roots.store)
+		if err := m.deleteNodes(changes.removed); err != nil {
+			return 0, err
+		}
+	}
+	return len(items), nil
+}
+
+// putItem writes the item, and its archive if the block is archived.
+func (m *CacheMVCCDB) putItem(r *flushRequest, item *Item) error {
+	k := []byte(item.table + string(SEPARATOR) + item.key)
+	if r.archive {
+		if err := m.storage.Put(archiveKey(k, r.number), archiveValue(item)); err != nil {
+			return err
+		}
+	}
+	if item.deleted {
+		return m.storage.Delete(k)
+	}
+	return m.storage.Put(k, []byte(item.value))
+}
+
+// writeBatch writes the items with the tag in a batch.
+func (m *CacheMVCCDB) writeBatch(r *flushRequest, items []*Item) error {
+	var archiveStart int64
+	if r.archive {
+		var err error
+		if archiveStart, err = m.prepareArchive(r.number); err != nil {
+			return err
+		}
+	}
+	if err := m.storage.BeginBatch(); err != nil {
+		return err
+	}
+	if err := m.storage.Put(tagKey, []byte(r.tag)); err != nil {
+		return err
+	}
+	if err := m.putStateRoot(r.root, r.tag); err != nil {
+		return err
+	}
+	if r.archive {
+		if err := m.putArchiveRange(archiveStart, r.number); err != nil {
+			return err
+		}
+	}
+	for _, item := range items {
+		if err := m.putItem(r, item); err != nil {
+			return err
+		}
+	}
+	return m.storage.CommitBatch()
+}
+
+// saveUndo saves the old value of the key into the undo log in the current batch.
+func (m *CacheMVCCDB) saveUndo(key []byte) error {
+	old, err := m.storage.Get(key)
+	if err != nil {
+		return err
+	}
+	undo := []byte{undoDeletedMark}
+	if len(old) > 0 {
+		undo = append([]byte{undoValueMark}, old...)
+	}
+	return m.storage.Put(append(append([]byte{}, undoPrefix...), key...), undo)
+}
+
+// writeBatches writes the items in batches of flushBatchSize, with the undo log of them.
+func (m *CacheMVCCDB) writeBatches(r *flushRequest, items []*Item) error {
+	var archiveStart int64
+	if r.archive {
+		var err error
+		if archiveStart, err = m.prepareArchive(r.number); err != nil {
+			return err
+		}
+	}
+	if err := m.storage.BeginBatch(); err != nil {
+		return err
+	}
+	if err := m.storage.Put(flushingKey, []byte(r.tag)); err != nil {
+		return err
+	}
+	if err := m.storage.CommitBatch(); err != nil {
+		return err
+	}
+
+	for start := 0; start < len(items); start += flushBatchSize {
+		end := start + flushBatchSize
+		if end > len(items) {
+			end = len(items)
+		}
+		if err := m.storage.BeginBatch(); err != nil {
+			return err
+		}
+		for _, item := range items[start:end] {
+			k := []byte(item.table + string(SEPARATOR) + item.key)
+			if err := m.saveUndo(k); err != nil {
+				return err
+			}
+			if r.archive {
+				if err := m.saveUndo(archiveKey(k, r.number)); err != nil {
+					return err
+				}
+			}
+			if err := m.putItem(r, item); err != nil {
+				return err
+			}
+		}
+		if err := m.storage.CommitBatch(); err != nil {
+			return err
+		}
+	}
+
+	if err := m.storage.BeginBatch(); err != nil {
+		return err
+	}
+	if err := m.storage.Put(tagKey, []byte(r.tag)); err != nil {
+		return err
+	}
+	if err := m.putStateRoot(r.root, r.tag); err != nil {
+		return err
+	}
+	if r.archive {
+		if err := m.putArchiveRange(archiveStart, r.number); err != nil {
+			return err
+		}
+	}
+	if err := m.storage.Delete(flushingKey); err != nil {
+		return err
+	}
+	if err := m.storage.CommitBatch(); err != nil {
+		return err
+	}
+	return m.replayUndo(false)
+}
+
+// replayUndo removes the undo log in batches. If restore is true, the old values in the
+// undo log are written back before they are removed.
+func (m *CacheMVCCDB) replayUndo(restore bool) error {
+	for {
+		var keys, values [][]byte
+		iter := m.storage.NewIteratorByPrefix(undoPrefix)
+		for len(keys) < flushBatchSize && iter.Next() {
+			keys = append(keys, append([]byte{}, iter.Key()...))
+			values = append(values, append([]byte{}, iter.Value()...))
+		}
+		iter.Release()
+		if err := iter.Error(); err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			return nil
+		}
+		if err := m.storage.BeginBatch(); err != nil {
+			return err
+		}
+		for i, k := range keys {
+			if restore && len(values[i]) > 0 {
+				key := k[len(undoPrefix):]
+				var err error
+				if values[i][0] == undoValueMark {
+					err = m.storage.Put(key, values[i][1:])
+				} else {
+					err = m.storage.Delete(key)
+				}
+				if err != nil {
+					return err
+				}
+			}
+			if err := m.storage.Delete(k); err != nil {
+				return err
+			}
+		}
+		if err := m.storage.CommitBatch(); err != nil {
+			return err
+		}
+	}
+}
+
+// recoverFlush restores the state interrupted in the middle of a flush to the last flushed
+// state, and removes the undo log left by the finished flush.
+func (m *CacheMVCCDB) recoverFlush() error {
+	flushing, err := m.storage.Get(flushingKey)
+	if err != nil {
+		return err
+	}
+	if len(flushing) == 0 {
+		return m.replayUndo(false)
+	}
+	ilog.Warnf("the flush of state %v is interrupted, restore the last flushed state", common.Base58Encode(flushing))
+	if err := m.replayUndo(true); err != nil {
+		return err
+	}
+	return m.storage.Delete(flushingKey)
+}
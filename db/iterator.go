@@ -0,0 +1,137 @@
+package db
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/iost-official/go-iost/db/kv"
+)
+
+// Iterator iterates the items of a table in the order of keys
+type Iterator interface {
+	Next() bool
+	Key() string
+	Value() string
+	Error() error
+	Release()
+}
+
+// cacheIterator merges the sorted items in cache into the iterator of storage, the item
+// in cache takes the place of the one with the same key in storage.
+type cacheIterator struct {
+	table   string
+	items   []*Item
+	storage *kv.Iterator
+	next    []byte // the key of the storage iterator, nil if it's exhausted
+	key     string
+	value   string
+	err     error
+}
+
+func commonPrefix(a, b string) string {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return a[:i]
+}
+
+// NewIterator returns the iterator of the items in table whose keys are in [start, end),
+// an empty end means the end of table. The iterator reads the state at the time of
+// creating, and must be released after use.
+func (m *CacheMVCCDB) NewIterator(table string, start string, end string) (Iterator, error) {
+	if !m.isValidTable(table) {
+		return nil, ErrTableNotValid
+	}
+	if end != "" && end <= start {
+		return nil, fmt.Errorf("invalid range [%v, %v)", start, end)
+	}
+	prefix := table + string(SEPARATOR)
+	limit := []byte(table + string(SEPARATOR+1))
+	if end != "" {
+		prefix += commonPrefix(start, end)
+		limit = []byte(table + string(SEPARATOR) + end)
+	}
+	latest := make(map[string]*Item)
+	// the items of the child commits come after the ones of their parents
+	for _, v := range m.stage.All([]byte(prefix)) {
+		item, ok := v.(*Item)
+		if !ok {
+			return nil, fmt.Errorf("can't assert Item type")
+		}
+		if item.key >= start && (end == "" || item.key < end) {
+			latest[item.key] = item
+		}
+	}
+	items := make([]*Item, 0, len(latest))
+	for _, item := range latest {
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].key < items[j].key })
+
+	iter := &cacheIterator{
+		table:   table,
+		items:   items,
+		storage: m.storage.NewIteratorByRange([]byte(table+string(SEPARATOR)+start), limit),
+	}
+	iter.nextStorage()
+	return iter, nil
+}
+
+func (i *cacheIterator) nextStorage() {
+	i.next = nil
+	if i.storage.Next() {
+		i.next = i.storage.Key()
+	}
+}
+
+// Next moves to the next item, and returns false if there is no more item.
+func (i *cacheIterator) Next() bool {
+	for i.err == nil {
+		storageKey := ""
+		if i.next != nil {
+			storageKey = string(i.next[len(i.table)+1:])
+		}
+		switch {
+		case len(i.items) > 0 && (i.next == nil || i.items[0].key <= storageKey):
+			item := i.items[0]
+			i.items = i.items[1:]
+			if i.next != nil && item.key == storageKey {
+				i.nextStorage()
+			}
+			if item.deleted {
+				continue
+			}
+			i.key, i.value = item.key, item.value
+			return true
+		case i.next != nil:
+			i.key, i.value = storageKey, string(i.storage.Value())
+			i.nextStorage()
+			return true
+		default:
+			i.err = i.storage.Error()
+			return false
+		}
+	}
+	return false
+}
+
+// Key returns the key of the current item.
+func (i *cacheIterator) Key() string {
+	return i.key
+}
+
+// Value returns the value of the current item.
+func (i *cacheIterator) Value() string {
+	return i.value
+}
+
+// Error returns the error of iterating.
+func (i *cacheIterator) Error() error {
+	return i.err
+}
+
+// Release releases the iterator of storage.
+func (i *cacheIterator) Release() {
+	i.storage.Release()
+}
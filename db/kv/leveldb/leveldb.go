@@ -112,6 +112,11 @@ func (d *DB) Size() (int64, error) {
 	return total, nil
 }
 
+// Compact compacts the whole key range of leveldb
+func (d *DB) Compact() error {
+	return d.db.CompactRange(util.Range{})
+}
+
 // Close will close the database
 func (d *DB) Close() error {
 	return d.db.Close()
@@ -125,6 +130,14 @@ func (d *DB) NewIteratorByPrefix(prefix []byte) interface{} {
 	}
 }
 
+// NewIteratorByRange returns a new iterator by range
+func (d *DB) NewIteratorByRange(start []byte, limit []byte) interface{} {
+	iter := d.db.NewIterator(&util.Range{Start: start, Limit: limit}, nil)
+	return &Iter{
+		iter: iter,
+	}
+}
+
 // Iter is the iterator for leveldb
 type Iter struct {
 	iter iterator.Iterator
@@ -0,0 +1,148 @@
+package lsm
+
+import (
+	"bytes"
+
+	"github.com/syndtr/goleveldb/leveldb/iterator"
+)
+
+// source is a sorted stream of records, including the deleted ones.
+type source interface {
+	next() bool
+	current() (key []byte, rec []byte)
+	error() error
+	release()
+}
+
+type memSource struct {
+	iter iterator.Iterator
+}
+
+func (s *memSource) next() bool {
+	return s.iter.Next()
+}
+
+func (s *memSource) current() ([]byte, []byte) {
+	return s.iter.Key(), s.iter.Value()
+}
+
+func (s *memSource) error() error {
+	return s.iter.Error()
+}
+
+func (s *memSource) release() {
+	s.iter.Release()
+}
+
+type tableSource struct {
+	*tableIterator
+}
+
+func (s tableSource) current() ([]byte, []byte) {
+	return s.key, s.rec
+}
+
+func (s tableSource) error() error {
+	return s.err
+}
+
+// Iter merges the memtable and tables, the record of the newest source wins when
+// a key exists in several sources.
+type Iter struct {
+	sources     []source
+	valid       []bool
+	started     bool
+	keepDeleted bool
+	key         []byte
+	rec         []byte
+	err         error
+}
+
+// newIter returns the merged iterator of sources, which are ordered from the newest to the oldest.
+func newIter(sources []source, keepDeleted bool) *Iter {
+	return &Iter{
+		sources:     sources,
+		valid:       make([]bool, len(sources)),
+		keepDeleted: keepDeleted,
+	}
+}
+
+// Next do next item of iterator
+func (it *Iter) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if !it.started {
+		it.started = true
+		for i := range it.sources {
+			it.advance(i)
+		}
+	}
+	for it.err == nil {
+		var key, rec []byte
+		found := false
+		for i, s := range it.sources {
+			if !it.valid[i] {
+				continue
+			}
+			k, r := s.current()
+			if !found || bytes.Compare(k, key) < 0 {
+				key, rec, found = k, r, true
+			}
+		}
+		if !found {
+			break
+		}
+		key = append([]byte(nil), key...)
+		rec = append([]byte(nil), rec...)
+		for i, s := range it.sources {
+			if !it.valid[i] {
+				continue
+			}
+			if k, _ := s.current(); bytes.Equal(k, key) {
+				it.advance(i)
+			}
+		}
+		if rec[0] == kindDelete && !it.keepDeleted {
+			continue
+		}
+		it.key, it.rec = key, rec
+		return true
+	}
+	it.key, it.rec = nil, nil
+	return false
+}
+
+func (it *Iter) advance(i int) {
+	it.valid[i] = it.sources[i].next()
+	if !it.valid[i] && it.err == nil {
+		it.err = it.sources[i].error()
+	}
+}
+
+// Key returns the key of current item
+func (it *Iter) Key() []byte {
+	return it.key
+}
+
+// Value returns the value of current item
+func (it *Iter) Value() []byte {
+	if it.rec == nil {
+		return nil
+	}
+	return it.rec[1:]
+}
+
+// Error returns the error of iterator
+func (it *Iter) Error() error {
+	return it.err
+}
+
+// Release will release the iterator
+func (it *Iter) Release() {
+	for _, s := range it.sources {
+		s.release()
+	}
+	it.sources = nil
+	it.valid = nil
+}
@@ -0,0 +1,140 @@
+package lsm
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"os"
+)
+
+// The kinds of records, a record is the kind followed by the value.
+const (
+	kindDelete byte = 0
+	kindPut    byte = 1
+)
+
+const journalHeaderSize = 8
+
+// batch is a list of encoded entries which are written atomically.
+type batch struct {
+	data []byte
+}
+
+func (b *batch) put(key []byte, value []byte) {
+	b.data = appendEntry(b.data, key, kindPut, value)
+}
+
+func (b *batch) delete(key []byte) {
+	b.data = appendEntry(b.data, key, kindDelete, nil)
+}
+
+// replay calls fn with the key and record of every entry in the batch.
+func (b *batch) replay(fn func(key []byte, rec []byte) error) error {
+	for data := b.data; len(data) > 0; {
+		key, rec, n, err := decodeEntry(data)
+		if err != nil {
+			return err
+		}
+		if err := fn(key, rec); err != nil {
+			return err
+		}
+		data = data[n:]
+	}
+	return nil
+}
+
+// appendEntry appends the entry of key and the record made of kind and value to dst.
+func appendEntry(dst []byte, key []byte, kind byte, value []byte) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	dst = append(dst, buf[:binary.PutUvarint(buf[:], uint64(len(key)))]...)
+	dst = append(dst, key...)
+	dst = append(dst, buf[:binary.PutUvarint(buf[:], uint64(len(value)+1))]...)
+	dst = append(dst, kind)
+	return append(dst, value...)
+}
+
+// decodeEntry returns the key, record and encoded length of the first entry in data.
+func decodeEntry(data []byte) (key []byte, rec []byte, n int, err error) {
+	keyLen, i := binary.Uvarint(data)
+	if i <= 0 || uint64(len(data)-i) < keyLen {
+		return nil, nil, 0, ErrCorrupted
+	}
+	key = data[i : i+int(keyLen)]
+	n = i + int(keyLen)
+	recLen, i := binary.Uvarint(data[n:])
+	if i <= 0 || recLen == 0 || uint64(len(data)-n-i) < recLen {
+		return nil, nil, 0, ErrCorrupted
+	}
+	rec = data[n+i : n+i+int(recLen)]
+	return key, rec, n + i + int(recLen), nil
+}
+
+// journal is the write ahead log of the memtable. Every batch is a record of
+// the checksum and length followed by the encoded entries.
+type journal struct {
+	f    *os.File
+	size int64
+}
+
+// openJournal replays the journal of path by fn and opens it for appending.
+// The torn record at the tail, left by a crash during writing, is truncated.
+func openJournal(path string, fn func(key []byte, rec []byte) error) (*journal, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	size, err := replayJournal(f, fn)
+	if err == nil {
+		err = f.Truncate(size)
+	}
+	if err == nil {
+		_, err = f.Seek(size, io.SeekStart)
+	}
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &journal{f: f, size: size}, nil
+}
+
+// replayJournal returns the size of the valid records.
+func replayJournal(r io.Reader, fn func(key []byte, rec []byte) error) (int64, error) {
+	var size int64
+	header := make([]byte, journalHeaderSize)
+	for {
+		if _, err := io.ReadFull(r, header); err != nil {
+			return size, nil
+		}
+		data := make([]byte, binary.LittleEndian.Uint32(header[4:]))
+		if _, err := io.ReadFull(r, data); err != nil {
+			return size, nil
+		}
+		if crc32.ChecksumIEEE(data) != binary.LittleEndian.Uint32(header) {
+			return size, nil
+		}
+		b := &batch{data: data}
+		if err := b.replay(fn); err != nil {
+			return 0, err
+		}
+		size += int64(journalHeaderSize + len(data))
+	}
+}
+
+func (j *journal) write(b *batch) error {
+	record := make([]byte, journalHeaderSize, journalHeaderSize+len(b.data))
+	binary.LittleEndian.PutUint32(record, crc32.ChecksumIEEE(b.data))
+	binary.LittleEndian.PutUint32(record[4:], uint32(len(b.data)))
+	record = append(record, b.data...)
+	if _, err := j.f.Write(record); err != nil {
+		// drops the partial record so that the following records are still replayable
+		j.f.Truncate(j.size)
+		j.f.Seek(j.size, io.SeekStart)
+		return err
+	}
+	j.size += int64(len(record))
+	return nil
+}
+
+func (j *journal) close() error {
+	return j.f.Close()
+}
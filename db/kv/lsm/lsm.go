@@ -0,0 +1,626 @@
+// Package lsm is a pure-Go log-structured merge tree storage engine.
+//
+// Writes go to the journal and the memtable, which is flushed to an immutable sorted
+// table when it is full. Tables are merged in background by size-tiered compaction, in
+// which the newest tables of similar sizes are merged into one, so that the number of
+// tables and the write amplification both stay low.
+package lsm
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/syndtr/goleveldb/leveldb/comparer"
+	"github.com/syndtr/goleveldb/leveldb/filter"
+	"github.com/syndtr/goleveldb/leveldb/memdb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// Errors of lsm db.
+var (
+	ErrCorrupted = errors.New("lsm: corrupted data")
+	ErrClosed    = errors.New("lsm: db is closed")
+)
+
+const manifestName = "MANIFEST"
+
+// Options is the options of lsm db.
+type Options struct {
+	// MemtableSize is the size of memtable in bytes, above which the memtable is flushed to a table.
+	MemtableSize int
+	// BlockSize is the size of data blocks of tables in bytes.
+	BlockSize int
+	// BloomBitsPerKey is the bits per key of the bloom filters of tables.
+	BloomBitsPerKey int
+	// CompactionTrigger is the number of tables which triggers a compaction.
+	CompactionTrigger int
+}
+
+// DefaultOptions is the default options of lsm db.
+var DefaultOptions = Options{
+	MemtableSize:      4 << 20,
+	BlockSize:         4 << 10,
+	BloomBitsPerKey:   10,
+	CompactionTrigger: 4,
+}
+
+// DB is the lsm database
+type DB struct {
+	path    string
+	opts    Options
+	bloom   filter.Filter
+	mu      sync.RWMutex
+	mem     *memdb.DB
+	journal *journal
+	logNum  uint64
+	nextNum uint64
+	tables  []*table
+	batch   *batch
+	closed  bool
+
+	// the compaction runs in background one at a time, and merges the tables without the lock
+	compactMu  sync.Mutex
+	compactWg  sync.WaitGroup
+	compacting bool
+	compactErr error
+}
+
+// NewDB return new lsm db with the default options
+func NewDB(path string) (*DB, error) {
+	return Open(path, DefaultOptions)
+}
+
+// Open opens the lsm db of path, the zero fields of opts are set to the default.
+func Open(path string, opts Options) (*DB, error) {
+	if opts.MemtableSize <= 0 {
+		opts.MemtableSize = DefaultOptions.MemtableSize
+	}
+	if opts.BlockSize <= 0 {
+		opts.BlockSize = DefaultOptions.BlockSize
+	}
+	if opts.BloomBitsPerKey <= 0 {
+		opts.BloomBitsPerKey = DefaultOptions.BloomBitsPerKey
+	}
+	if opts.CompactionTrigger < 2 {
+		opts.CompactionTrigger = DefaultOptions.CompactionTrigger
+	}
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return nil, err
+	}
+	d := &DB{
+		path:  path,
+		opts:  opts,
+		bloom: filter.NewBloomFilter(opts.BloomBitsPerKey),
+		mem:   memdb.New(comparer.DefaultComparer, 0),
+	}
+	tableNums, err := d.readManifest()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %v", err)
+	}
+	for _, num := range tableNums {
+		t, err := openTable(d.fileName(num, "sst"), num, d.bloom)
+		if err != nil {
+			d.releaseTables()
+			return nil, fmt.Errorf("failed to open table %v: %v", num, err)
+		}
+		d.tables = append(d.tables, t)
+	}
+	if d.logNum == 0 {
+		d.logNum = d.newFileNum()
+		if err := d.writeManifest(); err != nil {
+			d.releaseTables()
+			return nil, err
+		}
+	}
+	d.journal, err = openJournal(d.fileName(d.logNum, "log"), d.mem.Put)
+	if err != nil {
+		d.releaseTables()
+		return nil, fmt.Errorf("failed to open journal: %v", err)
+	}
+	d.removeObsoleteFiles()
+	return d, nil
+}
+
+func (d *DB) fileName(num uint64, ext string) string {
+	return filepath.Join(d.path, fmt.Sprintf("%06d.%v", num, ext))
+}
+
+func (d *DB) newFileNum() uint64 {
+	d.nextNum++
+	return d.nextNum
+}
+
+// readManifest reads the journal number, the last file number and the table numbers
+// ordered from the newest to the oldest. A missing manifest means an empty db.
+func (d *DB) readManifest() ([]uint64, error) {
+	f, err := os.Open(filepath.Join(d.path, manifestName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var tables []uint64
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			return nil, ErrCorrupted
+		}
+		num, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, ErrCorrupted
+		}
+		switch fields[0] {
+		case "next":
+			d.nextNum = num
+		case "log":
+			d.logNum = num
+		case "table":
+			tables = append(tables, num)
+		default:
+			return nil, ErrCorrupted
+		}
+	}
+	return tables, scanner.Err()
+}
+
+// writeManifest replaces the manifest atomically.
+func (d *DB) writeManifest() error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "next %v\nlog %v\n", d.nextNum, d.logNum)
+	for _, t := range d.tables {
+		fmt.Fprintf(&b, "table %v\n", t.num)
+	}
+	tmp := filepath.Join(d.path, manifestName+".tmp")
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	_, err = f.WriteString(b.String())
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, filepath.Join(d.path, manifestName)); err != nil {
+		return err
+	}
+	if dir, err := os.Open(d.path); err == nil {
+		dir.Sync()
+		dir.Close()
+	}
+	return nil
+}
+
+// removeObsoleteFiles removes the tables and journals which are not in the manifest,
+// which are left by a crash during flush or compaction.
+func (d *DB) removeObsoleteFiles() {
+	live := map[uint64]bool{d.logNum: true}
+	for _, t := range d.tables {
+		live[t.num] = true
+	}
+	files, err := ioutil.ReadDir(d.path)
+	if err != nil {
+		return
+	}
+	for _, file := range files {
+		name := file.Name()
+		ext := filepath.Ext(name)
+		if ext != ".sst" && ext != ".log" {
+			continue
+		}
+		num, err := strconv.ParseUint(strings.TrimSuffix(name, ext), 10, 64)
+		if err != nil || live[num] {
+			continue
+		}
+		os.Remove(filepath.Join(d.path, name))
+	}
+}
+
+func (d *DB) releaseTables() {
+	for _, t := range d.tables {
+		t.release()
+	}
+	d.tables = nil
+}
+
+// find returns the record of key from the newest source which contains it.
+func (d *DB) find(key []byte) ([]byte, bool, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if d.closed {
+		return nil, false, ErrClosed
+	}
+	if rec, err := d.mem.Get(key); err == nil {
+		return rec, true, nil
+	}
+	for _, t := range d.tables {
+		rec, ok, err := t.get(key)
+		if err != nil {
+			return nil, false, err
+		}
+		if ok {
+			return rec, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// Get return the value of the specify key
+func (d *DB) Get(key []byte) ([]byte, error) {
+	rec, ok, err := d.find(key)
+	if err != nil {
+		return nil, err
+	}
+	if !ok || rec[0] == kindDelete {
+		return []byte{}, nil
+	}
+	return append([]byte{}, rec[1:]...), nil
+}
+
+// Has returns whether the specified key exists
+func (d *DB) Has(key []byte) (bool, error) {
+	rec, ok, err := d.find(key)
+	if err != nil {
+		return false, err
+	}
+	return ok && rec[0] != kindDelete, nil
+}
+
+// Put will insert the key-value pair
+func (d *DB) Put(key []byte, value []byte) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.batch != nil {
+		d.batch.put(key, value)
+		return nil
+	}
+	b := &batch{}
+	b.put(key, value)
+	return d.write(b)
+}
+
+// Delete will remove the specify key
+func (d *DB) Delete(key []byte) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.batch != nil {
+		d.batch.delete(key)
+		return nil
+	}
+	b := &batch{}
+	b.delete(key)
+	return d.write(b)
+}
+
+// Keys returns the list of key prefixed with prefix
+func (d *DB) Keys(prefix []byte) ([][]byte, error) {
+	iter := d.NewIteratorByPrefix(prefix).(*Iter)
+	keys := make([][]byte, 0)
+	for iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Release()
+	err := iter.Error()
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// BeginBatch will start the batch transaction
+func (d *DB) BeginBatch() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.batch != nil {
+		return fmt.Errorf("not support nested batch write")
+	}
+	d.batch = &batch{}
+	return nil
+}
+
+// CommitBatch will commit the batch transaction
+func (d *DB) CommitBatch() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.batch == nil {
+		return fmt.Errorf("no batch write to commit")
+	}
+	err := d.write(d.batch)
+	if err != nil {
+		return err
+	}
+	d.batch = nil
+	return nil
+}
+
+// write writes the batch to the journal and memtable, it must be called with the lock held.
+func (d *DB) write(b *batch) error {
+	if d.closed {
+		return ErrClosed
+	}
+	if len(b.data) == 0 {
+		return nil
+	}
+	if err := d.journal.write(b); err != nil {
+		return err
+	}
+	if err := b.replay(d.mem.Put); err != nil {
+		return err
+	}
+	if d.mem.Size() < d.opts.MemtableSize {
+		return nil
+	}
+	if err := d.flushMemtable(); err != nil {
+		return fmt.Errorf("failed to flush memtable: %v", err)
+	}
+	// the error of the last compaction is returned once, and the compaction is retried
+	err := d.compactErr
+	d.compactErr = nil
+	d.compactInBackground()
+	return err
+}
+
+// flushMemtable writes the memtable to a new table and switches to a new journal.
+func (d *DB) flushMemtable() error {
+	iter := &memSource{d.mem.NewIterator(nil)}
+	t, err := d.writeTable(d.newFileNum(), newIter([]source{iter}, true))
+	iter.release()
+	if err != nil {
+		return err
+	}
+	oldLogNum := d.logNum
+	d.logNum = d.newFileNum()
+	j, err := openJournal(d.fileName(d.logNum, "log"), nil)
+	if err == nil {
+		if t != nil {
+			d.tables = append([]*table{t}, d.tables...)
+		}
+		err = d.writeManifest()
+		if err != nil && t != nil {
+			d.tables = d.tables[1:]
+		}
+	}
+	if err != nil {
+		if j != nil {
+			j.close()
+		}
+		if t != nil {
+			atomic.StoreInt32(&t.obsolete, 1)
+			t.release()
+		}
+		os.Remove(d.fileName(d.logNum, "log"))
+		d.logNum = oldLogNum
+		return err
+	}
+	d.journal.close()
+	os.Remove(d.fileName(oldLogNum, "log"))
+	d.journal = j
+	d.mem = memdb.New(comparer.DefaultComparer, 0)
+	return nil
+}
+
+// writeTable writes the records of iter to the new table num, and returns nil if there is no record.
+func (d *DB) writeTable(num uint64, iter *Iter) (*table, error) {
+	path := d.fileName(num, "sst")
+	w, err := newTableWriter(path, d.opts.BlockSize, d.bloom)
+	if err != nil {
+		return nil, err
+	}
+	for iter.Next() {
+		if err := w.add(iter.key, iter.rec); err != nil {
+			w.abort()
+			return nil, err
+		}
+	}
+	if err := iter.Error(); err != nil {
+		w.abort()
+		return nil, err
+	}
+	if w.count == 0 {
+		w.abort()
+		return nil, nil
+	}
+	if err := w.finish(); err != nil {
+		os.Remove(path)
+		return nil, err
+	}
+	return openTable(path, num, d.bloom)
+}
+
+// compactInBackground starts the compaction in background if there are too many tables
+// and it isn't running, it must be called with the lock held.
+func (d *DB) compactInBackground() {
+	if d.compacting || len(d.tables) < d.opts.CompactionTrigger {
+		return
+	}
+	d.compacting = true
+	d.compactWg.Add(1)
+	go func() {
+		defer d.compactWg.Done()
+		err := d.compact()
+		d.mu.Lock()
+		d.compacting = false
+		d.compactErr = err
+		d.mu.Unlock()
+	}()
+}
+
+// compact merges the newest tables while there are too many tables. The newer tables
+// are merged with the next older one as long as their total size is at least half of it.
+// The deleted records are dropped only when the oldest table is merged.
+func (d *DB) compact() error {
+	d.compactMu.Lock()
+	defer d.compactMu.Unlock()
+	for {
+		d.mu.RLock()
+		if d.closed || len(d.tables) < d.opts.CompactionTrigger {
+			d.mu.RUnlock()
+			return nil
+		}
+		n, size := 1, d.tables[0].size
+		for n < len(d.tables) && (n < 2 || size*2 >= d.tables[n].size) {
+			size += d.tables[n].size
+			n++
+		}
+		merged := append([]*table{}, d.tables[:n]...)
+		d.mu.RUnlock()
+		if err := d.mergeTables(merged); err != nil {
+			return err
+		}
+	}
+}
+
+// mergeTables merges the tables into one, it must be called with compactMu held. The
+// tables are merged without the lock, and the lock is held only to replace them with the
+// merged one. The tables flushed meanwhile are newer, so the merged tables are still
+// adjacent, and the deleted keys are dropped if the oldest table is merged.
+func (d *DB) mergeTables(merged []*table) error {
+	d.mu.Lock()
+	if d.closed {
+		d.mu.Unlock()
+		return ErrClosed
+	}
+	num := d.newFileNum()
+	sources := make([]source, len(merged))
+	for i, t := range merged {
+		sources[i] = tableSource{t.newIterator(nil, nil)}
+	}
+	oldest := merged[len(merged)-1] == d.tables[len(d.tables)-1]
+	d.mu.Unlock()
+
+	iter := newIter(sources, !oldest)
+	t, err := d.writeTable(num, iter)
+	iter.Release()
+	if err != nil {
+		return fmt.Errorf("failed to compact tables: %v", err)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		if t != nil {
+			atomic.StoreInt32(&t.obsolete, 1)
+			t.release()
+		}
+		return ErrClosed
+	}
+	start := 0
+	for d.tables[start] != merged[0] {
+		start++
+	}
+	old := d.tables
+	d.tables = append([]*table{}, old[:start]...)
+	if t != nil {
+		d.tables = append(d.tables, t)
+	}
+	d.tables = append(d.tables, old[start+len(merged):]...)
+	if err := d.writeManifest(); err != nil {
+		d.tables = old
+		if t != nil {
+			atomic.StoreInt32(&t.obsolete, 1)
+			t.release()
+		}
+		return err
+	}
+	for _, t := range merged {
+		atomic.StoreInt32(&t.obsolete, 1)
+		t.release()
+	}
+	return nil
+}
+
+// Compact flushes the memtable and merges all the tables into one, which drops
+// the deleted keys and the overwritten values.
+func (d *DB) Compact() error {
+	d.compactMu.Lock()
+	defer d.compactMu.Unlock()
+	d.mu.Lock()
+	if d.closed {
+		d.mu.Unlock()
+		return ErrClosed
+	}
+	if d.mem.Len() > 0 {
+		if err := d.flushMemtable(); err != nil {
+			d.mu.Unlock()
+			return fmt.Errorf("failed to flush memtable: %v", err)
+		}
+	}
+	merged := append([]*table{}, d.tables...)
+	d.mu.Unlock()
+	if len(merged) == 0 {
+		return nil
+	}
+	return d.mergeTables(merged)
+}
+
+// Size returns the size of tables and journal
+func (d *DB) Size() (int64, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if d.closed {
+		return 0, ErrClosed
+	}
+	total := d.journal.size
+	for _, t := range d.tables {
+		total += t.size
+	}
+	return total, nil
+}
+
+// Close will close the database, the memtable is recovered from the journal on the next open
+func (d *DB) Close() error {
+	d.mu.Lock()
+	if d.closed {
+		d.mu.Unlock()
+		return ErrClosed
+	}
+	d.closed = true
+	d.mu.Unlock()
+	// the running compaction stops before the tables are released
+	d.compactWg.Wait()
+	d.compactMu.Lock()
+	defer d.compactMu.Unlock()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.releaseTables()
+	return d.journal.close()
+}
+
+// NewIteratorByPrefix returns a new iterator by prefix
+func (d *DB) NewIteratorByPrefix(prefix []byte) interface{} {
+	r := util.BytesPrefix(prefix)
+	return d.newIterator(r.Start, r.Limit)
+}
+
+// NewIteratorByRange returns a new iterator by range
+func (d *DB) NewIteratorByRange(start []byte, limit []byte) interface{} {
+	return d.newIterator(start, limit)
+}
+
+func (d *DB) newIterator(start []byte, limit []byte) *Iter {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if d.closed {
+		return &Iter{err: ErrClosed}
+	}
+	sources := []source{&memSource{d.mem.NewIterator(&util.Range{Start: start, Limit: limit})}}
+	for _, t := range d.tables {
+		sources = append(sources, tableSource{t.newIterator(start, limit)})
+	}
+	return newIter(sources, false)
+}
@@ -0,0 +1,171 @@
+package lsm
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var smallOptions = Options{
+	MemtableSize:      1 << 10,
+	BlockSize:         128,
+	CompactionTrigger: 3,
+}
+
+func key(i int) []byte {
+	return []byte(fmt.Sprintf("key%05d", i))
+}
+
+func value(i int, round int) []byte {
+	return []byte(fmt.Sprintf("value%05d-%d", i, round))
+}
+
+func keys(t *testing.T, d *DB, start, limit []byte) []string {
+	iter := d.NewIteratorByRange(start, limit).(*Iter)
+	defer iter.Release()
+	var keys []string
+	for iter.Next() {
+		keys = append(keys, string(iter.Key()))
+	}
+	require.Nil(t, iter.Error())
+	return keys
+}
+
+func TestFlushAndCompaction(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lsm")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	d, err := Open(dir, smallOptions)
+	require.Nil(t, err)
+	for round := 0; round < 3; round++ {
+		for i := 0; i < 500; i++ {
+			require.Nil(t, d.Put(key(i), value(i, round)))
+		}
+	}
+	for i := 0; i < 500; i += 2 {
+		require.Nil(t, d.Delete(key(i)))
+	}
+	d.compactWg.Wait()
+	assert.True(t, len(d.tables) > 0)
+	assert.True(t, len(d.tables) < smallOptions.CompactionTrigger)
+
+	check := func(d *DB) {
+		for i := 0; i < 500; i++ {
+			v, err := d.Get(key(i))
+			assert.Nil(t, err)
+			ok, err := d.Has(key(i))
+			assert.Nil(t, err)
+			if i%2 == 0 {
+				assert.Equal(t, []byte{}, v)
+				assert.False(t, ok)
+			} else {
+				assert.Equal(t, value(i, 2), v)
+				assert.True(t, ok)
+			}
+		}
+		ks := keys(t, d, key(100), key(110))
+		assert.Equal(t, []string{"key00101", "key00103", "key00105", "key00107", "key00109"}, ks)
+		assert.Len(t, keys(t, d, nil, nil), 250)
+	}
+	check(d)
+
+	require.Nil(t, d.Close())
+	d, err = Open(dir, smallOptions)
+	require.Nil(t, err)
+	check(d)
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.sst"))
+	require.Nil(t, err)
+	assert.Len(t, files, len(d.tables))
+	require.Nil(t, d.Close())
+}
+
+func TestIteratorDuringCompaction(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lsm")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	d, err := Open(dir, smallOptions)
+	require.Nil(t, err)
+	defer d.Close()
+	for i := 0; i < 100; i++ {
+		require.Nil(t, d.Put(key(i), value(i, 0)))
+	}
+	iter := d.NewIteratorByPrefix([]byte("key")).(*Iter)
+	for i := 0; i < 1000; i++ {
+		require.Nil(t, d.Put(key(i), value(i, 1)))
+	}
+	n := 0
+	for iter.Next() {
+		n++
+	}
+	iter.Release()
+	assert.Nil(t, iter.Error())
+	assert.Equal(t, 100, n)
+}
+
+func TestBackgroundCompaction(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lsm")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	d, err := Open(dir, smallOptions)
+	require.Nil(t, err)
+	defer d.Close()
+
+	// the writes and reads aren't blocked by the compaction
+	d.compactMu.Lock()
+	for i := 0; i < 1000; i++ {
+		require.Nil(t, d.Put(key(i), value(i, 0)))
+	}
+	v, err := d.Get(key(1))
+	assert.Nil(t, err)
+	assert.Equal(t, value(1, 0), v)
+	assert.True(t, len(d.tables) >= smallOptions.CompactionTrigger)
+
+	d.compactMu.Unlock()
+	d.compactWg.Wait()
+	assert.True(t, len(d.tables) < smallOptions.CompactionTrigger)
+	assert.Len(t, keys(t, d, nil, nil), 1000)
+}
+
+func TestTornJournal(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lsm")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	d, err := NewDB(dir)
+	require.Nil(t, err)
+	require.Nil(t, d.Put(key(1), value(1, 0)))
+	require.Nil(t, d.Put(key(2), value(2, 0)))
+	logName := d.fileName(d.logNum, "log")
+	require.Nil(t, d.Close())
+
+	info, err := os.Stat(logName)
+	require.Nil(t, err)
+	require.Nil(t, os.Truncate(logName, info.Size()-1))
+
+	d, err = NewDB(dir)
+	require.Nil(t, err)
+	v, err := d.Get(key(1))
+	assert.Nil(t, err)
+	assert.Equal(t, value(1, 0), v)
+	ok, err := d.Has(key(2))
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	require.Nil(t, d.Put(key(3), value(3, 0)))
+	require.Nil(t, d.Close())
+	d, err = NewDB(dir)
+	require.Nil(t, err)
+	v, err = d.Get(key(3))
+	assert.Nil(t, err)
+	assert.Equal(t, value(3, 0), v)
+	require.Nil(t, d.Close())
+}
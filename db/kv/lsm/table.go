@@ -0,0 +1,347 @@
+package lsm
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"os"
+	"sort"
+	"sync/atomic"
+
+	"github.com/syndtr/goleveldb/leveldb/filter"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// A table is an immutable file of sorted entries, which is made of
+//
+//	data blocks | index block | filter block | footer
+//
+// Every block is followed by its crc32 checksum. The index block holds the last key
+// and handle of every data block, and the footer holds the handles of the index and
+// filter blocks followed by the magic number.
+const (
+	tableMagic       uint64 = 0x69737473746d736c
+	blockHandleSize         = 16
+	tableFooterSize         = 2*blockHandleSize + 8
+	blockTrailerSize        = 4
+)
+
+type blockHandle struct {
+	offset uint64
+	length uint64
+}
+
+func (h blockHandle) encode(dst []byte) {
+	binary.LittleEndian.PutUint64(dst, h.offset)
+	binary.LittleEndian.PutUint64(dst[8:], h.length)
+}
+
+func decodeBlockHandle(src []byte) blockHandle {
+	return blockHandle{
+		offset: binary.LittleEndian.Uint64(src),
+		length: binary.LittleEndian.Uint64(src[8:]),
+	}
+}
+
+type indexEntry struct {
+	last   []byte
+	handle blockHandle
+}
+
+// tableWriter writes the sorted entries to a table file.
+type tableWriter struct {
+	f         *os.File
+	w         *bufio.Writer
+	offset    uint64
+	blockSize int
+	block     []byte
+	lastKey   []byte
+	index     []byte
+	filter    filter.FilterGenerator
+	count     int
+}
+
+func newTableWriter(path string, blockSize int, bloom filter.Filter) (*tableWriter, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &tableWriter{
+		f:         f,
+		w:         bufio.NewWriter(f),
+		blockSize: blockSize,
+		filter:    bloom.NewGenerator(),
+	}, nil
+}
+
+// add appends the entry, whose key must be greater than the previous one.
+func (w *tableWriter) add(key []byte, rec []byte) error {
+	w.block = appendEntry(w.block, key, rec[0], rec[1:])
+	w.lastKey = append(w.lastKey[:0], key...)
+	w.filter.Add(key)
+	w.count++
+	if len(w.block) >= w.blockSize {
+		return w.flushBlock()
+	}
+	return nil
+}
+
+func (w *tableWriter) flushBlock() error {
+	if len(w.block) == 0 {
+		return nil
+	}
+	h, err := w.writeBlock(w.block)
+	if err != nil {
+		return err
+	}
+	var buf [binary.MaxVarintLen64 + blockHandleSize]byte
+	n := binary.PutUvarint(buf[:], uint64(len(w.lastKey)))
+	w.index = append(w.index, buf[:n]...)
+	w.index = append(w.index, w.lastKey...)
+	h.encode(buf[:])
+	w.index = append(w.index, buf[:blockHandleSize]...)
+	w.block = w.block[:0]
+	return nil
+}
+
+func (w *tableWriter) writeBlock(data []byte) (blockHandle, error) {
+	h := blockHandle{offset: w.offset, length: uint64(len(data))}
+	var trailer [blockTrailerSize]byte
+	binary.LittleEndian.PutUint32(trailer[:], crc32.ChecksumIEEE(data))
+	if _, err := w.w.Write(data); err != nil {
+		return h, err
+	}
+	if _, err := w.w.Write(trailer[:]); err != nil {
+		return h, err
+	}
+	w.offset += uint64(len(data) + blockTrailerSize)
+	return h, nil
+}
+
+// finish writes the index, filter and footer, and syncs the file.
+func (w *tableWriter) finish() error {
+	if err := w.flushBlock(); err != nil {
+		return err
+	}
+	indexHandle, err := w.writeBlock(w.index)
+	if err != nil {
+		return err
+	}
+	buf := &util.Buffer{}
+	w.filter.Generate(buf)
+	filterHandle, err := w.writeBlock(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	footer := make([]byte, tableFooterSize)
+	indexHandle.encode(footer)
+	filterHandle.encode(footer[blockHandleSize:])
+	binary.LittleEndian.PutUint64(footer[2*blockHandleSize:], tableMagic)
+	if _, err := w.w.Write(footer); err != nil {
+		return err
+	}
+	if err := w.w.Flush(); err != nil {
+		return err
+	}
+	if err := w.f.Sync(); err != nil {
+		return err
+	}
+	return w.f.Close()
+}
+
+// abort closes and removes the unfinished table.
+func (w *tableWriter) abort() {
+	w.f.Close()
+	os.Remove(w.f.Name())
+}
+
+// table is the reader of a table file. It is reference counted by the db and the
+// iterators, and the file is removed after the last reference is released if the
+// table is obsolete.
+type table struct {
+	num      uint64
+	f        *os.File
+	size     int64
+	index    []indexEntry
+	filter   []byte
+	bloom    filter.Filter
+	ref      int32
+	obsolete int32
+}
+
+func openTable(path string, num uint64, bloom filter.Filter) (*table, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	t := &table{num: num, f: f, bloom: bloom, ref: 1}
+	if err := t.load(); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return t, nil
+}
+
+func (t *table) load() error {
+	info, err := t.f.Stat()
+	if err != nil {
+		return err
+	}
+	t.size = info.Size()
+	if t.size < tableFooterSize {
+		return ErrCorrupted
+	}
+	footer := make([]byte, tableFooterSize)
+	if _, err := t.f.ReadAt(footer, t.size-tableFooterSize); err != nil {
+		return err
+	}
+	if binary.LittleEndian.Uint64(footer[2*blockHandleSize:]) != tableMagic {
+		return ErrCorrupted
+	}
+	index, err := t.readBlock(decodeBlockHandle(footer))
+	if err != nil {
+		return err
+	}
+	for len(index) > 0 {
+		keyLen, n := binary.Uvarint(index)
+		if n <= 0 || uint64(len(index)-n) < keyLen+blockHandleSize {
+			return ErrCorrupted
+		}
+		index = index[n:]
+		t.index = append(t.index, indexEntry{
+			last:   index[:keyLen],
+			handle: decodeBlockHandle(index[keyLen:]),
+		})
+		index = index[keyLen+blockHandleSize:]
+	}
+	t.filter, err = t.readBlock(decodeBlockHandle(footer[blockHandleSize:]))
+	return err
+}
+
+func (t *table) readBlock(h blockHandle) ([]byte, error) {
+	if h.offset+h.length+blockTrailerSize > uint64(t.size) {
+		return nil, ErrCorrupted
+	}
+	data := make([]byte, h.length+blockTrailerSize)
+	if _, err := t.f.ReadAt(data, int64(h.offset)); err != nil {
+		return nil, err
+	}
+	if crc32.ChecksumIEEE(data[:h.length]) != binary.LittleEndian.Uint32(data[h.length:]) {
+		return nil, ErrCorrupted
+	}
+	return data[:h.length], nil
+}
+
+// seekBlock returns the index of the first block which may contain keys not less than key.
+func (t *table) seekBlock(key []byte) int {
+	return sort.Search(len(t.index), func(i int) bool {
+		return bytes.Compare(t.index[i].last, key) >= 0
+	})
+}
+
+// get returns the record of key, and whether it is found.
+func (t *table) get(key []byte) ([]byte, bool, error) {
+	if !t.bloom.Contains(t.filter, key) {
+		return nil, false, nil
+	}
+	i := t.seekBlock(key)
+	if i == len(t.index) {
+		return nil, false, nil
+	}
+	block, err := t.readBlock(t.index[i].handle)
+	if err != nil {
+		return nil, false, err
+	}
+	for len(block) > 0 {
+		k, rec, n, err := decodeEntry(block)
+		if err != nil {
+			return nil, false, err
+		}
+		switch bytes.Compare(k, key) {
+		case 0:
+			return rec, true, nil
+		case 1:
+			return nil, false, nil
+		}
+		block = block[n:]
+	}
+	return nil, false, nil
+}
+
+func (t *table) acquire() {
+	atomic.AddInt32(&t.ref, 1)
+}
+
+func (t *table) release() {
+	if atomic.AddInt32(&t.ref, -1) == 0 {
+		t.f.Close()
+		if atomic.LoadInt32(&t.obsolete) == 1 {
+			os.Remove(t.f.Name())
+		}
+	}
+}
+
+// newIterator returns the iterator of entries in the range [start, limit).
+func (t *table) newIterator(start []byte, limit []byte) *tableIterator {
+	t.acquire()
+	it := &tableIterator{
+		t:     t,
+		block: -1,
+		start: start,
+		limit: limit,
+	}
+	if start != nil {
+		it.block = t.seekBlock(start) - 1
+	}
+	return it
+}
+
+// tableIterator iterates the entries of a table, including the deleted ones.
+type tableIterator struct {
+	t     *table
+	block int
+	data  []byte
+	start []byte
+	limit []byte
+	key   []byte
+	rec   []byte
+	err   error
+}
+
+func (it *tableIterator) next() bool {
+	for it.err == nil {
+		if len(it.data) == 0 {
+			it.block++
+			if it.block >= len(it.t.index) {
+				return false
+			}
+			it.data, it.err = it.t.readBlock(it.t.index[it.block].handle)
+			continue
+		}
+		key, rec, n, err := decodeEntry(it.data)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.data = it.data[n:]
+		if it.start != nil && bytes.Compare(key, it.start) < 0 {
+			continue
+		}
+		if it.limit != nil && bytes.Compare(key, it.limit) >= 0 {
+			it.data = nil
+			it.block = len(it.t.index)
+			return false
+		}
+		it.key, it.rec = key, rec
+		return true
+	}
+	return false
+}
+
+func (it *tableIterator) release() {
+	if it.t != nil {
+		it.t.release()
+		it.t = nil
+	}
+}
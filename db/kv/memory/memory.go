@@ -0,0 +1,224 @@
+// Package memory is the in-memory storage backend, whose data is lost when it is closed.
+// It is meant for tests, benchmarks and nodes which replay the chain on every start.
+package memory
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/syndtr/goleveldb/leveldb/comparer"
+	"github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/syndtr/goleveldb/leveldb/memdb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// ErrClosed is returned when the db is used after closed.
+var ErrClosed = errors.New("memory: db is closed")
+
+type op struct {
+	key     []byte
+	value   []byte
+	deleted bool
+}
+
+// DB is the in-memory database
+type DB struct {
+	mu    sync.Mutex
+	db    *memdb.DB
+	batch []op
+	inTx  bool
+}
+
+// NewDB return new in-memory db
+func NewDB() *DB {
+	return &DB{
+		db: memdb.New(comparer.DefaultComparer, 0),
+	}
+}
+
+func (d *DB) mem() (*memdb.DB, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.db == nil {
+		return nil, ErrClosed
+	}
+	return d.db, nil
+}
+
+// Get return the value of the specify key
+func (d *DB) Get(key []byte) ([]byte, error) {
+	db, err := d.mem()
+	if err != nil {
+		return nil, err
+	}
+	value, err := db.Get(key)
+	if err != nil {
+		return []byte{}, nil
+	}
+	return append([]byte{}, value...), nil
+}
+
+// Has returns whether the specified key exists
+func (d *DB) Has(key []byte) (bool, error) {
+	db, err := d.mem()
+	if err != nil {
+		return false, err
+	}
+	return db.Contains(key), nil
+}
+
+// Put will insert the key-value pair
+func (d *DB) Put(key []byte, value []byte) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.db == nil {
+		return ErrClosed
+	}
+	if d.inTx {
+		d.batch = append(d.batch, op{key: append([]byte{}, key...), value: append([]byte{}, value...)})
+		return nil
+	}
+	return d.db.Put(key, value)
+}
+
+// Delete will remove the specify key
+func (d *DB) Delete(key []byte) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.db == nil {
+		return ErrClosed
+	}
+	if d.inTx {
+		d.batch = append(d.batch, op{key: append([]byte{}, key...), deleted: true})
+		return nil
+	}
+	d.db.Delete(key)
+	return nil
+}
+
+// Keys returns the list of key prefixed with prefix
+func (d *DB) Keys(prefix []byte) ([][]byte, error) {
+	iter := d.NewIteratorByPrefix(prefix).(*Iter)
+	keys := make([][]byte, 0)
+	for iter.Next() {
+		key := make([]byte, len(iter.Key()))
+		copy(key, iter.Key())
+		keys = append(keys, key)
+	}
+	iter.Release()
+	err := iter.Error()
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// BeginBatch will start the batch transaction
+func (d *DB) BeginBatch() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.inTx {
+		return fmt.Errorf("not support nested batch write")
+	}
+	d.inTx = true
+	return nil
+}
+
+// CommitBatch will commit the batch transaction
+func (d *DB) CommitBatch() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.inTx {
+		return fmt.Errorf("no batch write to commit")
+	}
+	if d.db == nil {
+		return ErrClosed
+	}
+	for _, o := range d.batch {
+		if o.deleted {
+			d.db.Delete(o.key)
+		} else {
+			d.db.Put(o.key, o.value)
+		}
+	}
+	d.batch = nil
+	d.inTx = false
+	return nil
+}
+
+// Size returns the size of keys and values in memory
+func (d *DB) Size() (int64, error) {
+	db, err := d.mem()
+	if err != nil {
+		return 0, err
+	}
+	return int64(db.Size()), nil
+}
+
+// Compact does nothing, the deleted keys are removed from memory immediately
+func (d *DB) Compact() error {
+	_, err := d.mem()
+	return err
+}
+
+// Close will close the database and drop all data
+func (d *DB) Close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.db == nil {
+		return ErrClosed
+	}
+	d.db = nil
+	d.batch = nil
+	d.inTx = false
+	return nil
+}
+
+// NewIteratorByPrefix returns a new iterator by prefix
+func (d *DB) NewIteratorByPrefix(prefix []byte) interface{} {
+	return d.newIterator(util.BytesPrefix(prefix))
+}
+
+// NewIteratorByRange returns a new iterator by range
+func (d *DB) NewIteratorByRange(start []byte, limit []byte) interface{} {
+	return d.newIterator(&util.Range{Start: start, Limit: limit})
+}
+
+func (d *DB) newIterator(r *util.Range) *Iter {
+	db, err := d.mem()
+	if err != nil {
+		return &Iter{iter: iterator.NewEmptyIterator(err)}
+	}
+	return &Iter{iter: db.NewIterator(r)}
+}
+
+// Iter is the iterator for in-memory db
+type Iter struct {
+	iter iterator.Iterator
+}
+
+// Next do next item of iterator
+func (i *Iter) Next() bool {
+	return i.iter.Next()
+}
+
+// Key returns the key of current item
+func (i *Iter) Key() []byte {
+	return i.iter.Key()
+}
+
+// Value returns the value of current item
+func (i *Iter) Value() []byte {
+	return i.iter.Value()
+}
+
+// Error returns the error of iterator
+func (i *Iter) Error() error {
+	return i.iter.Error()
+}
+
+// Release will release the iterator
+func (i *Iter) Release() {
+	i.iter.Release()
+}
@@ -1,18 +1,53 @@
 package kv
 
 import (
+	"fmt"
+
 	"github.com/iost-official/go-iost/db/kv/leveldb"
+	"github.com/iost-official/go-iost/db/kv/lsm"
+	"github.com/iost-official/go-iost/db/kv/memory"
 )
 
-// StorageType is the type of storage, include leveldb and rocksdb
+// StorageType is the type of storage, include leveldb, lsm and memory
 type StorageType uint8
 
 // Storage type constant
 const (
 	_ StorageType = iota
 	LevelDBStorage
+	LSMStorage
+	MemoryStorage
 )
 
+var storageTypeNames = map[StorageType]string{
+	LevelDBStorage: "leveldb",
+	LSMStorage:     "lsm",
+	MemoryStorage:  "memory",
+}
+
+// StorageTypes is the list of all storage types.
+var StorageTypes = []StorageType{LevelDBStorage, LSMStorage, MemoryStorage}
+
+func (t StorageType) String() string {
+	if name, ok := storageTypeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("StorageType(%d)", t)
+}
+
+// ParseStorageType returns the storage type of name, an empty name means leveldb.
+func ParseStorageType(name string) (StorageType, error) {
+	if name == "" {
+		return LevelDBStorage, nil
+	}
+	for t, n := range storageTypeNames {
+		if n == name {
+			return t, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown storage type %v", name)
+}
+
 // StorageBackend is the storage backend interface
 type StorageBackend interface {
 	Get(key []byte) ([]byte, error)
@@ -23,8 +58,10 @@ type StorageBackend interface {
 	BeginBatch() error
 	CommitBatch() error
 	Size() (int64, error)
+	Compact() error
 	Close() error
 	NewIteratorByPrefix(prefix []byte) interface{}
+	NewIteratorByRange(start []byte, limit []byte) interface{}
 }
 
 // Storage is a kv database
@@ -41,6 +78,14 @@ func NewStorage(path string, t StorageType) (*Storage, error) {
 			return nil, err
 		}
 		return &Storage{StorageBackend: sb}, nil
+	case LSMStorage:
+		sb, err := lsm.NewDB(path)
+		if err != nil {
+			return nil, err
+		}
+		return &Storage{StorageBackend: sb}, nil
+	case MemoryStorage:
+		return &Storage{StorageBackend: memory.NewDB()}, nil
 	default:
 		sb, err := leveldb.NewDB(path)
 		if err != nil {
@@ -58,6 +103,15 @@ func (s *Storage) NewIteratorByPrefix(prefix []byte) *Iterator {
 	}
 }
 
+// NewIteratorByRange returns a new iterator of keys in the range [start, limit).
+// A nil start means the first key and a nil limit means beyond the last key.
+func (s *Storage) NewIteratorByRange(start []byte, limit []byte) *Iterator {
+	ib := s.StorageBackend.NewIteratorByRange(start, limit).(IteratorBackend)
+	return &Iterator{
+		IteratorBackend: ib,
+	}
+}
+
 // IteratorBackend is the storage iterator backend
 type IteratorBackend interface {
 	Next() bool
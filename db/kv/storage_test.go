@@ -2,6 +2,7 @@ package kv
 
 import (
 	"crypto/rand"
+	"os"
 	"os/exec"
 	"reflect"
 	"testing"
@@ -122,6 +123,26 @@ func (suite *StorageTestSuite) TestKeys() {
 	)
 }
 
+func (suite *StorageTestSuite) TestIteratorByRange() {
+	iter := suite.storage.NewIteratorByRange([]byte("key02"), []byte("key05"))
+	keys := make([]string, 0)
+	for iter.Next() {
+		keys = append(keys, string(iter.Key()))
+	}
+	iter.Release()
+	suite.Nil(iter.Error())
+	suite.Equal([]string{"key02", "key03", "key04"}, keys)
+
+	iter = suite.storage.NewIteratorByRange([]byte("key04"), nil)
+	keys = make([]string, 0)
+	for iter.Next() {
+		keys = append(keys, string(iter.Key()))
+	}
+	iter.Release()
+	suite.Nil(iter.Error())
+	suite.Equal([]string{"key04", "key05"}, keys)
+}
+
 func (suite *StorageTestSuite) TestBatch() {
 	var value []byte
 	var err error
@@ -163,6 +184,9 @@ func (suite *StorageTestSuite) TestBatch() {
 }
 
 func (suite *StorageTestSuite) TestRecover() {
+	if suite.t == MemoryStorage {
+		suite.T().Skip("memory storage is not persistent")
+	}
 	var value []byte
 	var err error
 
@@ -204,20 +228,62 @@ func (suite *StorageTestSuite) TestRecover() {
 	suite.Equal([]byte{}, value)
 }
 
+func (suite *StorageTestSuite) TestCompact() {
+	for _, k := range []string{"key01", "key02", "key03"} {
+		suite.Nil(suite.storage.Delete([]byte(k)))
+	}
+	suite.Nil(suite.storage.Put([]byte("key04"), []byte("value11")))
+	suite.Nil(suite.storage.Compact())
+
+	keys, err := suite.storage.Keys([]byte("key"))
+	suite.Nil(err)
+	suite.Equal([][]byte{[]byte("key04"), []byte("key05")}, keys)
+	value, err := suite.storage.Get([]byte("key04"))
+	suite.Nil(err)
+	suite.Equal([]byte("value11"), value)
+
+	if suite.t == MemoryStorage {
+		return
+	}
+	suite.Nil(suite.storage.Close())
+	storage, err := NewStorage(DBPATH, suite.t)
+	suite.Require().Nil(err)
+	suite.storage = storage
+	keys, err = suite.storage.Keys([]byte("key"))
+	suite.Nil(err)
+	suite.Equal([][]byte{[]byte("key04"), []byte("key05")}, keys)
+}
+
 func (suite *StorageTestSuite) TearDownTest() {
 	err := suite.storage.Close()
 	suite.Nil(err)
-	cmd := exec.Command("rm", "-r", DBPATH)
-	err = cmd.Run()
+	err = os.RemoveAll(DBPATH)
 	suite.Require().Nil(err)
 }
 
 func TestStorageTestSuite(t *testing.T) {
-	suite.Run(t, &StorageTestSuite{t: LevelDBStorage})
+	for _, st := range StorageTypes {
+		t.Run(st.String(), func(t *testing.T) {
+			suite.Run(t, &StorageTestSuite{t: st})
+		})
+	}
+}
+
+func TestParseStorageType(t *testing.T) {
+	for _, st := range StorageTypes {
+		parsed, err := ParseStorageType(st.String())
+		assert.Nil(t, err)
+		assert.Equal(t, st, parsed)
+	}
+	parsed, err := ParseStorageType("")
+	assert.Nil(t, err)
+	assert.Equal(t, LevelDBStorage, parsed)
+	_, err = ParseStorageType("rocksdb")
+	assert.NotNil(t, err)
 }
 
 func BenchmarkStorage(b *testing.B) {
-	for _, t := range []StorageType{LevelDBStorage} {
+	for _, t := range StorageTypes {
 		storage, err := NewStorage(DBPATH, t)
 		if err != nil {
 			b.Fatalf("Failed to new storage: %v", err)
@@ -269,7 +335,7 @@ func BenchmarkStorage(b *testing.B) {
 }
 
 func BenchmarkKeys(b *testing.B) {
-	for _, t := range []StorageType{LevelDBStorage} {
+	for _, t := range StorageTypes {
 		storage, err := NewStorage(DBPATH, t)
 		if err != nil {
 			b.Fatalf("Failed to new storage: %v", err)
@@ -317,7 +383,13 @@ func BenchmarkKeys(b *testing.B) {
 }
 
 func BenchmarkIterator(b *testing.B) {
-	storage, err := NewStorage(DBPATH, LevelDBStorage)
+	for _, t := range StorageTypes {
+		benchmarkIterator(b, t)
+	}
+}
+
+func benchmarkIterator(b *testing.B, t StorageType) {
+	storage, err := NewStorage(DBPATH, t)
 	if err != nil {
 		b.Fatalf("Failed to new storage: %v", err)
 	}
@@ -7,6 +7,7 @@ package db_mock
 import (
 	gomock "github.com/golang/mock/gomock"
 	db "github.com/iost-official/go-iost/db"
+	smt "github.com/iost-official/go-iost/db/smt"
 	reflect "reflect"
 )
 
@@ -91,6 +92,28 @@ func (mr *MockMVCCDBMockRecorder) Del(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Del", reflect.TypeOf((*MockMVCCDB)(nil).Del), arg0, arg1)
 }
 
+// EnableArchive mocks base method
+func (m *MockMVCCDB) EnableArchive() {
+	m.ctrl.Call(m, "EnableArchive")
+}
+
+// EnableArchive indicates an expected call of EnableArchive
+func (mr *MockMVCCDBMockRecorder) EnableArchive() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableArchive", reflect.TypeOf((*MockMVCCDB)(nil).EnableArchive))
+}
+
+// EnableStateRoot mocks base method
+func (m *MockMVCCDB) EnableStateRoot() error {
+	ret := m.ctrl.Call(m, "EnableStateRoot")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableStateRoot indicates an expected call of EnableStateRoot
+func (mr *MockMVCCDBMockRecorder) EnableStateRoot() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableStateRoot", reflect.TypeOf((*MockMVCCDB)(nil).EnableStateRoot))
+}
+
 // Flush mocks base method
 func (m *MockMVCCDB) Flush(arg0 string) error {
 	ret := m.ctrl.Call(m, "Flush", arg0)
@@ -103,6 +126,18 @@ func (mr *MockMVCCDBMockRecorder) Flush(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Flush", reflect.TypeOf((*MockMVCCDB)(nil).Flush), arg0)
 }
 
+// FlushBlock mocks base method
+func (m *MockMVCCDB) FlushBlock(arg0 string, arg1 int64) error {
+	ret := m.ctrl.Call(m, "FlushBlock", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FlushBlock indicates an expected call of FlushBlock
+func (mr *MockMVCCDBMockRecorder) FlushBlock(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlushBlock", reflect.TypeOf((*MockMVCCDB)(nil).FlushBlock), arg0, arg1)
+}
+
 // Fork mocks base method
 func (m *MockMVCCDB) Fork() db.MVCCDB {
 	ret := m.ctrl.Call(m, "Fork")
@@ -154,6 +189,19 @@ func (mr *MockMVCCDBMockRecorder) Keys(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Keys", reflect.TypeOf((*MockMVCCDB)(nil).Keys), arg0, arg1)
 }
 
+// NewIterator mocks base method
+func (m *MockMVCCDB) NewIterator(arg0, arg1, arg2 string) (db.Iterator, error) {
+	ret := m.ctrl.Call(m, "NewIterator", arg0, arg1, arg2)
+	ret0, _ := ret[0].(db.Iterator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewIterator indicates an expected call of NewIterator
+func (mr *MockMVCCDBMockRecorder) NewIterator(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewIterator", reflect.TypeOf((*MockMVCCDB)(nil).NewIterator), arg0, arg1, arg2)
+}
+
 // Put mocks base method
 func (m *MockMVCCDB) Put(arg0, arg1, arg2 string) error {
 	ret := m.ctrl.Call(m, "Put", arg0, arg1, arg2)
@@ -166,6 +214,18 @@ func (mr *MockMVCCDBMockRecorder) Put(arg0, arg1, arg2 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockMVCCDB)(nil).Put), arg0, arg1, arg2)
 }
 
+// Restore mocks base method
+func (m *MockMVCCDB) Restore(arg0 string, arg1 func() ([]byte, []byte, error)) error {
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore
+func (mr *MockMVCCDBMockRecorder) Restore(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockMVCCDB)(nil).Restore), arg0, arg1)
+}
+
 // Rollback mocks base method
 func (m *MockMVCCDB) Rollback() {
 	m.ctrl.Call(m, "Rollback")
@@ -176,6 +236,18 @@ func (mr *MockMVCCDBMockRecorder) Rollback() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockMVCCDB)(nil).Rollback))
 }
 
+// RollbackTo mocks base method
+func (m *MockMVCCDB) RollbackTo(arg0 string, arg1 int64) error {
+	ret := m.ctrl.Call(m, "RollbackTo", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackTo indicates an expected call of RollbackTo
+func (mr *MockMVCCDBMockRecorder) RollbackTo(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackTo", reflect.TypeOf((*MockMVCCDB)(nil).RollbackTo), arg0, arg1)
+}
+
 // Size mocks base method
 func (m *MockMVCCDB) Size() (int64, error) {
 	ret := m.ctrl.Call(m, "Size")
@@ -189,6 +261,58 @@ func (mr *MockMVCCDBMockRecorder) Size() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockMVCCDB)(nil).Size))
 }
 
+// Snapshot mocks base method
+func (m *MockMVCCDB) Snapshot(arg0 func([]byte, []byte) error) (string, error) {
+	ret := m.ctrl.Call(m, "Snapshot", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Snapshot indicates an expected call of Snapshot
+func (mr *MockMVCCDBMockRecorder) Snapshot(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshot", reflect.TypeOf((*MockMVCCDB)(nil).Snapshot), arg0)
+}
+
+// StateAt mocks base method
+func (m *MockMVCCDB) StateAt(arg0 int64) (*db.StateView, error) {
+	ret := m.ctrl.Call(m, "StateAt", arg0)
+	ret0, _ := ret[0].(*db.StateView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateAt indicates an expected call of StateAt
+func (mr *MockMVCCDBMockRecorder) StateAt(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateAt", reflect.TypeOf((*MockMVCCDB)(nil).StateAt), arg0)
+}
+
+// StateProof mocks base method
+func (m *MockMVCCDB) StateProof(arg0, arg1, arg2 string) (*smt.Proof, error) {
+	ret := m.ctrl.Call(m, "StateProof", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*smt.Proof)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateProof indicates an expected call of StateProof
+func (mr *MockMVCCDBMockRecorder) StateProof(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateProof", reflect.TypeOf((*MockMVCCDB)(nil).StateProof), arg0, arg1, arg2)
+}
+
+// StateRoot mocks base method
+func (m *MockMVCCDB) StateRoot(arg0 string) ([]byte, error) {
+	ret := m.ctrl.Call(m, "StateRoot", arg0)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateRoot indicates an expected call of StateRoot
+func (mr *MockMVCCDBMockRecorder) StateRoot(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateRoot", reflect.TypeOf((*MockMVCCDB)(nil).StateRoot), arg0)
+}
+
 // Tag mocks base method
 func (m *MockMVCCDB) Tag(arg0 string) {
 	m.ctrl.Call(m, "Tag", arg0)
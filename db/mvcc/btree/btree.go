@@ -1,9 +1,342 @@
-package mvcc
+package btree
 
-// BTreeNode is the B tree node
-type BTreeNode struct {
+import (
+	"bytes"
+	"sort"
+	"sync"
+)
+
+// Constant of btree
+const (
+	// Degree is the minimum degree of the tree, a node has at most 2*Degree-1 entries
+	Degree = 16
+	// MinRebuildSize is the min size of the tree to rebuild it at
+	MinRebuildSize = 1024
+)
+
+const maxEntries = 2*Degree - 1
+
+// context is the write context of a fork. The nodes owned by a context are modified in
+// place, and the others are copied before writing.
+type context struct {
+	freed bool
+}
+
+type entry struct {
+	key     []byte
+	value   interface{}
+	context *context
+}
+
+// Node is the node of the copy-on-write B tree
+type Node struct {
+	context  *context
+	entries  []*entry
+	children []*Node
+}
+
+func (n *Node) leaf() bool {
+	return len(n.children) == 0
+}
+
+// search returns the index of the first entry not less than key, and whether it's equal to key
+func (n *Node) search(key []byte) (int, bool) {
+	i := sort.Search(len(n.entries), func(i int) bool {
+		return bytes.Compare(n.entries[i].key, key) >= 0
+	})
+	return i, i < len(n.entries) && bytes.Equal(n.entries[i].key, key)
+}
+
+func (n *Node) get(key []byte) *entry {
+	for n != nil {
+		i, found := n.search(key)
+		if found {
+			return n.entries[i]
+		}
+		if n.leaf() {
+			return nil
+		}
+		n = n.children[i]
+	}
+	return nil
+}
+
+func (n *Node) forkWithContext(c *context) *Node {
+	if n.context == c {
+		return n
+	}
+	node := &Node{
+		context: c,
+		entries: make([]*entry, len(n.entries), maxEntries),
+	}
+	copy(node.entries, n.entries)
+	if !n.leaf() {
+		node.children = make([]*Node, len(n.children), maxEntries+1)
+		copy(node.children, n.children)
+	}
+	return node
+}
+
+// split moves the entries after the middle one into a new node, and returns the middle
+// entry and the new node. The node must be owned by the context.
+func (n *Node) split() (*entry, *Node) {
+	mid := Degree - 1
+	right := &Node{
+		context: n.context,
+		entries: make([]*entry, 0, maxEntries),
+	}
+	right.entries = append(right.entries, n.entries[mid+1:]...)
+	middle := n.entries[mid]
+	for i := mid; i < len(n.entries); i++ {
+		n.entries[i] = nil
+	}
+	n.entries = n.entries[:mid]
+	if !n.leaf() {
+		right.children = make([]*Node, 0, maxEntries+1)
+		right.children = append(right.children, n.children[mid+1:]...)
+		for i := mid + 1; i < len(n.children); i++ {
+			n.children[i] = nil
+		}
+		n.children = n.children[:mid+1]
+	}
+	return middle, right
+}
+
+// put inserts the entry into the subtree of the non-full node owned by the context, and
+// returns whether the key is new.
+func (n *Node) put(e *entry) bool {
+	for {
+		i, found := n.search(e.key)
+		if found {
+			n.entries[i] = e
+			return false
+		}
+		if n.leaf() {
+			n.entries = append(n.entries, nil)
+			copy(n.entries[i+1:], n.entries[i:])
+			n.entries[i] = e
+			return true
+		}
+		child := n.children[i].forkWithContext(n.context)
+		n.children[i] = child
+		if len(child.entries) == maxEntries {
+			middle, right := child.split()
+			n.entries = append(n.entries, nil)
+			copy(n.entries[i+1:], n.entries[i:])
+			n.entries[i] = middle
+			n.children = append(n.children, nil)
+			copy(n.children[i+2:], n.children[i+1:])
+			n.children[i+1] = right
+			switch c := bytes.Compare(e.key, middle.key); {
+			case c == 0:
+				n.entries[i] = e
+				return false
+			case c > 0:
+				child = right
+			}
+		}
+		n = child
+	}
+}
+
+// ascend calls fn with the entries prefixed with prefix in order, until fn returns false.
+func (n *Node) ascend(prefix []byte, fn func(*entry) bool) bool {
+	i, _ := n.search(prefix)
+	for ; ; i++ {
+		if !n.leaf() && !n.children[i].ascend(prefix, fn) {
+			return false
+		}
+		if i == len(n.entries) {
+			return true
+		}
+		e := n.entries[i]
+		if !bytes.HasPrefix(e.key, prefix) {
+			return false
+		}
+		if !fn(e) {
+			return false
+		}
+	}
+}
+
+// free drops the values put by the contexts in the subtree owned by them.
+func (n *Node) free(contexts []*context) {
+	owned := func(c *context) bool {
+		for _, o := range contexts {
+			if c == o {
+				return true
+			}
+		}
+		return false
+	}
+	if !owned(n.context) {
+		return
+	}
+	for _, e := range n.entries {
+		if owned(e.context) {
+			e.value = nil
+		}
+	}
+	for _, c := range n.children {
+		c.free(contexts)
+	}
+}
+
+// Tree is the mvcc copy-on-write B tree, whose forks share the nodes until they are written.
+// The values freed by the other forks are dropped from the tree when it is rebuilt.
+type Tree struct {
+	context   *context
+	contexts  []*context // all the contexts of this fork, which are freed with it
+	root      *Node
+	size      int // the number of entries in the tree, including the freed ones
+	rebuildAt int // the size to rebuild the tree at
+	rwmu      *sync.RWMutex
+}
+
+// New returns new tree
+func New() *Tree {
+	c := &context{}
+	return &Tree{
+		context:   c,
+		contexts:  []*context{c},
+		root:      &Node{context: c},
+		rebuildAt: MinRebuildSize,
+		rwmu:      new(sync.RWMutex),
+	}
+}
+
+func (e *entry) valid() bool {
+	return e != nil && !e.context.freed && e.value != nil
+}
+
+// Get returns the value of specify key
+func (t *Tree) Get(key []byte) interface{} {
+	t.rwmu.RLock()
+	defer t.rwmu.RUnlock()
+
+	if t.root == nil {
+		return nil
+	}
+	e := t.root.get(key)
+	if !e.valid() {
+		return nil
+	}
+	return e.value
+}
+
+// Put will insert the key-value pair
+func (t *Tree) Put(key []byte, value interface{}) {
+	t.rwmu.Lock()
+	defer t.rwmu.Unlock()
+
+	if t.root == nil {
+		return
+	}
+	if t.size >= t.rebuildAt {
+		t.rebuild()
+	}
+	k := make([]byte, len(key))
+	copy(k, key)
+	t.put(&entry{key: k, value: value, context: t.context})
+}
+
+func (t *Tree) put(e *entry) {
+	root := t.root.forkWithContext(t.context)
+	if len(root.entries) == maxEntries {
+		middle, right := root.split()
+		root = &Node{
+			context:  t.context,
+			entries:  append(make([]*entry, 0, maxEntries), middle),
+			children: append(make([]*Node, 0, maxEntries+1), root, right),
+		}
+	}
+	t.root = root
+	if root.put(e) {
+		t.size++
+	}
+}
+
+// rebuild rebuilds the tree with the valid entries, which takes linear time after the size
+// of the tree doubles.
+func (t *Tree) rebuild() {
+	entries := make([]*entry, 0, t.size)
+	t.root.ascend(nil, func(e *entry) bool {
+		if e.valid() {
+			entries = append(entries, e)
+		}
+		return true
+	})
+	t.root = &Node{context: t.context}
+	t.size = 0
+	for _, e := range entries {
+		t.put(e)
+	}
+	t.rebuildAt = 2 * t.size
+	if t.rebuildAt < MinRebuildSize {
+		t.rebuildAt = MinRebuildSize
+	}
+}
+
+// Ascend calls fn with the key-value pairs prefixed with prefix in the order of keys, until
+// fn returns false. The tree must not be written in fn.
+func (t *Tree) Ascend(prefix []byte, fn func(key []byte, value interface{}) bool) {
+	t.rwmu.RLock()
+	defer t.rwmu.RUnlock()
+
+	if t.root == nil {
+		return
+	}
+	t.root.ascend(prefix, func(e *entry) bool {
+		if !e.valid() {
+			return true
+		}
+		return fn(e.key, e.value)
+	})
 }
 
-// BTreeLeaf is the B tree leaf
-type BTreeLeaf struct {
+// All returns the list of values prefixed with prefix in the order of keys
+func (t *Tree) All(prefix []byte) []interface{} {
+	values := []interface{}{}
+	t.Ascend(prefix, func(key []byte, value interface{}) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
+
+// Fork will fork the tree, both of the forks copy the shared nodes before writing them
+// thread safe between all forks of the tree
+func (t *Tree) Fork() interface{} {
+	t.rwmu.Lock()
+	defer t.rwmu.Unlock()
+
+	if t.root != nil {
+		t.context = &context{}
+		t.contexts = append(t.contexts, t.context)
+	}
+	c := &context{}
+	return &Tree{
+		context:   c,
+		contexts:  []*context{c},
+		root:      t.root,
+		size:      t.size,
+		rebuildAt: t.rebuildAt,
+		rwmu:      t.rwmu,
+	}
+}
+
+// Free will free the memory of tree, the values put by it are dropped from all the forks
+func (t *Tree) Free() {
+	t.rwmu.Lock()
+	defer t.rwmu.Unlock()
+
+	if t.root == nil {
+		return
+	}
+	for _, c := range t.contexts {
+		c.freed = true
+	}
+	t.root.free(t.contexts)
+	t.root = nil
+	t.contexts = nil
 }
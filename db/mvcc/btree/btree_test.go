@@ -0,0 +1,128 @@
+package btree
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPutAndGet(t *testing.T) {
+	tree := New()
+	data := make(map[string]interface{})
+	for i := 0; i < 10000; i++ {
+		k := fmt.Sprintf("key%d", rand.Intn(5000))
+		tree.Put([]byte(k), i)
+		data[k] = i
+	}
+	for k, v := range data {
+		assert.Equal(t, v, tree.Get([]byte(k)))
+	}
+	assert.Nil(t, tree.Get([]byte("key")))
+	assert.Nil(t, tree.Get([]byte("key5000")))
+	assert.Equal(t, len(data), tree.size)
+}
+
+func TestAll(t *testing.T) {
+	tree := New()
+	keys := []string{}
+	for i := 0; i < 3000; i++ {
+		k := fmt.Sprintf("%c%d", 'a'+rand.Intn(3), rand.Int())
+		tree.Put([]byte(k), k)
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, prefix := range []string{"", "a", "b1", "c12", "d"} {
+		expect := []interface{}{}
+		for i, k := range keys {
+			if strings.HasPrefix(k, prefix) && (i == 0 || keys[i-1] != k) {
+				expect = append(expect, k)
+			}
+		}
+		assert.Equal(t, expect, tree.All([]byte(prefix)), "prefix %v", prefix)
+	}
+
+	count := 0
+	tree.Ascend([]byte("b"), func(key []byte, value interface{}) bool {
+		count++
+		return count < 10
+	})
+	assert.Equal(t, 10, count)
+}
+
+func TestFork(t *testing.T) {
+	tree := New()
+	for i := 0; i < 1000; i++ {
+		tree.Put([]byte(fmt.Sprintf("key%04d", i)), i)
+	}
+	fork := tree.Fork().(*Tree)
+	for i := 0; i < 1000; i += 2 {
+		fork.Put([]byte(fmt.Sprintf("key%04d", i)), -i)
+	}
+	fork.Put([]byte("new"), "fork")
+	tree.Put([]byte("key0001"), "tree")
+	tree.Put([]byte("new"), "tree")
+
+	assert.Equal(t, 0, tree.Get([]byte("key0000")))
+	assert.Equal(t, -2, fork.Get([]byte("key0002")))
+	assert.Equal(t, 3, fork.Get([]byte("key0003")))
+	assert.Equal(t, "tree", tree.Get([]byte("key0001")))
+	assert.Equal(t, 1, fork.Get([]byte("key0001")))
+	assert.Equal(t, "tree", tree.Get([]byte("new")))
+	assert.Equal(t, "fork", fork.Get([]byte("new")))
+	assert.Equal(t, 1001, len(tree.All(nil)))
+	assert.Equal(t, 1001, len(fork.All(nil)))
+}
+
+func TestFree(t *testing.T) {
+	tree := New()
+	tree.Put([]byte("a"), 1)
+	tree.Put([]byte("b"), 2)
+	fork := tree.Fork().(*Tree)
+	fork.Put([]byte("b"), 3)
+	fork.Put([]byte("c"), 4)
+	tree.Free()
+
+	assert.Nil(t, tree.Get([]byte("a")))
+	assert.Nil(t, fork.Get([]byte("a")))
+	assert.Equal(t, 3, fork.Get([]byte("b")))
+	assert.Equal(t, []interface{}{3, 4}, fork.All(nil))
+
+	// the freed entries are dropped after rebuilding
+	for i := 0; i < MinRebuildSize; i++ {
+		fork.Put([]byte(fmt.Sprintf("key%d", i)), i)
+	}
+	next := fork.Fork().(*Tree)
+	fork.Free()
+	for i := 0; i < MinRebuildSize; i++ {
+		next.Put([]byte(fmt.Sprintf("new%d", i)), i)
+	}
+	assert.Equal(t, MinRebuildSize, next.size)
+	assert.Equal(t, MinRebuildSize, len(next.All(nil)))
+}
+
+func TestConcurrentForks(t *testing.T) {
+	tree := New()
+	for i := 0; i < 1000; i++ {
+		tree.Put([]byte(fmt.Sprintf("key%d", i)), i)
+	}
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		fork := tree.Fork().(*Tree)
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				fork.Put([]byte(fmt.Sprintf("key%d", j)), i)
+				assert.Equal(t, i, fork.Get([]byte(fmt.Sprintf("key%d", j))))
+			}
+			assert.Equal(t, 1000, len(fork.All(nil)))
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(t, 500, tree.Get([]byte("key500")))
+}
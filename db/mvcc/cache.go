@@ -1,6 +1,9 @@
 package mvcc
 
 import (
+	"fmt"
+
+	"github.com/iost-official/go-iost/db/mvcc/btree"
 	"github.com/iost-official/go-iost/db/mvcc/map"
 	"github.com/iost-official/go-iost/db/mvcc/trie"
 )
@@ -13,8 +16,38 @@ const (
 	_ CacheType = iota
 	TrieCache
 	MapCache
+	BTreeCache
 )
 
+var cacheTypeNames = map[CacheType]string{
+	TrieCache:  "trie",
+	MapCache:   "map",
+	BTreeCache: "btree",
+}
+
+// CacheTypes is the list of all cache types.
+var CacheTypes = []CacheType{TrieCache, MapCache, BTreeCache}
+
+func (t CacheType) String() string {
+	if name, ok := cacheTypeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("CacheType(%d)", t)
+}
+
+// ParseCacheType returns the cache type of name, an empty name means map.
+func ParseCacheType(name string) (CacheType, error) {
+	if name == "" {
+		return MapCache, nil
+	}
+	for t, n := range cacheTypeNames {
+		if n == name {
+			return t, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown cache type %v", name)
+}
+
 // Cache is the cache interface
 type Cache interface {
 	Get(key []byte) interface{}
@@ -31,6 +64,8 @@ func NewCache(t CacheType) Cache {
 		return trie.New()
 	case MapCache:
 		return mvccmap.New()
+	case BTreeCache:
+		return btree.New()
 	default:
 		return trie.New()
 	}
@@ -236,6 +236,9 @@ func (t *Trie) All(prefix []byte) []interface{} {
 
 	node := t.root.get(prefix, 0)
 	valuelist := []interface{}{}
+	if node == nil {
+		return valuelist
+	}
 	for _, n := range node.all() {
 		if n.value != nil {
 			valuelist = append(valuelist, n.value)
@@ -2,10 +2,12 @@ package db
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/iost-official/go-iost/db/kv"
 	"github.com/iost-official/go-iost/db/mvcc"
+	"github.com/iost-official/go-iost/db/smt"
 )
 
 //go:generate mockgen -destination mocks/mock_mvccdb.go -package db_mock github.com/iost-official/go-iost/db MVCCDB
@@ -15,6 +17,9 @@ const (
 	SEPARATOR = '/'
 )
 
+// tagKey is the key of the tag of the flushed state in storage
+var tagKey = []byte(string(SEPARATOR) + "tag")
+
 // error of mvccdb
 var (
 	ErrTableNotValid = fmt.Errorf("table name is not valid")
@@ -27,6 +32,7 @@ type MVCCDB interface {
 	Del(table string, key string) error
 	Has(table string, key string) (bool, error)
 	Keys(table string, prefix string) ([]string, error)
+	NewIterator(table string, start string, end string) (Iterator, error)
 	Commit()
 	Rollback()
 	Checkout(t string) bool
@@ -34,13 +40,27 @@ type MVCCDB interface {
 	CurrentTag() string
 	Fork() MVCCDB
 	Flush(t string) error
+	FlushBlock(t string, number int64) error
+	EnableArchive()
+	EnableStateRoot() error
+	StateRoot(t string) ([]byte, error)
+	StateProof(t string, table string, key string) (*smt.Proof, error)
+	StateAt(number int64) (*StateView, error)
+	RollbackTo(t string, number int64) error
+	Snapshot(fn func(key []byte, value []byte) error) (string, error)
+	Restore(tag string, next func() ([]byte, []byte, error)) error
 	Size() (int64, error)
 	Close() error
 }
 
 // NewMVCCDB return new mvccdb
 func NewMVCCDB(path string) (MVCCDB, error) {
-	return NewCacheMVCCDB(path, mvcc.MapCache)
+	return NewCacheMVCCDB(path, mvcc.MapCache, kv.LevelDBStorage)
+}
+
+// NewMVCCDBWithStorage return new mvccdb on the storage of the specify type
+func NewMVCCDBWithStorage(path string, storageType kv.StorageType) (MVCCDB, error) {
+	return NewCacheMVCCDB(path, mvcc.MapCache, storageType)
 }
 
 // Item is the value of cache
@@ -55,6 +75,11 @@ type Item struct {
 type Commit struct {
 	mvcc.Cache
 	Tags []string
+
+	parent *Commit
+	dirty  map[string]*Item // the items put in this commit, which are flushed incrementally
+	tree   *smt.Tree        // the state tree of this commit, which is computed on demand
+	mu     sync.Mutex
 }
 
 // NewCommit returns new commit
@@ -62,6 +87,7 @@ func NewCommit(cacheType mvcc.CacheType) *Commit {
 	return &Commit{
 		Cache: mvcc.NewCache(cacheType),
 		Tags:  make([]string, 0),
+		dirty: make(map[string]*Item),
 	}
 }
 
@@ -69,11 +95,41 @@ func NewCommit(cacheType mvcc.CacheType) *Commit {
 // thread safe between all forks of the commit
 func (c *Commit) Fork() *Commit {
 	return &Commit{
-		Cache: c.Cache.Fork().(mvcc.Cache),
-		Tags:  make([]string, 0),
+		Cache:  c.Cache.Fork().(mvcc.Cache),
+		Tags:   make([]string, 0),
+		parent: c,
+		dirty:  make(map[string]*Item),
 	}
 }
 
+// Put will insert the item into the cache, and mark it dirty
+func (c *Commit) Put(key []byte, item *Item) {
+	c.Cache.Put(key, item)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.dirty[string(key)] = item
+}
+
+// Free will free the memory of the commit
+func (c *Commit) Free() {
+	c.Cache.Free()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.parent = nil
+	c.dirty = nil
+	c.tree = nil
+}
+
+// dirtyItems returns the items put in this commit
+func (c *Commit) dirtyItems() map[string]*Item {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.dirty
+}
+
 // CommitManager is the commit manager, support get, delete etc.
 type CommitManager struct {
 	tags    map[string]*Commit
@@ -126,6 +182,17 @@ func (m *CommitManager) GetTags(c *Commit) []string {
 	return c.Tags
 }
 
+// First returns the first commit, which is the last flushed one
+func (m *CommitManager) First() *Commit {
+	m.rwmu.RLock()
+	defer m.rwmu.RUnlock()
+
+	if len(m.commits) == 0 {
+		return nil
+	}
+	return m.commits[0]
+}
+
 // FreeBefore will free the momery of commits before the commit
 func (m *CommitManager) FreeBefore(c *Commit) {
 	m.rwmu.Lock()
@@ -151,15 +218,18 @@ type CacheMVCCDB struct {
 	stage   *Commit
 	storage *kv.Storage
 	cm      *CommitManager
+	archive bool
+	flusher *flusher
+	roots   *stateRoots
 }
 
 // NewCacheMVCCDB returns new CacheMVCCDB
-func NewCacheMVCCDB(path string, cacheType mvcc.CacheType) (*CacheMVCCDB, error) {
-	storage, err := kv.NewStorage(path, kv.LevelDBStorage)
+func NewCacheMVCCDB(path string, cacheType mvcc.CacheType, storageType kv.StorageType) (*CacheMVCCDB, error) {
+	storage, err := kv.NewStorage(path, storageType)
 	if err != nil {
 		return nil, fmt.Errorf("failed to new storage: %v", err)
 	}
-	tag, err := storage.Get([]byte(string(SEPARATOR) + "tag"))
+	tag, err := storage.Get(tagKey)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get from storage: %v", err)
 	}
@@ -174,7 +244,13 @@ func NewCacheMVCCDB(path string, cacheType mvcc.CacheType) (*CacheMVCCDB, error)
 		stage:   stage,
 		storage: storage,
 		cm:      cm,
+		roots:   &stateRoots{},
+	}
+	if err := mvccdb.recoverFlush(); err != nil {
+		storage.Close()
+		return nil, fmt.Errorf("failed to recover the interrupted flush: %v", err)
 	}
+	mvccdb.flusher = newFlusher(mvccdb)
 	return mvccdb, nil
 }
 
@@ -268,27 +344,35 @@ func (m *CacheMVCCDB) Has(table string, key string) (bool, error) {
 	return true, nil
 }
 
-// Keys returns the list of key prefixed with prefix in the table
+// Keys returns the sorted list of key prefixed with prefix in the table
 func (m *CacheMVCCDB) Keys(table string, prefix string) ([]string, error) {
-	//if !m.isValidTable(table) {
-	//	return nil, ErrTableNotValid
-	//}
-	//p := []byte(table + string(SEPARATOR) + prefix)
-	//m.stagerw.RLock()
-	//vlist := m.stage.Keys(p)
-	//m.stagerw.RUnlock()
-	//keys, err := m.storage.Keys(p)
-	//if err != nil {
-	//	return nil, err
-	//}
-	//// TODO use iterator instead of keys
-	//for key := range keys {
-	//
-	//}
-	//	if !ok {
-	//		return nil, error.New("can't assert Item type")
-	//	}
-	return nil, nil
+	if !m.isValidTable(table) {
+		return nil, ErrTableNotValid
+	}
+	p := []byte(table + string(SEPARATOR) + prefix)
+	keys, err := m.storage.Keys(p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get keys from storage: %v", err)
+	}
+	exist := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		exist[string(k[len(table)+1:])] = true
+	}
+	for _, v := range m.stage.All(p) {
+		i, ok := v.(*Item)
+		if !ok {
+			return nil, fmt.Errorf("can't assert Item type")
+		}
+		exist[i.key] = !i.deleted
+	}
+	ret := make([]string, 0, len(exist))
+	for k, ok := range exist {
+		if ok {
+			ret = append(ret, k)
+		}
+	}
+	sort.Strings(ret)
+	return ret, nil
 }
 
 // Commit will commit current state of mvccdb
@@ -354,45 +438,36 @@ func (m *CacheMVCCDB) Fork() MVCCDB {
 		stage:   m.head.Fork(),
 		storage: m.storage,
 		cm:      m.cm,
+		archive: m.archive,
+		flusher: m.flusher,
+		roots:   m.roots,
 	}
 	return mvccdb
 }
 
-// Flush will persist the current state of mvccdb
+// Flush will persist the current state of mvccdb in background. The error of a flush
+// is returned by the following flushes.
 func (m *CacheMVCCDB) Flush(t string) error {
+	return m.flush(t, -1)
+}
+
+// FlushBlock will persist the state of the block with the number, which is tagged with t.
+// In archive mode the changes of the block are kept in archive as well.
+func (m *CacheMVCCDB) FlushBlock(t string, number int64) error {
+	return m.flush(t, number)
+}
+
+func (m *CacheMVCCDB) flush(t string, number int64) error {
 	commit := m.cm.Get(t)
 	if commit == nil {
 		return fmt.Errorf("not found tag: %v", t)
 	}
-	if err := m.storage.BeginBatch(); err != nil {
-		return err
-	}
-	err := m.storage.Put([]byte(string(SEPARATOR)+"tag"), []byte(t))
-	if err != nil {
-		return err
-	}
-	for _, v := range commit.All([]byte("")) {
-		item, ok := v.(*Item)
-		if !ok {
-			return fmt.Errorf("can't assert Item type")
-		}
-		if item.deleted {
-			err := m.storage.Delete([]byte(item.table + string(SEPARATOR) + item.key))
-			if err != nil {
-				return err
-			}
-		} else {
-			err := m.storage.Put([]byte(item.table+string(SEPARATOR)+item.key), []byte(item.value))
-			if err != nil {
-				return err
-			}
-		}
-	}
-	if err := m.storage.CommitBatch(); err != nil {
-		return err
-	}
-	m.cm.FreeBefore(commit)
-	return nil
+	return m.flusher.push(&flushRequest{
+		commit:  commit,
+		tag:     t,
+		number:  number,
+		archive: number >= 0 && m.archiveEnabled(),
+	})
 }
 
 // Size returns the size of mvccdb
@@ -400,7 +475,11 @@ func (m *CacheMVCCDB) Size() (int64, error) {
 	return m.storage.Size()
 }
 
-// Close will close the mvccdb
+// Close will wait for the pending flushes and close the mvccdb
 func (m *CacheMVCCDB) Close() error {
-	return m.storage.Close()
+	err := m.flusher.close()
+	if cerr := m.storage.Close(); err == nil {
+		err = cerr
+	}
+	return err
 }
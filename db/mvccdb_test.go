@@ -1,12 +1,16 @@
 package db
 
 import (
+	"fmt"
 	"os/exec"
 	"testing"
 
 	"os"
 	"time"
 
+	"github.com/iost-official/go-iost/db/kv"
+	"github.com/iost-official/go-iost/db/mvcc"
+	"github.com/iost-official/go-iost/db/smt"
 	"github.com/iost-official/go-iost/ilog"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -18,11 +22,16 @@ const (
 
 type MVCCDBTestSuite struct {
 	suite.Suite
-	mvccdb MVCCDB
+	cacheType mvcc.CacheType
+	mvccdb    MVCCDB
+}
+
+func (suite *MVCCDBTestSuite) newMVCCDB() (MVCCDB, error) {
+	return NewCacheMVCCDB(DBPATH, suite.cacheType, kv.LevelDBStorage)
 }
 
 func (suite *MVCCDBTestSuite) SetupTest() {
-	mvccdb, err := NewMVCCDB(DBPATH)
+	mvccdb, err := suite.newMVCCDB()
 	require.Nil(suite.T(), err, "Create MVCCDB should not fail")
 	suite.mvccdb = mvccdb
 	suite.mvccdb.Put("table01", "key01", "value01")
@@ -231,7 +240,7 @@ func (suite *MVCCDBTestSuite) TestRecovery() {
 	err = suite.mvccdb.Close()
 	suite.Nil(err, "Close MVCCDB should not fail")
 
-	mvccdb, err := NewMVCCDB(DBPATH)
+	mvccdb, err := suite.newMVCCDB()
 	require.Nil(suite.T(), err, "Create MVCCDB should not fail")
 	suite.mvccdb = mvccdb
 
@@ -278,6 +287,377 @@ func (suite *MVCCDBTestSuite) TestFlushAndCheckout() {
 	suite.Equal("", value)
 }
 
+func (suite *MVCCDBTestSuite) TestArchive() {
+	_, err := suite.mvccdb.StateAt(0)
+	suite.Equal(ErrArchiveDisabled, err)
+
+	suite.mvccdb.EnableArchive()
+	suite.mvccdb.Tag("block0")
+	suite.Nil(suite.mvccdb.FlushBlock("block0", 0))
+
+	suite.mvccdb.Put("table01", "key01", "value011")
+	suite.mvccdb.Del("table01", "key02")
+	suite.mvccdb.Commit()
+	suite.mvccdb.Tag("block1")
+	suite.mvccdb.Put("table01", "key01", "value012")
+	suite.mvccdb.Put("table01", "key0111", "value0111")
+	suite.mvccdb.Commit()
+	suite.mvccdb.Tag("block2")
+	suite.Nil(suite.mvccdb.FlushBlock("block1", 1))
+	suite.Nil(suite.mvccdb.FlushBlock("block2", 2))
+
+	expected := []map[string]string{
+		{"key01": "value01", "key02": "value02", "key0111": "", "iost05": "value10"},
+		{"key01": "value011", "key02": "", "key0111": "", "iost05": "value10"},
+		{"key01": "value012", "key02": "", "key0111": "value0111", "iost05": "value10"},
+	}
+	for number, kv := range expected {
+		state, err := suite.mvccdb.StateAt(int64(number))
+		suite.Nil(err)
+		for k, v := range kv {
+			value, err := state.Get("table01", k)
+			suite.Nil(err)
+			suite.Equal(v, value, "key %v at block %v", k, number)
+		}
+	}
+	_, err = suite.mvccdb.StateAt(3)
+	suite.NotNil(err)
+
+	// the archive restarts from the persisted state after a gap
+	suite.Nil(suite.mvccdb.Put("table01", "key03", "value033"))
+	suite.mvccdb.Commit()
+	suite.mvccdb.Tag("block4")
+	suite.Nil(suite.mvccdb.FlushBlock("block4", 4))
+	_, err = suite.mvccdb.StateAt(2)
+	suite.NotNil(err)
+	state, err := suite.mvccdb.StateAt(3)
+	suite.Nil(err)
+	value, err := state.Get("table01", "key01")
+	suite.Nil(err)
+	suite.Equal("value012", value)
+	value, err = state.Get("table01", "key03")
+	suite.Nil(err)
+	suite.Equal("value03", value)
+	state, err = suite.mvccdb.StateAt(4)
+	suite.Nil(err)
+	value, err = state.Get("table01", "key03")
+	suite.Nil(err)
+	suite.Equal("value033", value)
+	suite.Equal(ErrReadOnly, state.Put("table01", "key03", "value"))
+}
+
+func (suite *MVCCDBTestSuite) TestArchiveInterrupted() {
+	suite.mvccdb.EnableArchive()
+	for i := 0; i < flushBatchSize; i++ {
+		suite.mvccdb.Put("table02", fmt.Sprintf("key%05d", i), "value")
+	}
+	suite.mvccdb.Commit()
+	suite.mvccdb.Tag("block0")
+	suite.Nil(suite.mvccdb.FlushBlock("block0", 0))
+
+	// the copy of the state interrupted before the block is flushed doesn't change the archive
+	m := suite.mvccdb.(*CacheMVCCDB)
+	var start int64
+	suite.Nil(m.flusher.do(func() error {
+		var err error
+		start, err = m.prepareArchive(5)
+		return err
+	}))
+	suite.Equal(int64(4), start)
+	_, err := suite.mvccdb.StateAt(4)
+	suite.NotNil(err)
+	_, err = suite.mvccdb.StateAt(0)
+	suite.Nil(err)
+
+	// the state is copied again with the next block
+	suite.mvccdb.Put("table01", "key01", "value011")
+	suite.mvccdb.Commit()
+	suite.mvccdb.Tag("block5")
+	suite.Nil(suite.mvccdb.FlushBlock("block5", 5))
+	_, err = suite.mvccdb.StateAt(0)
+	suite.NotNil(err)
+	for number, v := range map[int64]string{4: "value01", 5: "value011"} {
+		state, err := suite.mvccdb.StateAt(number)
+		suite.Nil(err)
+		value, err := state.Get("table01", "key01")
+		suite.Nil(err)
+		suite.Equal(v, value)
+		value, err = state.Get("table02", fmt.Sprintf("key%05d", flushBatchSize-1))
+		suite.Nil(err)
+		suite.Equal("value", value)
+	}
+}
+
+func (suite *MVCCDBTestSuite) TestSnapshot() {
+	snapshot := func() (map[string]string, string, error) {
+		items := make(map[string]string)
+		tag, err := suite.mvccdb.Snapshot(func(key []byte, value []byte) error {
+			items[string(key)] = string(value)
+			return nil
+		})
+		return items, tag, err
+	}
+	suite.mvccdb.Tag("tag1")
+	suite.Nil(suite.mvccdb.Flush("tag1"))
+	items, tag, err := snapshot()
+	suite.Nil(err)
+	suite.Equal("tag1", tag)
+	suite.Len(items, 10)
+
+	// the commits not flushed aren't missed silently
+	suite.mvccdb.Put("table01", "key06", "value11")
+	suite.mvccdb.Commit()
+	_, _, err = snapshot()
+	suite.Equal(ErrPendingCommits, err)
+	suite.mvccdb.Tag("tag2")
+	_, _, err = snapshot()
+	suite.Equal(ErrPendingCommits, err)
+	suite.Nil(suite.mvccdb.Flush("tag2"))
+	items, tag, err = snapshot()
+	suite.Nil(err)
+	suite.Equal("tag2", tag)
+	suite.Len(items, 11)
+}
+
+func (suite *MVCCDBTestSuite) TestFlushIncremental() {
+	suite.mvccdb.Tag("tag1")
+	suite.Nil(suite.mvccdb.Flush("tag1"))
+	suite.mvccdb.Put("table01", "key01", "value011")
+	suite.mvccdb.Del("table01", "key02")
+	suite.mvccdb.Commit()
+	suite.mvccdb.Put("table01", "key01", "value012")
+	suite.mvccdb.Tag("tag2")
+
+	m := suite.mvccdb.(*CacheMVCCDB)
+	suite.Nil(m.flusher.wait())
+	items := m.changedItems(m.cm.Get("tag2"))
+	suite.Equal([]*Item{
+		{table: "table01", key: "key01", value: "value012"},
+		{table: "table01", key: "key02", deleted: true},
+	}, items)
+}
+
+func (suite *MVCCDBTestSuite) TestFlushBatches() {
+	for i := 0; i <= flushBatchSize; i++ {
+		suite.mvccdb.Put("table02", fmt.Sprintf("key%05d", i), "value")
+	}
+	suite.mvccdb.Tag("tag1")
+	suite.Nil(suite.mvccdb.Flush("tag1"))
+	suite.Nil(suite.mvccdb.Close())
+
+	mvccdb, err := suite.newMVCCDB()
+	suite.Require().Nil(err)
+	suite.mvccdb = mvccdb
+	suite.Equal("tag1", mvccdb.CurrentTag())
+	keys, err := mvccdb.Keys("table02", "key")
+	suite.Nil(err)
+	suite.Len(keys, flushBatchSize+1)
+	storage := mvccdb.(*CacheMVCCDB).storage
+	undo, err := storage.Keys(undoPrefix)
+	suite.Nil(err)
+	suite.Empty(undo)
+	flushing, err := storage.Get(flushingKey)
+	suite.Nil(err)
+	suite.Empty(flushing)
+}
+
+func (suite *MVCCDBTestSuite) TestFlushInterrupted() {
+	suite.mvccdb.Tag("tag1")
+	suite.Nil(suite.mvccdb.Flush("tag1"))
+	m := suite.mvccdb.(*CacheMVCCDB)
+	suite.Nil(m.flusher.wait())
+
+	// a flush is interrupted after the first batch of items
+	storage := m.storage
+	suite.Nil(storage.BeginBatch())
+	storage.Put(flushingKey, []byte("tag2"))
+	suite.Nil(m.saveUndo([]byte("table01/key01")))
+	storage.Put([]byte("table01/key01"), []byte("value011"))
+	suite.Nil(m.saveUndo([]byte("table01/key06")))
+	storage.Put([]byte("table01/key06"), []byte("value06"))
+	suite.Nil(storage.CommitBatch())
+	suite.Nil(suite.mvccdb.Close())
+
+	mvccdb, err := suite.newMVCCDB()
+	suite.Require().Nil(err)
+	suite.mvccdb = mvccdb
+	suite.Equal("tag1", mvccdb.CurrentTag())
+	value, err := mvccdb.Get("table01", "key01")
+	suite.Nil(err)
+	suite.Equal("value01", value)
+	has, err := mvccdb.Has("table01", "key06")
+	suite.Nil(err)
+	suite.False(has)
+	undo, err := mvccdb.(*CacheMVCCDB).storage.Keys(undoPrefix)
+	suite.Nil(err)
+	suite.Empty(undo)
+}
+
+func (suite *MVCCDBTestSuite) TestKeys() {
+	suite.mvccdb.Tag("block0")
+	suite.Nil(suite.mvccdb.Flush("block0"))
+	suite.mvccdb.Del("table01", "key02")
+	suite.mvccdb.Put("table01", "key0111", "value0111")
+	suite.mvccdb.Commit()
+	suite.mvccdb.Put("table01", "key06", "value06")
+
+	keys, err := suite.mvccdb.Keys("table01", "key")
+	suite.Nil(err)
+	suite.Equal([]string{"key01", "key0111", "key03", "key04", "key05", "key06"}, keys)
+	keys, err = suite.mvccdb.Keys("table01", "none")
+	suite.Nil(err)
+	suite.Empty(keys)
+	_, err = suite.mvccdb.Keys("table/01", "key")
+	suite.Equal(ErrTableNotValid, err)
+}
+
+func (suite *MVCCDBTestSuite) TestIterator() {
+	suite.mvccdb.Tag("block0")
+	suite.Nil(suite.mvccdb.Flush("block0"))
+	suite.mvccdb.Del("table01", "key02")
+	suite.mvccdb.Put("table01", "key03", "value033")
+	suite.mvccdb.Commit()
+	suite.mvccdb.Put("table01", "key0111", "value0111")
+	suite.mvccdb.Put("table02", "key01", "value01")
+
+	collect := func(start, end string) ([]string, []string) {
+		iter, err := suite.mvccdb.NewIterator("table01", start, end)
+		suite.Require().Nil(err)
+		defer iter.Release()
+		var keys, values []string
+		for iter.Next() {
+			keys = append(keys, iter.Key())
+			values = append(values, iter.Value())
+		}
+		suite.Nil(iter.Error())
+		return keys, values
+	}
+	keys, values := collect("key", "kez")
+	suite.Equal([]string{"key01", "key0111", "key03", "key04", "key05"}, keys)
+	suite.Equal([]string{"value01", "value0111", "value033", "value04", "value05"}, values)
+	keys, _ = collect("key0111", "key04")
+	suite.Equal([]string{"key0111", "key03"}, keys)
+	keys, _ = collect("key04", "")
+	suite.Equal([]string{"key04", "key05"}, keys)
+	keys, _ = collect("", "")
+	suite.Len(keys, 10)
+	keys, _ = collect("none", "nonf")
+	suite.Empty(keys)
+
+	_, err := suite.mvccdb.NewIterator("table01", "key05", "key01")
+	suite.NotNil(err)
+	_, err = suite.mvccdb.NewIterator("table/01", "", "")
+	suite.Equal(ErrTableNotValid, err)
+}
+
+func (suite *MVCCDBTestSuite) TestRollbackTo() {
+	suite.Equal(ErrArchiveDisabled, suite.mvccdb.RollbackTo("block1", 1))
+
+	suite.mvccdb.EnableArchive()
+	suite.mvccdb.Tag("block0")
+	suite.Nil(suite.mvccdb.FlushBlock("block0", 0))
+	suite.mvccdb.Put("table01", "key01", "value011")
+	suite.mvccdb.Commit()
+	suite.mvccdb.Tag("block1")
+	suite.Nil(suite.mvccdb.FlushBlock("block1", 1))
+	suite.mvccdb.Put("table01", "key01", "value012")
+	suite.mvccdb.Del("table01", "key02")
+	suite.mvccdb.Put("table01", "key0111", "value0111")
+	suite.mvccdb.Commit()
+	suite.mvccdb.Tag("block2")
+	suite.Nil(suite.mvccdb.FlushBlock("block2", 2))
+
+	suite.NotNil(suite.mvccdb.RollbackTo("block3", 3))
+	suite.Nil(suite.mvccdb.RollbackTo("block1", 1))
+	suite.Nil(suite.mvccdb.Close())
+
+	mvccdb, err := suite.newMVCCDB()
+	suite.Require().Nil(err)
+	suite.mvccdb = mvccdb
+	suite.mvccdb.EnableArchive()
+	suite.Equal("block1", suite.mvccdb.CurrentTag())
+	for k, v := range map[string]string{"key01": "value011", "key02": "value02", "key0111": "", "iost05": "value10"} {
+		value, err := suite.mvccdb.Get("table01", k)
+		suite.Nil(err)
+		suite.Equal(v, value, "key %v", k)
+	}
+	_, err = suite.mvccdb.StateAt(2)
+	suite.NotNil(err)
+	_, err = suite.mvccdb.StateAt(1)
+	suite.Nil(err)
+}
+
+func (suite *MVCCDBTestSuite) TestStateRoot() {
+	_, err := suite.mvccdb.StateRoot("")
+	suite.Equal(ErrStateRootDisabled, err)
+	suite.Nil(suite.mvccdb.EnableStateRoot())
+	suite.mvccdb.Tag("tag1")
+	root1, err := suite.mvccdb.StateRoot("tag1")
+	suite.Nil(err)
+
+	// the root is independent of the order of changes
+	expected := smt.New(nil, nil)
+	for _, k := range []string{"iost01", "iost02", "iost03", "iost04", "iost05", "key01", "key02", "key03", "key04", "key05"} {
+		v, err := suite.mvccdb.Get("table01", k)
+		suite.Nil(err)
+		suite.Nil(expected.Update([]byte("table01/"+k), []byte(v)))
+	}
+	suite.Equal(expected.Root(), root1)
+
+	suite.mvccdb.Put("table01", "key01", "value011")
+	suite.mvccdb.Commit()
+	suite.mvccdb.Del("table01", "key02")
+	suite.mvccdb.Tag("tag2")
+	root2, err := suite.mvccdb.StateRoot("tag2")
+	suite.Nil(err)
+	suite.Nil(expected.Update([]byte("table01/key01"), []byte("value011")))
+	suite.Nil(expected.Update([]byte("table01/key02"), nil))
+	suite.Equal(expected.Root(), root2)
+
+	proof, err := suite.mvccdb.StateProof("tag2", "table01", "key01")
+	suite.Nil(err)
+	suite.Nil(proof.Verify(root2, []byte("table01/key01"), []byte("value011")))
+	proof, err = suite.mvccdb.StateProof("tag2", "table01", "key02")
+	suite.Nil(err)
+	suite.Nil(proof.Verify(root2, []byte("table01/key02"), nil))
+
+	suite.True(suite.mvccdb.Checkout("tag1"))
+	suite.mvccdb.Put("table01", "key03", "value031")
+	suite.mvccdb.Tag("tag3")
+	root3, err := suite.mvccdb.StateRoot("tag3")
+	suite.Nil(err)
+	suite.NotEqual(root1, root3)
+
+	suite.Nil(suite.mvccdb.Flush("tag1"))
+	suite.Nil(suite.mvccdb.Flush("tag2"))
+	suite.Nil(suite.mvccdb.Close())
+
+	mvccdb, err := suite.newMVCCDB()
+	suite.Require().Nil(err)
+	suite.mvccdb = mvccdb
+	storage := mvccdb.(*CacheMVCCDB).storage
+	nodes, err := storage.Keys(nodePrefix)
+	suite.Nil(err)
+	suite.NotEmpty(nodes)
+	suite.Nil(mvccdb.EnableStateRoot())
+	root, err := mvccdb.StateRoot("tag2")
+	suite.Nil(err)
+	suite.Equal(root2, root)
+	proof, err = mvccdb.StateProof("tag2", "table01", "key03")
+	suite.Nil(err)
+	suite.Nil(proof.Verify(root2, []byte("table01/key03"), []byte("value03")))
+
+	// the outdated tree is rebuilt with the same nodes
+	suite.Nil(storage.Put(stateRootKey, []byte("outdated")))
+	suite.Nil(mvccdb.(*CacheMVCCDB).reloadStateTree())
+	root, err = mvccdb.StateRoot("tag2")
+	suite.Nil(err)
+	suite.Equal(root2, root)
+	rebuilt, err := storage.Keys(nodePrefix)
+	suite.Nil(err)
+	suite.ElementsMatch(nodes, rebuilt)
+}
+
 func (suite *MVCCDBTestSuite) TearDownTest() {
 	err := suite.mvccdb.Close()
 	suite.Nil(err, "Close MVCCDB should not fail")
@@ -288,7 +668,11 @@ func (suite *MVCCDBTestSuite) TearDownTest() {
 }
 
 func TestMVCCDBTestSuite(t *testing.T) {
-	suite.Run(t, new(MVCCDBTestSuite))
+	for _, ct := range mvcc.CacheTypes {
+		t.Run(ct.String(), func(t *testing.T) {
+			suite.Run(t, &MVCCDBTestSuite{cacheType: ct})
+		})
+	}
 }
 
 func TestPutTimeout(t *testing.T) {
@@ -0,0 +1,352 @@
+// Package smt implements a sparse merkle tree, which authenticates a set of key-value pairs
+// with a 32-byte root. The keys and values are hashed, and each leaf is placed at the
+// shortest prefix of its key hash which no other leaf shares, so a subtree holding a single
+// leaf is represented by the leaf itself.
+package smt
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/iost-official/go-iost/common"
+)
+
+// HashSize is the size of the hashes in the tree.
+const HashSize = 32
+
+// The marks of encoded nodes.
+const (
+	leafMark     byte = 0
+	internalMark byte = 1
+)
+
+// EmptyHash is the hash of an empty subtree, which is the root of an empty tree.
+var EmptyHash = make([]byte, HashSize)
+
+// error of smt
+var (
+	ErrNodeNotFound = errors.New("node not found")
+	ErrInvalidNode  = errors.New("invalid node")
+)
+
+// NodeStore is the store of the encoded nodes by their hashes.
+type NodeStore interface {
+	// Node returns the encoded node of the hash, or ErrNodeNotFound if it's missing.
+	Node(hash []byte) ([]byte, error)
+}
+
+// Tree is a version of sparse merkle tree. The nodes created by the version are kept in
+// memory, and the others are read from the store, which may be the previous version.
+type Tree struct {
+	mu      sync.RWMutex
+	root    []byte
+	store   NodeStore
+	added   map[string][]byte // the nodes created by this version
+	removed map[string]bool   // the nodes of the store which aren't in this version
+}
+
+// New returns the tree with the root whose nodes are in store.
+func New(root []byte, store NodeStore) *Tree {
+	if len(root) == 0 {
+		root = EmptyHash
+	}
+	return &Tree{
+		root:    root,
+		store:   store,
+		added:   make(map[string][]byte),
+		removed: make(map[string]bool),
+	}
+}
+
+// Fork returns the next version of the tree.
+func (t *Tree) Fork() *Tree {
+	return New(t.Root(), t)
+}
+
+// Root returns the root hash of the tree.
+func (t *Tree) Root() []byte {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.root
+}
+
+// Parent returns the previous version of the tree, or nil if the store isn't a tree.
+func (t *Tree) Parent() *Tree {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	p, _ := t.store.(*Tree)
+	return p
+}
+
+// Node returns the encoded node of the hash in the tree or its store.
+func (t *Tree) Node(hash []byte) ([]byte, error) {
+	t.mu.RLock()
+	n, ok := t.added[string(hash)]
+	store := t.store
+	t.mu.RUnlock()
+	if ok {
+		return n, nil
+	}
+	return store.Node(hash)
+}
+
+// Changes returns the nodes created by this version and the nodes of the store which are
+// removed from this version.
+func (t *Tree) Changes() (map[string][]byte, map[string]bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.added, t.removed
+}
+
+// Rebase replaces the store of the tree with the one containing all its nodes, and drops
+// the nodes in memory.
+func (t *Tree) Rebase(store NodeStore) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.store = store
+	t.added = make(map[string][]byte)
+	t.removed = make(map[string]bool)
+}
+
+// Update puts the value of the key into the tree, a nil value deletes the key.
+func (t *Tree) Update(key []byte, value []byte) error {
+	var valueHash []byte
+	if value != nil {
+		valueHash = common.Sha3(value)
+	}
+	root, err := t.update(t.Root(), 0, common.Sha3(key), valueHash)
+	if err != nil {
+		return err
+	}
+	t.mu.Lock()
+	t.root = root
+	t.mu.Unlock()
+	return nil
+}
+
+func bit(hash []byte, depth int) byte {
+	return (hash[depth/8] >> uint(7-depth%8)) & 1
+}
+
+func isEmpty(hash []byte) bool {
+	return bytes.Equal(hash, EmptyHash)
+}
+
+func (t *Tree) put(node []byte) []byte {
+	hash := common.Sha3(node)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.removed[string(hash)] {
+		// the node is restored, which is still in the store
+		delete(t.removed, string(hash))
+	} else {
+		t.added[string(hash)] = node
+	}
+	return hash
+}
+
+func (t *Tree) remove(hash []byte) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.added[string(hash)]; ok {
+		delete(t.added, string(hash))
+	} else {
+		t.removed[string(hash)] = true
+	}
+}
+
+func encodeNode(mark byte, a, b []byte) []byte {
+	n := make([]byte, 0, 1+2*HashSize)
+	n = append(n, mark)
+	n = append(n, a...)
+	return append(n, b...)
+}
+
+func decodeNode(n []byte) (byte, []byte, []byte, error) {
+	if len(n) != 1+2*HashSize || n[0] > internalMark {
+		return 0, nil, nil, ErrInvalidNode
+	}
+	return n[0], n[1 : 1+HashSize], n[1+HashSize:], nil
+}
+
+// LeafHash returns the hash of the leaf of the key hash and value hash.
+func LeafHash(keyHash, valueHash []byte) []byte {
+	return common.Sha3(encodeNode(leafMark, keyHash, valueHash))
+}
+
+// InternalHash returns the hash of the internal node with the children.
+func InternalHash(left, right []byte) []byte {
+	return common.Sha3(encodeNode(internalMark, left, right))
+}
+
+// update updates the subtree of hash at depth, and returns the new hash of the subtree.
+func (t *Tree) update(hash []byte, depth int, key, value []byte) ([]byte, error) {
+	if isEmpty(hash) {
+		if value == nil {
+			return EmptyHash, nil
+		}
+		return t.put(encodeNode(leafMark, key, value)), nil
+	}
+	n, err := t.Node(hash)
+	if err != nil {
+		return nil, err
+	}
+	mark, a, b, err := decodeNode(n)
+	if err != nil {
+		return nil, err
+	}
+	if mark == leafMark {
+		if bytes.Equal(a, key) {
+			if bytes.Equal(b, value) {
+				return hash, nil
+			}
+			t.remove(hash)
+			if value == nil {
+				return EmptyHash, nil
+			}
+			return t.put(encodeNode(leafMark, key, value)), nil
+		}
+		if value == nil {
+			return hash, nil
+		}
+		return t.split(depth, hash, a, t.put(encodeNode(leafMark, key, value)), key), nil
+	}
+
+	left, right := a, b
+	if bit(key, depth) == 0 {
+		left, err = t.update(a, depth+1, key, value)
+	} else {
+		right, err = t.update(b, depth+1, key, value)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(left, a) && bytes.Equal(right, b) {
+		return hash, nil
+	}
+	t.remove(hash)
+	// a subtree with a single leaf is replaced by the leaf
+	for _, c := range [][2][]byte{{left, right}, {right, left}} {
+		if !isEmpty(c[0]) {
+			continue
+		}
+		if isEmpty(c[1]) {
+			return EmptyHash, nil
+		}
+		n, err := t.Node(c[1])
+		if err != nil {
+			return nil, err
+		}
+		mark, _, _, err := decodeNode(n)
+		if err != nil {
+			return nil, err
+		}
+		if mark == leafMark {
+			return c[1], nil
+		}
+	}
+	return t.put(encodeNode(internalMark, left, right)), nil
+}
+
+// split returns the subtree at depth holding the two leaves.
+func (t *Tree) split(depth int, leaf1, key1, leaf2, key2 []byte) []byte {
+	b1, b2 := bit(key1, depth), bit(key2, depth)
+	switch {
+	case b1 == b2:
+		child := t.split(depth+1, leaf1, key1, leaf2, key2)
+		if b1 == 0 {
+			return t.put(encodeNode(internalMark, child, EmptyHash))
+		}
+		return t.put(encodeNode(internalMark, EmptyHash, child))
+	case b1 == 0:
+		return t.put(encodeNode(internalMark, leaf1, leaf2))
+	default:
+		return t.put(encodeNode(internalMark, leaf2, leaf1))
+	}
+}
+
+// Proof is the proof of the value of a key in the tree.
+type Proof struct {
+	// the siblings on the path from the root to the leaf or the empty subtree of the key
+	Siblings [][]byte
+	// the key hash and value hash of the leaf at the end of the path, which are empty if the
+	// subtree is empty. The leaf of another key proves that the key isn't in the tree.
+	LeafKey   []byte
+	LeafValue []byte
+}
+
+// Prove returns the proof of the key in the tree.
+func (t *Tree) Prove(key []byte) (*Proof, error) {
+	keyHash := common.Sha3(key)
+	p := &Proof{}
+	hash := t.Root()
+	for depth := 0; !isEmpty(hash); depth++ {
+		n, err := t.Node(hash)
+		if err != nil {
+			return nil, err
+		}
+		mark, a, b, err := decodeNode(n)
+		if err != nil {
+			return nil, err
+		}
+		if mark == leafMark {
+			p.LeafKey, p.LeafValue = a, b
+			break
+		}
+		if bit(keyHash, depth) == 0 {
+			p.Siblings = append(p.Siblings, b)
+			hash = a
+		} else {
+			p.Siblings = append(p.Siblings, a)
+			hash = b
+		}
+	}
+	return p, nil
+}
+
+// Verify checks the proof of the value of the key in the tree of root, a nil value means
+// the key isn't in the tree.
+func (p *Proof) Verify(root, key, value []byte) error {
+	keyHash := common.Sha3(key)
+	if len(p.Siblings) > 8*HashSize {
+		return fmt.Errorf("too many siblings")
+	}
+	hash := EmptyHash
+	switch {
+	case value != nil:
+		if !bytes.Equal(p.LeafKey, keyHash) || !bytes.Equal(p.LeafValue, common.Sha3(value)) {
+			return fmt.Errorf("the leaf mismatches the key and value")
+		}
+		hash = LeafHash(p.LeafKey, p.LeafValue)
+	case len(p.LeafKey) > 0:
+		if bytes.Equal(p.LeafKey, keyHash) {
+			return fmt.Errorf("the key is in the tree")
+		}
+		if len(p.LeafKey) != HashSize {
+			return fmt.Errorf("invalid leaf key")
+		}
+		for depth := range p.Siblings {
+			if bit(p.LeafKey, depth) != bit(keyHash, depth) {
+				return fmt.Errorf("the leaf isn't on the path of the key")
+			}
+		}
+		hash = LeafHash(p.LeafKey, p.LeafValue)
+	}
+	for depth := len(p.Siblings) - 1; depth >= 0; depth-- {
+		if bit(keyHash, depth) == 0 {
+			hash = InternalHash(hash, p.Siblings[depth])
+		} else {
+			hash = InternalHash(p.Siblings[depth], hash)
+		}
+	}
+	if !bytes.Equal(hash, root) {
+		return fmt.Errorf("the root mismatches")
+	}
+	return nil
+}
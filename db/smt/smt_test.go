@@ -0,0 +1,127 @@
+package smt
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memStore map[string][]byte
+
+func (s memStore) Node(hash []byte) ([]byte, error) {
+	if n, ok := s[string(hash)]; ok {
+		return n, nil
+	}
+	return nil, ErrNodeNotFound
+}
+
+func build(t *testing.T, kvs map[string]string) *Tree {
+	tree := New(nil, memStore{})
+	for k, v := range kvs {
+		require.Nil(t, tree.Update([]byte(k), []byte(v)))
+	}
+	return tree
+}
+
+// apply writes the changes of the tree into store, and rebases the tree to it.
+func apply(store memStore, tree *Tree) {
+	added, removed := tree.Changes()
+	for h := range removed {
+		delete(store, h)
+	}
+	for h, n := range added {
+		store[h] = n
+	}
+	tree.Rebase(store)
+}
+
+func TestUpdate(t *testing.T) {
+	tree := New(nil, memStore{})
+	assert.Equal(t, EmptyHash, tree.Root())
+
+	r := rand.New(rand.NewSource(1))
+	kvs := make(map[string]string)
+	for i := 0; i < 1000; i++ {
+		k := fmt.Sprintf("key%d", r.Intn(300))
+		if r.Intn(3) == 0 {
+			delete(kvs, k)
+			require.Nil(t, tree.Update([]byte(k), nil))
+		} else {
+			v := fmt.Sprintf("value%d", i)
+			kvs[k] = v
+			require.Nil(t, tree.Update([]byte(k), []byte(v)))
+		}
+	}
+	// the root is independent of the order of updates
+	assert.Equal(t, build(t, kvs).Root(), tree.Root())
+	added, removed := tree.Changes()
+	assert.Empty(t, removed)
+	assert.True(t, len(added) > len(kvs))
+
+	for k := range kvs {
+		require.Nil(t, tree.Update([]byte(k), nil))
+	}
+	assert.Equal(t, EmptyHash, tree.Root())
+	added, _ = tree.Changes()
+	assert.Empty(t, added)
+}
+
+func TestFork(t *testing.T) {
+	base := build(t, map[string]string{"a": "1", "b": "2", "c": "3"})
+	added, _ := base.Changes()
+	store := memStore(added)
+	base.Rebase(store)
+
+	tree := base.Fork()
+	assert.Equal(t, base, tree.Parent())
+	require.Nil(t, tree.Update([]byte("b"), []byte("22")))
+	require.Nil(t, tree.Update([]byte("d"), []byte("4")))
+	assert.Equal(t, build(t, map[string]string{"a": "1", "b": "22", "c": "3", "d": "4"}).Root(), tree.Root())
+
+	// the changes of the fork turn the store into the fork
+	added, removed := tree.Changes()
+	assert.NotEmpty(t, removed)
+	apply(store, tree)
+	assert.Nil(t, tree.Parent())
+	require.Nil(t, tree.Update([]byte("a"), nil))
+	assert.Equal(t, build(t, map[string]string{"b": "22", "c": "3", "d": "4"}).Root(), tree.Root())
+	apply(store, tree)
+
+	// restoring the value restores the removed node
+	tree = New(tree.Root(), store)
+	require.Nil(t, tree.Update([]byte("c"), []byte("33")))
+	require.Nil(t, tree.Update([]byte("c"), []byte("3")))
+	added, removed = tree.Changes()
+	assert.Empty(t, added)
+	assert.Empty(t, removed)
+}
+
+func TestProof(t *testing.T) {
+	kvs := make(map[string]string)
+	for i := 0; i < 100; i++ {
+		kvs[fmt.Sprintf("key%d", i)] = fmt.Sprintf("value%d", i)
+	}
+	tree := build(t, kvs)
+	root := tree.Root()
+	for k, v := range kvs {
+		p, err := tree.Prove([]byte(k))
+		require.Nil(t, err)
+		assert.Nil(t, p.Verify(root, []byte(k), []byte(v)), k)
+		assert.NotNil(t, p.Verify(root, []byte(k), []byte("wrong")), k)
+		assert.NotNil(t, p.Verify(root, []byte(k), nil), k)
+	}
+	for i := 100; i < 200; i++ {
+		k := []byte(fmt.Sprintf("key%d", i))
+		p, err := tree.Prove(k)
+		require.Nil(t, err)
+		assert.Nil(t, p.Verify(root, k, nil))
+		assert.NotNil(t, p.Verify(root, k, []byte("value")))
+	}
+
+	p, err := New(nil, memStore{}).Prove([]byte("key"))
+	require.Nil(t, err)
+	assert.Nil(t, p.Verify(EmptyHash, []byte("key"), nil))
+}
@@ -0,0 +1,108 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// restoreBatchSize is the number of items written in a batch by Restore.
+const restoreBatchSize = 10000
+
+// Errors of snapshot and restore.
+var (
+	// ErrStateNotEmpty is returned when restoring into a state db which has been flushed.
+	ErrStateNotEmpty = errors.New("state db is not empty")
+	// ErrPendingCommits is returned when taking the snapshot of a state db whose commits
+	// in the cache aren't flushed.
+	ErrPendingCommits = errors.New("state db has commits not flushed")
+)
+
+// Snapshot calls fn with the key and value of every item in the flushed state, and
+// returns the tag of the state. The commits in the cache must be flushed before, so that
+// the snapshot is the current state, otherwise an error is returned. The state isn't
+// flushed during the snapshot.
+func (m *CacheMVCCDB) Snapshot(fn func(key []byte, value []byte) error) (string, error) {
+	var tag []byte
+	err := m.flusher.do(func() error {
+		m.rwmu.RLock()
+		pending := m.head != m.cm.First()
+		m.rwmu.RUnlock()
+		if pending {
+			return ErrPendingCommits
+		}
+		var err error
+		if tag, err = m.storage.Get(tagKey); err != nil {
+			return err
+		}
+		if len(tag) == 0 {
+			return fmt.Errorf("no flushed state")
+		}
+		// the keys of items never start with the separator, which are the keys of tag and archive
+		for _, r := range [][2][]byte{{nil, []byte{SEPARATOR}}, {[]byte{SEPARATOR + 1}, nil}} {
+			iter := m.storage.NewIteratorByRange(r[0], r[1])
+			for iter.Next() {
+				if err := fn(iter.Key(), iter.Value()); err != nil {
+					iter.Release()
+					return err
+				}
+			}
+			iter.Release()
+			if err := iter.Error(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(tag), nil
+}
+
+// Restore writes the items returned by next into the empty state db, as the flushed
+// state of tag. next returns io.EOF after the last item. The tag is written at last,
+// so an interrupted restore leaves the state db without tag, which should be discarded.
+func (m *CacheMVCCDB) Restore(tag string, next func() ([]byte, []byte, error)) error {
+	if m.CurrentTag() != "" {
+		return ErrStateNotEmpty
+	}
+	if err := m.flusher.wait(); err != nil {
+		return err
+	}
+	for done := false; !done; {
+		if err := m.storage.BeginBatch(); err != nil {
+			return err
+		}
+		for i := 0; i < restoreBatchSize; i++ {
+			key, value, err := next()
+			if err == io.EOF {
+				done = true
+				break
+			}
+			if err != nil {
+				m.storage.CommitBatch()
+				return err
+			}
+			if len(key) == 0 || key[0] == SEPARATOR {
+				m.storage.CommitBatch()
+				return fmt.Errorf("invalid key of state: %q", key)
+			}
+			if err := m.storage.Put(key, value); err != nil {
+				m.storage.CommitBatch()
+				return err
+			}
+		}
+		if err := m.storage.CommitBatch(); err != nil {
+			return err
+		}
+	}
+	if err := m.storage.Put(tagKey, []byte(tag)); err != nil {
+		return err
+	}
+
+	m.rwmu.Lock()
+	m.cm.AddTag(m.head, tag)
+	m.rwmu.Unlock()
+	return m.reloadStateTree()
+}
@@ -0,0 +1,365 @@
+package db
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/db/smt"
+	"github.com/iost-official/go-iost/ilog"
+)
+
+// The state root is the root of the sparse merkle tree over the items of state, whose keys
+// are table + "/" + key. The nodes of the tree of the flushed state are kept in storage as
+// nodePrefix + hash, and stateRootKey holds the root followed by the tag of the state. The
+// tree is rebuilt from the items if the tag mismatches the flushed state.
+var (
+	nodePrefix   = []byte(string(SEPARATOR) + "n" + string(SEPARATOR))
+	stateRootKey = []byte(string(SEPARATOR) + "stateroot")
+)
+
+// ErrStateRootDisabled is returned when getting the state root before it's enabled.
+var ErrStateRootDisabled = errors.New("state root is disabled")
+
+// stateRoots keeps the state trees of commits, which is shared by the forks.
+type stateRoots struct {
+	mu      sync.Mutex
+	enabled bool
+	store   smt.NodeStore
+}
+
+// storageNodes is the store of the nodes in storage.
+type storageNodes struct {
+	m *CacheMVCCDB
+}
+
+func nodeKey(hash []byte) []byte {
+	return append(append([]byte{}, nodePrefix...), hash...)
+}
+
+// Node returns the node of hash in storage.
+func (s *storageNodes) Node(hash []byte) ([]byte, error) {
+	n, err := s.m.storage.Get(nodeKey(hash))
+	if err != nil {
+		return nil, err
+	}
+	if len(n) == 0 {
+		return nil, smt.ErrNodeNotFound
+	}
+	return n, nil
+}
+
+func (c *Commit) stateTree() *smt.Tree {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.tree
+}
+
+func (c *Commit) setStateTree(tree *smt.Tree) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.tree = tree
+}
+
+// EnableStateRoot maintains the state root of every tagged state. The tree of the flushed
+// state is loaded from storage, or built from all the items if it's missing or outdated.
+func (m *CacheMVCCDB) EnableStateRoot() error {
+	return m.flusher.do(func() error {
+		m.roots.mu.Lock()
+		defer m.roots.mu.Unlock()
+
+		if m.roots.enabled {
+			return nil
+		}
+		if err := m.loadStateTree(); err != nil {
+			return err
+		}
+		m.roots.enabled = true
+		return nil
+	})
+}
+
+// reloadStateTree reloads the tree of the flushed state, which is rewritten without flush.
+func (m *CacheMVCCDB) reloadStateTree() error {
+	m.roots.mu.Lock()
+	defer m.roots.mu.Unlock()
+
+	if !m.roots.enabled {
+		return nil
+	}
+	return m.loadStateTree()
+}
+
+// loadStateTree sets the tree of the flushed state to the first commit.
+func (m *CacheMVCCDB) loadStateTree() error {
+	m.roots.store = &storageNodes{m: m}
+	tag, err := m.storage.Get(tagKey)
+	if err != nil {
+		return err
+	}
+	v, err := m.storage.Get(stateRootKey)
+	if err != nil {
+		return err
+	}
+	var root []byte
+	if len(v) >= smt.HashSize && bytes.Equal(v[smt.HashSize:], tag) {
+		root = v[:smt.HashSize]
+	} else {
+		ilog.Infof("build the state tree of %v", common.Base58Encode(tag))
+		if root, err = m.buildStateTree(string(tag)); err != nil {
+			return fmt.Errorf("failed to build state tree: %v", err)
+		}
+	}
+	m.cm.First().setStateTree(smt.New(root, m.roots.store))
+	return nil
+}
+
+// buildStateTree rebuilds the tree of the flushed state in storage, and returns the root.
+func (m *CacheMVCCDB) buildStateTree(tag string) ([]byte, error) {
+	keys, err := m.storage.Keys(nodePrefix)
+	if err != nil {
+		return nil, err
+	}
+	removed := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		removed[string(k[len(nodePrefix):])] = true
+	}
+	if err := m.deleteNodes(removed); err != nil {
+		return nil, err
+	}
+
+	tree := smt.New(nil, m.roots.store)
+	count := 0
+	// the keys of items never start with the separator
+	for _, r := range [][2][]byte{{nil, []byte{SEPARATOR}}, {[]byte{SEPARATOR + 1}, nil}} {
+		iter := m.storage.NewIteratorByRange(r[0], r[1])
+		for iter.Next() {
+			if len(iter.Value()) == 0 {
+				continue
+			}
+			if err := tree.Update(iter.Key(), iter.Value()); err != nil {
+				iter.Release()
+				return nil, err
+			}
+			count++
+			if count%flushBatchSize == 0 {
+				if err := m.writeTree(tree); err != nil {
+					iter.Release()
+					return nil, err
+				}
+			}
+		}
+		iter.Release()
+		if err := iter.Error(); err != nil {
+			return nil, err
+		}
+	}
+	if err := m.writeTree(tree); err != nil {
+		return nil, err
+	}
+	root := tree.Root()
+	return root, m.putStateRoot(root, tag)
+}
+
+// writeTree writes the changes of the tree into storage, and rebases the tree to storage.
+func (m *CacheMVCCDB) writeTree(tree *smt.Tree) error {
+	added, removed := tree.Changes()
+	if err := m.writeNodes(added); err != nil {
+		return err
+	}
+	if err := m.deleteNodes(removed); err != nil {
+		return err
+	}
+	tree.Rebase(m.roots.store)
+	return nil
+}
+
+// putStateRoot writes the root of the state of tag, it does nothing for a nil root.
+func (m *CacheMVCCDB) putStateRoot(root []byte, tag string) error {
+	if root == nil {
+		return nil
+	}
+	return m.storage.Put(stateRootKey, append(append([]byte{}, root...), tag...))
+}
+
+// writeNodes writes the nodes in batches of flushBatchSize.
+func (m *CacheMVCCDB) writeNodes(nodes map[string][]byte) error {
+	hashes := make([]string, 0, len(nodes))
+	for h := range nodes {
+		hashes = append(hashes, h)
+	}
+	return m.batchNodes(hashes, func(h string) error {
+		return m.storage.Put(nodeKey([]byte(h)), nodes[h])
+	})
+}
+
+// deleteNodes deletes the nodes in batches of flushBatchSize.
+func (m *CacheMVCCDB) deleteNodes(nodes map[string]bool) error {
+	hashes := make([]string, 0, len(nodes))
+	for h := range nodes {
+		hashes = append(hashes, h)
+	}
+	return m.batchNodes(hashes, func(h string) error {
+		return m.storage.Delete(nodeKey([]byte(h)))
+	})
+}
+
+func (m *CacheMVCCDB) batchNodes(hashes []string, fn func(h string) error) error {
+	sort.Strings(hashes)
+	for start := 0; start < len(hashes); start += flushBatchSize {
+		end := start + flushBatchSize
+		if end > len(hashes) {
+			end = len(hashes)
+		}
+		if err := m.storage.BeginBatch(); err != nil {
+			return err
+		}
+		for _, h := range hashes[start:end] {
+			if err := fn(h); err != nil {
+				m.storage.CommitBatch()
+				return err
+			}
+		}
+		if err := m.storage.CommitBatch(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// commitTree returns the state tree of the commit. The trees are computed from the nearest
+// ancestor with a tree, and kept by the tagged commits on the way.
+func (m *CacheMVCCDB) commitTree(commit *Commit) (*smt.Tree, error) {
+	var path []*Commit
+	var tree *smt.Tree
+	for c := commit; c != nil; c = c.parent {
+		if tree = c.stateTree(); tree != nil {
+			break
+		}
+		path = append(path, c)
+	}
+	if tree == nil {
+		return nil, fmt.Errorf("the state is freed")
+	}
+	changed := make(map[string]*Item)
+	for i := len(path) - 1; i >= 0; i-- {
+		for k, item := range path[i].dirtyItems() {
+			changed[k] = item
+		}
+		if i > 0 && len(m.cm.GetTags(path[i])) == 0 {
+			continue
+		}
+		tree = tree.Fork()
+		keys := make([]string, 0, len(changed))
+		for k := range changed {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			var value []byte
+			if item := changed[k]; !item.deleted && item.value != "" {
+				value = []byte(item.value)
+			}
+			if err := tree.Update([]byte(k), value); err != nil {
+				return nil, err
+			}
+		}
+		path[i].setStateTree(tree)
+		changed = make(map[string]*Item)
+	}
+	return tree, nil
+}
+
+// stateTreeChanges are the changes of the tree of a commit to the tree in storage.
+type stateTreeChanges struct {
+	tree    *smt.Tree
+	added   map[string][]byte
+	removed map[string]bool
+}
+
+// stateTreeChanges returns the changes of the state tree of the flushed commit, or nil if
+// the state root is disabled.
+func (m *CacheMVCCDB) stateTreeChanges(commit *Commit) (*stateTreeChanges, error) {
+	m.roots.mu.Lock()
+	defer m.roots.mu.Unlock()
+
+	if !m.roots.enabled {
+		return nil, nil
+	}
+	tree, err := m.commitTree(commit)
+	if err != nil {
+		return nil, err
+	}
+	var trees []*smt.Tree
+	for t := tree; t != nil; t = t.Parent() {
+		trees = append(trees, t)
+	}
+	changes := &stateTreeChanges{
+		tree:    tree,
+		added:   make(map[string][]byte),
+		removed: make(map[string]bool),
+	}
+	// the removed nodes of a version are in the former versions or storage
+	for i := len(trees) - 1; i >= 0; i-- {
+		added, removed := trees[i].Changes()
+		for h := range removed {
+			if _, ok := changes.added[h]; ok {
+				delete(changes.added, h)
+			} else {
+				changes.removed[h] = true
+			}
+		}
+		for h, n := range added {
+			if changes.removed[h] {
+				delete(changes.removed, h)
+			} else {
+				changes.added[h] = n
+			}
+		}
+	}
+	return changes, nil
+}
+
+// stateTree returns the state tree of the tag.
+func (m *CacheMVCCDB) stateTree(t string) (*smt.Tree, error) {
+	if !m.roots.enabled {
+		return nil, ErrStateRootDisabled
+	}
+	commit := m.cm.Get(t)
+	if commit == nil {
+		return nil, fmt.Errorf("not found tag: %v", t)
+	}
+	return m.commitTree(commit)
+}
+
+// StateRoot returns the root of the state tree of the tag.
+func (m *CacheMVCCDB) StateRoot(t string) ([]byte, error) {
+	m.roots.mu.Lock()
+	defer m.roots.mu.Unlock()
+
+	tree, err := m.stateTree(t)
+	if err != nil {
+		return nil, err
+	}
+	return tree.Root(), nil
+}
+
+// StateProof returns the proof of the item in the state tree of the tag.
+func (m *CacheMVCCDB) StateProof(t string, table string, key string) (*smt.Proof, error) {
+	if !m.isValidTable(table) {
+		return nil, ErrTableNotValid
+	}
+	m.roots.mu.Lock()
+	defer m.roots.mu.Unlock()
+
+	tree, err := m.stateTree(t)
+	if err != nil {
+		return nil, err
+	}
+	return tree.Prove([]byte(table + string(SEPARATOR) + key))
+}
@@ -0,0 +1,115 @@
+package wal
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/iost-official/go-iost/ilog"
+)
+
+// gzipMagic is the header of a compressed segment. A raw segment never starts with it,
+// as its first record is a crc record whose frame size is small.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// compressedTmpSuffix is the suffix of the segment being compressed.
+const compressedTmpSuffix = ".gz.tmp"
+
+// EnableCompression makes the segments compressed when they are cut. The compressed
+// segments are read transparently whether it's enabled or not.
+func (w *WAL) EnableCompression() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.compress = true
+}
+
+// compressInBackground compresses the segment which has been cut without holding the
+// lock, so that saving entries isn't blocked by it. The segment is replaced with the
+// compressed one unless it has been removed meanwhile.
+func (w *WAL) compressInBackground(path string) {
+	w.compressing.Add(1)
+	go func() {
+		defer w.compressing.Done()
+		tmp, err := compressSegment(path)
+		if err != nil {
+			ilog.Errorf("failed to compress WAL segment %v: %v", path, err)
+			return
+		}
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		if _, err := os.Stat(path); err != nil {
+			os.Remove(tmp)
+			return
+		}
+		if err := os.Rename(tmp, path); err != nil {
+			ilog.Errorf("failed to replace WAL segment %v: %v", path, err)
+			os.Remove(tmp)
+		}
+	}()
+}
+
+// compressSegment writes the compressed content of the segment file to a temp file, and
+// returns the path of it.
+func compressSegment(path string) (string, error) {
+	src, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+
+	tmp := path + compressedTmpSuffix
+	dst, err := os.Create(tmp)
+	if err != nil {
+		return "", err
+	}
+	zw, err := gzip.NewWriterLevel(dst, gzip.BestSpeed)
+	if err == nil {
+		if _, err = io.Copy(zw, src); err == nil {
+			err = zw.Close()
+		}
+	}
+	if err == nil {
+		err = dst.Sync()
+	}
+	if cerr := dst.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return "", err
+	}
+	return tmp, nil
+}
+
+// removeCompressedTmp removes the temp files of the compressions interrupted by a crash.
+func removeCompressedTmp(dirpath string) error {
+	names, err := filepath.Glob(filepath.Join(dirpath, "*"+compressedTmpSuffix))
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if err := os.Remove(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// segmentReader returns the reader of the content of the segment file, and whether the
+// segment is compressed.
+func segmentReader(f io.Reader) (io.Reader, bool, error) {
+	br := bufio.NewReader(f)
+	head, err := br.Peek(len(gzipMagic))
+	if err != nil || !bytes.Equal(head, gzipMagic) {
+		return br, false, nil
+	}
+	zr, err := gzip.NewReader(br)
+	if err != nil {
+		return nil, true, err
+	}
+	return zr, true, nil
+}
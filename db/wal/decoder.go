@@ -112,8 +112,13 @@ func (d *decoder) isTornWrite(data []byte) bool {
 	if len(d.r) != 1 {
 		return false
 	}
+	return isTornWrite(data, d.lastOffset)
+}
 
-	fileOff := d.lastOffset + frameSizeLength
+// isTornWrite checks whether the record data following the frame at lastOffset of the
+// file has a sector never written.
+func isTornWrite(data []byte, lastOffset int64) bool {
+	fileOff := lastOffset + frameSizeLength
 	curOff := 0
 	chunks := [][]byte{}
 	// split data on sector boundaries
@@ -0,0 +1,334 @@
+package wal
+
+import (
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iost-official/go-iost/db/wal/pcrc"
+)
+
+// maxRecordBytes is the max size of a record, the larger frame size means a corrupted frame.
+const maxRecordBytes = 1 << 30
+
+// ErrTornWrite is the error of the record partially written at the end of the WAL, which
+// is dropped on recovery.
+var ErrTornWrite = errors.New("wal: torn write")
+
+// Record is a record read from a segment.
+type Record struct {
+	Segment string  // the name of the segment file
+	Offset  int64   // the offset of the record in the uncompressed segment
+	Type    LogType // the type of the record
+	Entry   *Entry  // the entry of the record of entryType
+	Err     error   // the error of the bad record, the following records aren't read
+}
+
+// SegmentInfo is the summary of a segment.
+type SegmentInfo struct {
+	Name       string
+	Size       int64 // the size of the segment file
+	Compressed bool
+	Records    int
+	Entries    int
+	FirstIndex uint64 // the index of the first entry, valid if Entries > 0
+	LastIndex  uint64 // the index of the last entry, valid if Entries > 0
+}
+
+// Report is the result of checking all the records of a WAL directory.
+type Report struct {
+	Segments []*SegmentInfo
+	Records  int
+	Entries  int
+	// the first bad record, nil if all the records are good
+	Bad *Record
+}
+
+// Corrupted returns whether the WAL has a bad record, other than a torn write at the end
+// which is dropped on recovery.
+func (r *Report) Corrupted() bool {
+	return r.Bad != nil && r.Bad.Err != ErrTornWrite
+}
+
+// segmentNames returns the names of the segments in order, the tail segment is the first
+// temp file if there is any. Unlike readWALNames, the other files are left untouched.
+func segmentNames(dirpath string) ([]string, []string, error) {
+	names, err := filterDirWithExt(dirpath, "")
+	if err != nil {
+		return nil, nil, err
+	}
+	var segments, others []string
+	tail := ""
+	for _, name := range names {
+		switch {
+		case strings.HasSuffix(name, ".wal"):
+			if _, _, err := parseWALName(name); err == nil {
+				segments = append(segments, name)
+				continue
+			}
+		case strings.HasSuffix(name, ".wal.tmp") && tail == "":
+			tail = name
+			continue
+		}
+		others = append(others, name)
+	}
+	if tail != "" {
+		segments = append(segments, tail)
+	}
+	if len(segments) == 0 {
+		return nil, nil, ErrFileNotFound
+	}
+	return segments, others, nil
+}
+
+// Inspect reads all the records of the WAL directory, and calls fn with every record
+// until the first bad one. The WAL must not be opened for writing.
+func Inspect(dirpath string, fn func(*Record)) (*Report, error) {
+	names, _, err := segmentNames(dirpath)
+	if err != nil {
+		return nil, err
+	}
+	in := &inspector{
+		dirpath: dirpath,
+		crc:     pcrc.New(0, crc64Table),
+		report:  &Report{},
+		fn:      fn,
+	}
+	for i, name := range names {
+		info, err := in.segment(name, i == len(names)-1)
+		if err != nil {
+			return nil, err
+		}
+		in.report.Segments = append(in.report.Segments, info)
+		if in.report.Bad != nil {
+			break
+		}
+	}
+	return in.report, nil
+}
+
+type inspector struct {
+	dirpath string
+	crc     hash.Hash64
+	report  *Report
+	fn      func(*Record)
+}
+
+// segment reads the records of the segment until the end of it or the first bad record.
+func (in *inspector) segment(name string, tail bool) (*SegmentInfo, error) {
+	f, err := os.Open(filepath.Join(in.dirpath, name))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	st, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	info := &SegmentInfo{Name: name, Size: st.Size()}
+	r, compressed, err := segmentReader(f)
+	info.Compressed = compressed
+	if err != nil {
+		in.report.Bad = &Record{Segment: name, Err: err}
+		in.fn(in.report.Bad)
+		return info, nil
+	}
+	var offset int64
+	for {
+		l, err := readInt64(r)
+		if err == io.EOF || (err == nil && l == 0) {
+			// hit end of file or preallocated space
+			return info, nil
+		}
+		rec := &Record{Segment: name, Offset: offset}
+		var size int64
+		if err == nil {
+			size, err = in.record(r, l, rec, tail)
+		} else if err == io.ErrUnexpectedEOF && tail {
+			err = ErrTornWrite
+		}
+		if err != nil {
+			rec.Err = err
+			in.report.Bad = rec
+			in.fn(rec)
+			return info, nil
+		}
+		info.Records++
+		in.report.Records++
+		if rec.Entry != nil {
+			if info.Entries == 0 {
+				info.FirstIndex = rec.Entry.Index
+			}
+			info.LastIndex = rec.Entry.Index
+			info.Entries++
+			in.report.Entries++
+		}
+		in.fn(rec)
+		offset += size
+	}
+}
+
+// record reads the record of frame size l, and returns the size of the frame.
+func (in *inspector) record(r io.Reader, l int64, rec *Record, tail bool) (int64, error) {
+	recBytes, padBytes := decodeFrameSize(l)
+	if recBytes > maxRecordBytes {
+		return 0, fmt.Errorf("invalid frame size %d", recBytes)
+	}
+	data := make([]byte, recBytes+padBytes)
+	if _, err := io.ReadFull(r, data); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			if tail {
+				return 0, ErrTornWrite
+			}
+			return 0, io.ErrUnexpectedEOF
+		}
+		return 0, err
+	}
+	// only the last record of the tail segment may be partially written
+	torn := tail && isTornWrite(data, rec.Offset)
+	log := &Log{}
+	if err := proto.Unmarshal(data[:recBytes], log); err != nil {
+		if torn {
+			return 0, ErrTornWrite
+		}
+		return 0, fmt.Errorf("invalid record: %v", err)
+	}
+	rec.Type = log.Type
+	switch log.Type {
+	case LogType_crcType:
+		// the crc of the previous segments, which is 0 for the first one
+		if crc := in.crc.Sum64(); crc != 0 && log.Check(crc) != nil {
+			return 0, ErrCRCMismatch
+		}
+		in.crc = pcrc.New(log.Checksum, crc64Table)
+	case LogType_metaDataType, LogType_entryType:
+		in.crc.Write(log.Data)
+		if log.Check(in.crc.Sum64()) != nil {
+			if torn {
+				return 0, ErrTornWrite
+			}
+			return 0, ErrCRCMismatch
+		}
+	default:
+		return 0, fmt.Errorf("unexpected record type %d", log.Type)
+	}
+	if log.Type == LogType_entryType {
+		e := &Entry{}
+		if err := proto.Unmarshal(log.Data, e); err != nil {
+			return 0, fmt.Errorf("invalid entry: %v", err)
+		}
+		rec.Entry = e
+	}
+	return frameSizeLength + recBytes + padBytes, nil
+}
+
+// Truncate drops the first bad record and the following ones, so that the WAL recovers
+// all the records before it. The segment of the bad record becomes the tail segment. It
+// returns the report before truncating and the names of the removed files. The WAL must
+// not be opened.
+func Truncate(dirpath string) (*Report, []string, error) {
+	report, err := Inspect(dirpath, func(*Record) {})
+	if err != nil || report.Bad == nil {
+		return report, nil, err
+	}
+	names, others, err := segmentNames(dirpath)
+	if err != nil {
+		return nil, nil, err
+	}
+	var removed []string
+	remove := func(name string) error {
+		if err := os.Remove(filepath.Join(dirpath, name)); err != nil {
+			return err
+		}
+		removed = append(removed, name)
+		return nil
+	}
+	bad := report.Bad
+	after := false
+	for _, name := range names {
+		if after {
+			if err := remove(name); err != nil {
+				return nil, nil, err
+			}
+		}
+		after = after || name == bad.Segment
+	}
+	// the preallocated segments and the interrupted compressions
+	for _, name := range others {
+		if strings.HasSuffix(name, ".wal.tmp") || strings.HasSuffix(name, compressedTmpSuffix) {
+			if err := remove(name); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	if strings.HasSuffix(bad.Segment, ".wal.tmp") {
+		return report, removed, zeroSegment(filepath.Join(dirpath, bad.Segment), bad.Offset)
+	}
+	if err := rewriteTail(dirpath, bad.Segment, bad.Offset); err != nil {
+		return nil, nil, err
+	}
+	return report, append(removed, bad.Segment), nil
+}
+
+// zeroSegment zeros the segment from offset to the end.
+func zeroSegment(path string, offset int64) error {
+	f, err := os.OpenFile(path, os.O_RDWR, 0666)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+	if err := ZeroToEnd(f); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+// rewriteTail replaces the segment with a tail segment of its content before offset.
+func rewriteTail(dirpath, name string, offset int64) error {
+	f, err := os.Open(filepath.Join(dirpath, name))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	content := []byte{}
+	if offset > 0 {
+		r, _, err := segmentReader(f)
+		if err != nil {
+			return err
+		}
+		if content, err = ioutil.ReadAll(io.LimitReader(r, offset)); err != nil {
+			return err
+		}
+	}
+
+	path := filepath.Join(dirpath, fmt.Sprintf(".%d.0.wal.tmp", time.Now().UnixNano()))
+	tail, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	_, err = tail.Write(content)
+	if err == nil && int64(len(content)) < SegmentSizeBytes {
+		err = tail.Truncate(SegmentSizeBytes)
+	}
+	if err == nil {
+		err = tail.Sync()
+	}
+	if cerr := tail.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(path)
+		return err
+	}
+	return os.Remove(filepath.Join(dirpath, name))
+}
@@ -0,0 +1,170 @@
+package wal
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func saveWithCut(t *testing.T, w *WAL) {
+	ents := []Entry{{Data: []byte("Entry1")}, {Data: []byte("Entry2")}}
+	if _, err := w.Save(ents); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.cut(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Save(ents); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func firstSegment(t *testing.T, p string) string {
+	names, _, err := segmentNames(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 2 || !strings.HasSuffix(names[0], ".wal") {
+		t.Fatal("unexpected segments: ", names)
+	}
+	return filepath.Join(p, names[0])
+}
+
+func TestCompression(t *testing.T) {
+	p, err := ioutil.TempDir(os.TempDir(), "waltest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(p)
+
+	w, err := Create(p, []byte("somedata"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.EnableCompression()
+	saveWithCut(t, w)
+
+	data, err := ioutil.ReadFile(firstSegment(t, p))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(data, gzipMagic) {
+		t.Fatal("the cut segment isn't compressed")
+	}
+
+	report, err := Inspect(p, func(*Record) {})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Bad != nil || report.Entries != 4 || len(report.Segments) != 2 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+	if !report.Segments[0].Compressed || report.Segments[1].Compressed {
+		t.Fatal("only the first segment should be compressed")
+	}
+	if report.Segments[1].FirstIndex != 2 || report.Segments[1].LastIndex != 3 {
+		t.Fatalf("unexpected segment: %+v", report.Segments[1])
+	}
+
+	// the temp file of an interrupted compression is removed on open
+	tmp := firstSegment(t, p) + compressedTmpSuffix
+	if err := ioutil.WriteFile(tmp, []byte("partial"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	newW, err := Create(p, []byte("somedata"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer newW.Close()
+	if _, err := os.Stat(tmp); !os.IsNotExist(err) {
+		t.Fatal("the temp file of compression isn't removed")
+	}
+	metad, entries, err := newW.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(metad) != "somedata" {
+		t.Fatal("metadata not consistent! Got: ", string(metad), " expect: somedata")
+	}
+	if len(entries) != 4 || string(entries[3].Data) != "Entry2" {
+		t.Fatal("Entries not match, got: ", entries)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	p, err := ioutil.TempDir(os.TempDir(), "waltest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(p)
+
+	w, err := Create(p, []byte("somedata"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	saveWithCut(t, w)
+
+	// corrupt the second entry of the first segment
+	path := firstSegment(t, p)
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	i := bytes.Index(data, []byte("Entry2"))
+	if i < 0 {
+		t.Fatal("entry not found")
+	}
+	data[i] = 'e'
+	if err := ioutil.WriteFile(path, data, 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	var records []*Record
+	report, err := Inspect(p, func(r *Record) { records = append(records, r) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Corrupted() || report.Bad.Err != ErrCRCMismatch || report.Bad.Segment != filepath.Base(path) {
+		t.Fatalf("unexpected bad record: %+v", report.Bad)
+	}
+	if report.Entries != 1 || records[len(records)-1] != report.Bad {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+
+	report, removed, err := Truncate(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Corrupted() || len(removed) != 2 {
+		t.Fatal("unexpected removed files: ", removed)
+	}
+	report, err = Inspect(p, func(*Record) {})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Bad != nil || report.Entries != 1 {
+		t.Fatalf("unexpected report after truncating: %+v", report)
+	}
+
+	newW, err := Create(p, []byte("somedata"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer newW.Close()
+	_, entries, err := newW.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || string(entries[0].Data) != "Entry1" {
+		t.Fatal("Entries not match, got: ", entries)
+	}
+	if _, err := newW.SaveSingle(Entry{Data: []byte("Entry3")}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -58,6 +58,9 @@ type WAL struct {
 
 	files []*os.File // the locked files the WAL holds (the name is increasing)
 	st    *StreamFile
+
+	compress    bool           // whether the segments are compressed when they are cut
+	compressing sync.WaitGroup // the compressions running in background
 }
 
 // Create creates a WAL ready for appending records. The given metadata is
@@ -131,6 +134,9 @@ func recoverFromDir(dirpath string, metadata []byte) (*WAL, error) {
 // the given snap. The WAL cannot be appended to before reading out all of its
 // previous records.
 func Open(dirpath string) (*WAL, error) {
+	if err := removeCompressedTmp(dirpath); err != nil {
+		return nil, err
+	}
 	w, err := openAtIndex(dirpath)
 	if err != nil {
 		return nil, err
@@ -165,7 +171,13 @@ func openAtIndex(dirpath string) (*WAL, error) {
 			return nil, err
 		}
 		ls = append(ls, l)
-		rs = append(rs, l)
+		r, _, err := segmentReader(l)
+		if err != nil {
+			closeAll(rcs...)
+			l.Close()
+			return nil, fmt.Errorf("failed to read segment %v: %v", name, err)
+		}
+		rs = append(rs, r)
 		if strings.HasSuffix(name, ".wal") {
 			rcs = append(rcs, l)
 		}
@@ -338,12 +350,14 @@ func (w *WAL) cut() error {
 	if err := os.Rename(w.tail().Name(), fpath); err != nil {
 		return err
 	}
-
 	var err error
 	w.files[len(w.files)-1], err = os.Open(fpath)
 	if err != nil {
 		return err
 	}
+	if w.compress {
+		w.compressInBackground(fpath)
+	}
 
 	// create a temp wal file with name sequence + 1, or truncate the existing one
 	newTail, err := w.st.GetNewFile()
@@ -447,6 +461,7 @@ func (w *WAL) ReleaseLockTo(index uint64) error {
 
 // Close closes the current WAL file and directory.
 func (w *WAL) Close() error {
+	w.compressing.Wait()
 	w.mu.Lock()
 	defer w.mu.Unlock()
 
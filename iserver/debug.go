@@ -59,10 +59,40 @@ func (d *DebugServer) Start() error {
 			rw.Write([]byte(d.blkChain.Draw(int64(start), int64(end))))
 		})
 
+	http.HandleFunc(
+		"/debug/verifydb/",
+		func(rw http.ResponseWriter, r *http.Request) {
+			opts := &VerifyDBOptions{}
+			q := r.URL.Query()
+			var err error
+			if s := q.Get("start"); s != "" {
+				if opts.Start, err = strconv.ParseInt(s, 10, 64); err != nil {
+					http.Error(rw, err.Error(), http.StatusBadRequest)
+					return
+				}
+			}
+			if s := q.Get("end"); s != "" {
+				if opts.End, err = strconv.ParseInt(s, 10, 64); err != nil {
+					http.Error(rw, err.Error(), http.StatusBadRequest)
+					return
+				}
+			}
+			report, err := verifyChain(d.blkChain, opts)
+			if err != nil {
+				http.Error(rw, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			bytes, _ := json.MarshalIndent(report, "", "    ")
+			rw.Write(bytes)
+		})
+
 	http.HandleFunc(
 		"/debug/p2p/neighbors/",
 		func(rw http.ResponseWriter, r *http.Request) {
-			neighbors := d.p2p.NeighborStat()
+			neighbors := map[string]interface{}{}
+			if d.p2p != nil {
+				neighbors = d.p2p.NeighborStat()
+			}
 			bytes, _ := json.MarshalIndent(neighbors, "", "    ")
 			rw.Write(bytes)
 		})
@@ -4,6 +4,7 @@ import (
 	"github.com/iost-official/go-iost/account"
 	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/consensus"
+	"github.com/iost-official/go-iost/consensus/genesis"
 	"github.com/iost-official/go-iost/consensus/synchronizer"
 	"github.com/iost-official/go-iost/core/blockcache"
 	"github.com/iost-official/go-iost/core/global"
@@ -24,8 +25,8 @@ type Service interface {
 // IServer is application for IOST.
 type IServer struct {
 	bv        global.BaseVariable
-	p2p       *p2p.NetService
-	sync      *synchronizer.SyncImpl
+	p2p       p2p.Service
+	sync      *synchronizer.SyncImpl // nil in dev mode
 	txp       *txpool.TxPImpl
 	rpcServer *rpc.Server
 	consensus consensus.Consensus
@@ -43,13 +44,33 @@ func New(conf *common.Config) *IServer {
 	if err := checkGenesis(bv); err != nil {
 		ilog.Fatalf("Check genesis failed: %v", err)
 	}
+	production, err := genesis.ProductionConfigByFile(bv.BlockChain(), conf.Genesis)
+	if err != nil {
+		ilog.Fatalf("Check production config failed: %v", err)
+	}
+	bv.SetProduction(production)
 	if err := recoverDB(bv); err != nil {
 		ilog.Fatalf("Recover DB failed: %v", err)
 	}
 
-	p2pService, err := p2p.NewNetService(conf.P2P)
-	if err != nil {
-		ilog.Fatalf("network initialization failed, stop the program! err:%v", err)
+	cType := consensus.Pob
+	if conf.Consensus != nil {
+		cType, err = consensus.ParseType(conf.Consensus.Type)
+		if err != nil {
+			ilog.Fatalf("invalid consensus type, stop the program! err:%v", err)
+		}
+	}
+
+	// the dev chain has a single node without network
+	dev := cType == consensus.Dev
+	var p2pService p2p.Service = p2p.NewLocalService()
+	var netService *p2p.NetService
+	if !dev {
+		netService, err = p2p.NewNetService(conf.P2P)
+		if err != nil {
+			ilog.Fatalf("network initialization failed, stop the program! err:%v", err)
+		}
+		p2pService = netService
 	}
 
 	accSecKey := conf.ACC.SecKey
@@ -68,16 +89,22 @@ func New(conf *common.Config) *IServer {
 		ilog.Fatalf("txpool initialization failed, stop the program! err:%v", err)
 	}
 
-	consensus := consensus.New(consensus.Pob, acc, bv, blkCache, txp, p2pService)
-
-	rpcServer := rpc.New(txp, blkCache, bv, p2pService)
+	consensus := consensus.New(cType, acc, bv, blkCache, txp, p2pService)
 
-	sync, err := synchronizer.NewSynchronizer(bv, blkCache, p2pService)
+	rpcServer, err := rpc.New(txp, blkCache, bv, p2pService)
 	if err != nil {
-		ilog.Fatalf("synchronizer initialization failed, stop the program! err:%v", err)
+		ilog.Fatalf("rpc server initialization failed, stop the program! err:%v", err)
 	}
 
-	debug := NewDebugServer(conf.Debug, p2pService, blkCache, bv.BlockChain())
+	var sync *synchronizer.SyncImpl
+	if !dev {
+		sync, err = synchronizer.NewSynchronizer(bv, blkCache, p2pService)
+		if err != nil {
+			ilog.Fatalf("synchronizer initialization failed, stop the program! err:%v", err)
+		}
+	}
+
+	debug := NewDebugServer(conf.Debug, netService, blkCache, bv.BlockChain())
 
 	return &IServer{
 		bv:        bv,
@@ -90,16 +117,18 @@ func New(conf *common.Config) *IServer {
 	}
 }
 
+// services returns the services in the order of starting.
+func (s *IServer) services() []Service {
+	services := []Service{s.p2p}
+	if s.sync != nil {
+		services = append(services, s.sync)
+	}
+	return append(services, s.txp, s.consensus, s.rpcServer)
+}
+
 // Start starts iserver application.
 func (s *IServer) Start() error {
-	Services := []Service{
-		s.p2p,
-		s.sync,
-		s.txp,
-		s.consensus,
-		s.rpcServer,
-	}
-	for _, s := range Services {
+	for _, s := range s.services() {
 		if err := s.Start(); err != nil {
 			return err
 		}
@@ -119,15 +148,9 @@ func (s *IServer) Stop() {
 	if conf.Debug != nil {
 		s.debug.Stop()
 	}
-	Services := []Service{
-		s.rpcServer,
-		s.consensus,
-		s.txp,
-		s.sync,
-		s.p2p,
-	}
-	for _, s := range Services {
-		s.Stop()
+	services := s.services()
+	for i := len(services) - 1; i >= 0; i-- {
+		services[i].Stop()
 	}
 	s.bv.BlockChain().Close()
 	s.bv.StateDB().Close()
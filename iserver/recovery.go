@@ -17,15 +17,14 @@ func checkGenesis(bv global.BaseVariable) error {
 	stateDB := bv.StateDB()
 	conf := bv.Config()
 
-	blk, err := blockChain.GetBlockByNumber(0)
-	if err != nil { //blockchaindb is empty
+	if blockChain.Length() == 0 { //blockchaindb is empty
 		ilog.Infof("Genesis is not exist.")
 		hash := stateDB.CurrentTag()
 		if hash != "" {
 			return fmt.Errorf("blockchaindb is empty, but statedb is not")
 		}
 
-		blk, err = genesis.GenGenesisByFile(stateDB, conf.Genesis)
+		blk, err := genesis.GenGenesisByFile(stateDB, conf.Genesis)
 		if err != nil {
 			return fmt.Errorf("new GenGenesis failed, stop the program. err: %v", err)
 		}
@@ -33,12 +32,18 @@ func checkGenesis(bv global.BaseVariable) error {
 		if err != nil {
 			return fmt.Errorf("push block in blockChain failed, stop the program. err: %v", err)
 		}
-		err = stateDB.Flush(string(blk.HeadHash()))
+		err = stateDB.FlushBlock(string(blk.HeadHash()), blk.Head.Number)
 		if err != nil {
 			return fmt.Errorf("flush block into stateDB failed, stop the program. err: %v", err)
 		}
 		ilog.Infof("Created Genesis.")
 	}
+	blk, err := blockChain.GetBlockByNumber(0)
+	if err != nil {
+		// the blocks before the snapshot are not in the blockchaindb imported from it
+		ilog.Infof("Genesis is not in blockchaindb, which is imported from the snapshot at block %v", blockChain.Length()-1)
+		return nil
+	}
 	// TODO check genesis hash between config and db
 	ilog.Infof("GenesisHash: %v", common.Base58Encode(blk.HeadHash()))
 
@@ -68,7 +73,7 @@ func recoverDB(bv global.BaseVariable) error {
 		v := verifier.Verifier{}
 		err = v.Verify(blk, parent, stateDB, &verifier.Config{
 			Mode:        0,
-			Timeout:     common.SlotLength / 3 * time.Second,
+			Timeout:     bv.Production().SlotLength() / 3,
 			TxTimeLimit: time.Millisecond * 100,
 		})
 		if err != nil {
@@ -76,7 +81,7 @@ func recoverDB(bv global.BaseVariable) error {
 		}
 		parent = blk
 		stateDB.Tag(string(blk.HeadHash()))
-		err = stateDB.Flush(string(blk.HeadHash()))
+		err = stateDB.FlushBlock(string(blk.HeadHash()), blk.Head.Number)
 		if err != nil {
 			return fmt.Errorf("flush stateDB failed, stop the pogram. err: %v", err)
 		}
@@ -0,0 +1,411 @@
+package iserver
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/core/global"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/db"
+	"github.com/iost-official/go-iost/ilog"
+)
+
+// The snapshot is a gzip stream of
+//
+//	magic | version | block | witness list | delay txs | state items | sha256
+//
+// The block is the LIB at which the state is flushed. The delay txs are the ones not
+// executed yet, which are referred by the following blocks. Every field is a record of
+// uvarint length and bytes, the delay txs and state items are ended by an empty record,
+// and the sha256 checksum is of all bytes before it.
+const (
+	snapshotMagic   = "IOSTSNAP"
+	snapshotVersion = 1
+
+	maxSnapshotRecordSize = 1 << 30
+)
+
+var (
+	errSnapshotChecksum = errors.New("snapshot checksum mismatch")
+	errNoTrustedHash    = errors.New("the trusted hash of the snapshot block is required")
+)
+
+type snapshotWriter struct {
+	gz   *gzip.Writer
+	w    io.Writer
+	hash hash.Hash
+	buf  [binary.MaxVarintLen64]byte
+}
+
+func newSnapshotWriter(w io.Writer) *snapshotWriter {
+	gz := gzip.NewWriter(w)
+	h := sha256.New()
+	return &snapshotWriter{
+		gz:   gz,
+		w:    io.MultiWriter(gz, h),
+		hash: h,
+	}
+}
+
+func (sw *snapshotWriter) writeUvarint(n uint64) error {
+	_, err := sw.w.Write(sw.buf[:binary.PutUvarint(sw.buf[:], n)])
+	return err
+}
+
+func (sw *snapshotWriter) writeRecord(data []byte) error {
+	if err := sw.writeUvarint(uint64(len(data))); err != nil {
+		return err
+	}
+	_, err := sw.w.Write(data)
+	return err
+}
+
+// close writes the checksum and flushes the gzip stream.
+func (sw *snapshotWriter) close() error {
+	if _, err := sw.gz.Write(sw.hash.Sum(nil)); err != nil {
+		return err
+	}
+	return sw.gz.Close()
+}
+
+type snapshotReader struct {
+	gz   *gzip.Reader
+	r    *bufio.Reader
+	hash hash.Hash
+}
+
+func newSnapshotReader(r io.Reader) (*snapshotReader, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("invalid snapshot: %v", err)
+	}
+	return &snapshotReader{
+		gz:   gz,
+		r:    bufio.NewReader(gz),
+		hash: sha256.New(),
+	}, nil
+}
+
+// ReadByte reads a byte into the checksum.
+func (sr *snapshotReader) ReadByte() (byte, error) {
+	b, err := sr.r.ReadByte()
+	if err == nil {
+		sr.hash.Write([]byte{b})
+	}
+	return b, err
+}
+
+func (sr *snapshotReader) readUvarint() (uint64, error) {
+	n, err := binary.ReadUvarint(sr)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+func (sr *snapshotReader) readRecord() ([]byte, error) {
+	n, err := sr.readUvarint()
+	if err != nil {
+		return nil, err
+	}
+	if n > maxSnapshotRecordSize {
+		return nil, fmt.Errorf("snapshot record is too large: %v", n)
+	}
+	data := make([]byte, n)
+	if _, err := io.ReadFull(sr.r, data); err != nil {
+		return nil, err
+	}
+	sr.hash.Write(data)
+	return data, nil
+}
+
+// verify checks the checksum, which must be followed by the end of stream.
+func (sr *snapshotReader) verify() error {
+	sum := make([]byte, sha256.Size)
+	if _, err := io.ReadFull(sr.r, sum); err != nil {
+		return errSnapshotChecksum
+	}
+	if !bytes.Equal(sum, sr.hash.Sum(nil)) {
+		return errSnapshotChecksum
+	}
+	if _, err := sr.r.ReadByte(); err != io.EOF {
+		return fmt.Errorf("unexpected data after snapshot checksum")
+	}
+	return sr.gz.Close()
+}
+
+// snapshotWitnessList returns the witness list of the flushed state, as block cache does for LIB.
+func snapshotWitnessList(stateDB db.MVCCDB) (*blockcache.WitnessList, error) {
+	wl := &blockcache.WitnessList{WitnessInfo: make(map[string]*blockcache.WitnessInfo)}
+	if err := wl.UpdatePending(stateDB); err != nil {
+		return nil, err
+	}
+	if err := wl.UpdateInfo(stateDB); err != nil {
+		return nil, err
+	}
+	wl.LibWitnessHandle()
+	return wl, nil
+}
+
+func sameWitnesses(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// verifySnapshotBlock checks the block against the hash the operator trusts, which is the
+// only anchor of the snapshot, and the merkle hashes and the witness signature of the block.
+func verifySnapshotBlock(blk *block.Block, trustedHash []byte) error {
+	if !bytes.Equal(blk.HeadHash(), trustedHash) {
+		return fmt.Errorf("hash %v of snapshot block %v isn't the trusted hash %v", common.Base58Encode(blk.HeadHash()),
+			blk.Head.Number, common.Base58Encode(trustedHash))
+	}
+	if !bytes.Equal(blk.CalculateTxMerkleHash(), blk.Head.TxMerkleHash) {
+		return fmt.Errorf("wrong txs hash of block")
+	}
+	if !bytes.Equal(blk.CalculateTxReceiptMerkleHash(), blk.Head.TxReceiptMerkleHash) {
+		return fmt.Errorf("wrong tx receipt merkle hash of block")
+	}
+	if blk.Head.Number == 0 {
+		return nil
+	}
+	blk.Sign.SetPubkey(account.DecodePubkey(blk.Head.Witness))
+	if !blk.Sign.Verify(blk.HeadHash()) {
+		return fmt.Errorf("wrong signature of block")
+	}
+	return nil
+}
+
+func exportSnapshot(blockChain block.Chain, stateDB db.MVCCDB, w io.Writer) (*block.Block, error) {
+	tag := stateDB.CurrentTag()
+	if tag == "" {
+		return nil, fmt.Errorf("no flushed state to export")
+	}
+	blk, err := blockChain.GetBlockByHash([]byte(tag))
+	if err != nil {
+		return nil, fmt.Errorf("get block of state failed: %v", err)
+	}
+	blkByte, err := blk.Encode()
+	if err != nil {
+		return nil, err
+	}
+	wl, err := snapshotWitnessList(stateDB)
+	if err != nil {
+		return nil, fmt.Errorf("get witness list failed: %v", err)
+	}
+	wlByte, err := proto.Marshal(wl)
+	if err != nil {
+		return nil, err
+	}
+	delaytxs, err := blockChain.AllDelaytx()
+	if err != nil {
+		return nil, err
+	}
+
+	sw := newSnapshotWriter(w)
+	if _, err := sw.w.Write([]byte(snapshotMagic)); err != nil {
+		return nil, err
+	}
+	if err := sw.writeUvarint(snapshotVersion); err != nil {
+		return nil, err
+	}
+	for _, record := range [][]byte{blkByte, wlByte} {
+		if err := sw.writeRecord(record); err != nil {
+			return nil, err
+		}
+	}
+	for _, t := range delaytxs {
+		if err := sw.writeRecord(t.Encode()); err != nil {
+			return nil, err
+		}
+	}
+	if err := sw.writeRecord(nil); err != nil {
+		return nil, err
+	}
+	count := 0
+	stateTag, err := stateDB.Snapshot(func(key []byte, value []byte) error {
+		count++
+		if err := sw.writeRecord(key); err != nil {
+			return err
+		}
+		return sw.writeRecord(value)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("export state failed: %v", err)
+	}
+	if stateTag != tag {
+		return nil, fmt.Errorf("state is flushed during export")
+	}
+	if err := sw.writeRecord(nil); err != nil {
+		return nil, err
+	}
+	if err := sw.close(); err != nil {
+		return nil, err
+	}
+	ilog.Infof("Exported %v state items and %v delay txs at block %v", count, len(delaytxs), blk.Head.Number)
+	return blk, nil
+}
+
+func importSnapshot(blockChain block.Chain, stateDB db.MVCCDB, r io.Reader, trustedHash []byte) (*block.Block, error) {
+	if len(trustedHash) == 0 {
+		return nil, errNoTrustedHash
+	}
+	if blockChain.Length() != 0 || stateDB.CurrentTag() != "" {
+		return nil, fmt.Errorf("blockchaindb or statedb is not empty")
+	}
+	sr, err := newSnapshotReader(r)
+	if err != nil {
+		return nil, err
+	}
+	magic := make([]byte, len(snapshotMagic))
+	if _, err := io.ReadFull(sr.r, magic); err != nil || string(magic) != snapshotMagic {
+		return nil, fmt.Errorf("invalid snapshot: wrong magic")
+	}
+	sr.hash.Write(magic)
+	version, err := sr.readUvarint()
+	if err != nil {
+		return nil, err
+	}
+	if version != snapshotVersion {
+		return nil, fmt.Errorf("unsupported snapshot version %v", version)
+	}
+
+	blkByte, err := sr.readRecord()
+	if err != nil {
+		return nil, err
+	}
+	blk := &block.Block{}
+	if err := blk.Decode(blkByte); err != nil {
+		return nil, fmt.Errorf("invalid block of snapshot: %v", err)
+	}
+	if err := verifySnapshotBlock(blk, trustedHash); err != nil {
+		return nil, err
+	}
+	wlByte, err := sr.readRecord()
+	if err != nil {
+		return nil, err
+	}
+	wl := &blockcache.WitnessList{}
+	if err := proto.Unmarshal(wlByte, wl); err != nil {
+		return nil, fmt.Errorf("invalid witness list of snapshot: %v", err)
+	}
+	var delaytxs []*tx.Tx
+	for {
+		txByte, err := sr.readRecord()
+		if err != nil {
+			return nil, err
+		}
+		if len(txByte) == 0 {
+			break
+		}
+		t := &tx.Tx{}
+		if err := t.Decode(txByte); err != nil {
+			return nil, fmt.Errorf("invalid delay tx of snapshot: %v", err)
+		}
+		delaytxs = append(delaytxs, t)
+	}
+
+	count := 0
+	err = stateDB.Restore(string(blk.HeadHash()), func() ([]byte, []byte, error) {
+		key, err := sr.readRecord()
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(key) == 0 {
+			return nil, nil, io.EOF
+		}
+		value, err := sr.readRecord()
+		if err != nil {
+			return nil, nil, err
+		}
+		count++
+		return key, value, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("import state failed: %v", err)
+	}
+	if err := sr.verify(); err != nil {
+		return nil, err
+	}
+
+	stateWl, err := snapshotWitnessList(stateDB)
+	if err != nil {
+		return nil, fmt.Errorf("get witness list of state failed: %v", err)
+	}
+	if stateWl.PendingNum() != wl.PendingNum() || !sameWitnesses(stateWl.Active(), wl.Active()) ||
+		!sameWitnesses(stateWl.Pending(), wl.Pending()) {
+		return nil, fmt.Errorf("witness list of snapshot doesn't match the state")
+	}
+	for _, t := range delaytxs {
+		if err := blockChain.PutDelaytx(t); err != nil {
+			return nil, err
+		}
+	}
+	if err := blockChain.Push(blk); err != nil {
+		return nil, fmt.Errorf("push block failed: %v", err)
+	}
+	ilog.Infof("Imported %v state items and %v delay txs at block %v", count, len(delaytxs), blk.Head.Number)
+	return blk, nil
+}
+
+// ExportSnapshot writes the snapshot of the flushed state, which is the state of LIB,
+// and the LIB with its witness list to w. The server must be stopped.
+func ExportSnapshot(conf *common.Config, w io.Writer) (*block.Block, error) {
+	bv, err := global.New(conf)
+	if err != nil {
+		return nil, err
+	}
+	defer bv.StateDB().Close()
+	defer bv.BlockChain().Close()
+
+	return exportSnapshot(bv.BlockChain(), bv.StateDB(), w)
+}
+
+// ImportSnapshot creates the state db and blockchain db from the snapshot of r, after which
+// the server syncs the blocks following the snapshot. The hash of the snapshot block must be
+// the trusted one, such as the hash of the LIB got from other nodes. The databases must not
+// exist, and are removed if the import fails.
+func ImportSnapshot(conf *common.Config, r io.Reader, trustedHash []byte) (*block.Block, error) {
+	if len(trustedHash) == 0 {
+		return nil, errNoTrustedHash
+	}
+	paths := []string{conf.DB.LdbPath + "BlockChainDB", conf.DB.LdbPath + "StateDB"}
+	for _, path := range paths {
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			return nil, fmt.Errorf("%v exists, the snapshot must be imported into an empty data directory", path)
+		}
+	}
+	bv, err := global.New(conf)
+	if err != nil {
+		return nil, err
+	}
+	blk, err := importSnapshot(bv.BlockChain(), bv.StateDB(), r, trustedHash)
+	bv.StateDB().Close()
+	bv.BlockChain().Close()
+	if err != nil {
+		for _, path := range paths {
+			os.RemoveAll(path)
+		}
+		return nil, err
+	}
+	return blk, nil
+}
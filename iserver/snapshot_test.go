@@ -0,0 +1,136 @@
+package iserver
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/iost-official/go-iost/db"
+	"github.com/iost-official/go-iost/vm/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func openSnapshotDB(t *testing.T, dir string) (block.Chain, db.MVCCDB) {
+	chain, err := block.NewBlockChain(filepath.Join(dir, "BlockChainDB"))
+	require.Nil(t, err)
+	stateDB, err := db.NewMVCCDB(filepath.Join(dir, "StateDB"))
+	require.Nil(t, err)
+	return chain, stateDB
+}
+
+func TestSnapshot(t *testing.T) {
+	dir, err := ioutil.TempDir("", "snapshot")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	acc, err := account.NewKeyPair(nil, crypto.Secp256k1)
+	require.Nil(t, err)
+	blk := &block.Block{
+		Head: &block.BlockHead{
+			Version:    0,
+			ParentHash: []byte("parent"),
+			Info:       []byte{},
+			Number:     10,
+			Witness:    acc.ReadablePubkey(),
+			Time:       time.Now().UnixNano(),
+		},
+		Txs:      []*tx.Tx{},
+		Receipts: []*tx.TxReceipt{},
+	}
+	blk.Head.TxMerkleHash = blk.CalculateTxMerkleHash()
+	blk.Head.TxReceiptMerkleHash = blk.CalculateTxReceiptMerkleHash()
+	require.Nil(t, blk.CalculateHeadHash())
+	blk.Sign = acc.Sign(blk.HeadHash())
+	delaytx := tx.NewTx([]*tx.Action{tx.NewAction("iost.system", "Transfer", "[]")}, nil, 100000, 100, time.Now().Add(time.Hour).UnixNano(), 1000, 0)
+
+	chain, stateDB := openSnapshotDB(t, filepath.Join(dir, "src"))
+	require.Nil(t, chain.Push(blk))
+	require.Nil(t, chain.PutDelaytx(delaytx))
+	vi := database.NewVisitor(0, stateDB)
+	vi.Put("vote_producer.iost-pendingBlockNumber", database.MustMarshal("9"))
+	vi.Put("vote_producer.iost-pendingProducerList", database.MustMarshal(`["`+acc.ReadablePubkey()+`"]`))
+	vi.Put("contract-key", database.MustMarshal("value"))
+	vi.Commit()
+	stateDB.Commit()
+	stateDB.Tag(string(blk.HeadHash()))
+	require.Nil(t, stateDB.Flush(string(blk.HeadHash())))
+
+	var buf bytes.Buffer
+	exported, err := exportSnapshot(chain, stateDB, &buf)
+	require.Nil(t, err)
+	assert.Equal(t, blk.HeadHash(), exported.HeadHash())
+	chain.Close()
+	stateDB.Close()
+
+	chain, stateDB = openSnapshotDB(t, filepath.Join(dir, "dst"))
+	// the snapshot is only imported with the trusted block hash
+	_, err = importSnapshot(chain, stateDB, bytes.NewReader(buf.Bytes()), nil)
+	assert.Equal(t, errNoTrustedHash, err)
+	_, err = importSnapshot(chain, stateDB, bytes.NewReader(buf.Bytes()), []byte("fake"))
+	assert.NotNil(t, err)
+	imported, err := importSnapshot(chain, stateDB, bytes.NewReader(buf.Bytes()), blk.HeadHash())
+	require.Nil(t, err)
+	assert.Equal(t, blk.HeadHash(), imported.HeadHash())
+	assert.Equal(t, string(blk.HeadHash()), stateDB.CurrentTag())
+	assert.Equal(t, "value", database.MustUnmarshal(database.NewVisitor(0, stateDB).Get("contract-key")))
+	top, err := chain.Top()
+	require.Nil(t, err)
+	assert.Equal(t, blk.HeadHash(), top.HeadHash())
+	referred, err := chain.GetTx(delaytx.Hash())
+	require.Nil(t, err)
+	assert.Equal(t, delaytx.Hash(), referred.Hash())
+
+	// the account tx index starts from the snapshot block
+	chain.EnableAccountTxIndex()
+	require.Nil(t, chain.RebuildAccountTxIndex(true))
+	next := &block.Block{
+		Head: &block.BlockHead{
+			ParentHash: blk.HeadHash(),
+			Number:     11,
+			Witness:    acc.ReadablePubkey(),
+		},
+	}
+	transfer := tx.NewTx([]*tx.Action{tx.NewAction("token.iost", "transfer", "[]")}, nil, 100000, 100, time.Now().Add(time.Hour).UnixNano(), 0, 0)
+	transfer.Publisher = "alice"
+	next.Txs = append(next.Txs, transfer)
+	next.Receipts = append(next.Receipts, tx.NewTxReceipt(transfer.Hash()))
+	require.Nil(t, next.CalculateHeadHash())
+	next.Sign = acc.Sign(next.HeadHash())
+	require.Nil(t, chain.Push(next))
+	require.Nil(t, chain.RebuildAccountTxIndex(false))
+	txs, err := chain.GetAccountTxs("alice", 0, 0, 10)
+	require.Nil(t, err)
+	require.Len(t, txs, 1)
+	assert.Equal(t, int64(11), txs[0].BlockNumber)
+
+	_, err = importSnapshot(chain, stateDB, bytes.NewReader(buf.Bytes()), blk.HeadHash())
+	assert.NotNil(t, err)
+	chain.Close()
+	stateDB.Close()
+
+	// the payload is modified and compressed again, which is detected by the checksum
+	gz, err := gzip.NewReader(bytes.NewReader(buf.Bytes()))
+	require.Nil(t, err)
+	payload, err := ioutil.ReadAll(gz)
+	require.Nil(t, err)
+	payload = bytes.Replace(payload, []byte("value"), []byte("VALUE"), 1)
+	var modified bytes.Buffer
+	w := gzip.NewWriter(&modified)
+	w.Write(payload)
+	w.Close()
+
+	chain, stateDB = openSnapshotDB(t, filepath.Join(dir, "modified"))
+	defer chain.Close()
+	defer stateDB.Close()
+	_, err = importSnapshot(chain, stateDB, bytes.NewReader(modified.Bytes()), blk.HeadHash())
+	assert.Equal(t, errSnapshotChecksum, err)
+}
@@ -0,0 +1,229 @@
+package iserver
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/db"
+	"github.com/iost-official/go-iost/db/kv"
+	"github.com/iost-official/go-iost/db/mvcc"
+	"github.com/iost-official/go-iost/ilog"
+)
+
+// verifyLogInterval is the number of blocks between the progress logs of verifying.
+const verifyLogInterval = 100000
+
+// VerifyDBOptions is the options of verifying the databases.
+type VerifyDBOptions struct {
+	Start       int64 // the number of the first block to verify
+	End         int64 // the number of the last block to verify, 0 means the top block
+	RepairIndex bool  // rewrite the broken tx and receipt indexes
+	Rollback    bool  // roll the blockchain and state back to the consistent height
+}
+
+// VerifyDBReport is the result of verifying the databases.
+type VerifyDBReport struct {
+	Length           int64    `json:"length"`            // the length of blockchain
+	Verified         int64    `json:"verified"`          // the number of blocks verified
+	Pruned           int64    `json:"pruned"`            // the number of verified blocks whose bodies are pruned
+	Repaired         int      `json:"repaired"`          // the number of index entries rewritten
+	BrokenIndexes    int      `json:"broken_indexes"`    // the number of broken index entries not rewritten
+	ConsistentLength int64    `json:"consistent_length"` // the blocks before it are consistent
+	StateTag         string   `json:"state_tag"`         // the tag of the flushed state
+	StateNumber      int64    `json:"state_number"`      // the block number of the state, -1 if not in blockchain
+	Problems         []string `json:"problems"`
+	Consistent       bool     `json:"consistent"` // whether the databases are consistent after the repair
+}
+
+func (r *VerifyDBReport) addProblem(format string, args ...interface{}) {
+	r.Problems = append(r.Problems, fmt.Sprintf(format, args...))
+}
+
+// verifyChain walks the blocks in the range of opts, and checks each block and its link to
+// the parent. The blocks missing before the first block, which are not imported from a
+// snapshot, are skipped.
+func verifyChain(chain block.Chain, opts *VerifyDBOptions) (*VerifyDBReport, error) {
+	report := &VerifyDBReport{
+		Length:           chain.Length(),
+		ConsistentLength: chain.Length(),
+		StateNumber:      -1,
+	}
+	end := opts.End
+	if end <= 0 || end >= report.Length {
+		end = report.Length - 1
+	}
+	var parent *block.Block
+	found := false
+	for number := opts.Start; number <= end; number++ {
+		r, err := chain.VerifyBlock(number, opts.RepairIndex)
+		if err != nil {
+			return nil, fmt.Errorf("fail to verify block %d, %v", number, err)
+		}
+		if r.Block == nil && !r.Pruned && !found {
+			continue
+		}
+		found = true
+		report.Verified++
+		if r.Pruned {
+			report.Pruned++
+		}
+		report.Repaired += r.Repaired
+		report.BrokenIndexes += r.BrokenIndexes - r.Repaired
+		report.Problems = append(report.Problems, r.Problems...)
+		broken := r.Broken
+		if r.Block != nil && parent != nil && parent.Head.Number == number-1 &&
+			!bytes.Equal(r.Block.Head.ParentHash, parent.HeadHash()) {
+			report.addProblem("parent hash of block %d mismatches the hash of block %d", number, number-1)
+			broken = true
+		}
+		if broken && number < report.ConsistentLength {
+			report.ConsistentLength = number
+		}
+		parent = r.Block
+		if (number+1)%verifyLogInterval == 0 {
+			ilog.Infof("verified blocks to %d", number)
+		}
+	}
+	return report, nil
+}
+
+// verifyState checks that the tag of the state is a block in the consistent part of blockchain.
+// The state behind the top block is fine, whose following blocks are replayed on start.
+func verifyState(chain block.Chain, stateDB db.MVCCDB, report *VerifyDBReport) bool {
+	report.StateTag = common.Base58Encode([]byte(stateDB.CurrentTag()))
+	if stateDB.CurrentTag() == "" {
+		if report.Length > 0 {
+			report.addProblem("state has no tag, but blockchain has %d blocks", report.Length)
+			return false
+		}
+		return true
+	}
+	hash := []byte(stateDB.CurrentTag())
+	blk, err := chain.GetBlockByHash(hash)
+	if perr, ok := err.(*block.PrunedError); ok {
+		report.StateNumber = perr.Number
+		report.addProblem("state is at block %d, whose body is pruned, so the following blocks can't be replayed", perr.Number)
+		return false
+	}
+	if err != nil {
+		report.addProblem("state tag %v isn't a block in blockchain", report.StateTag)
+		return false
+	}
+	report.StateNumber = blk.Head.Number
+	if h, err := chain.GetHashByNumber(blk.Head.Number); err != nil || !bytes.Equal(h, hash) {
+		report.addProblem("state is at block %v, which isn't indexed as block %d", report.StateTag, blk.Head.Number)
+		return false
+	}
+	if blk.Head.Number >= report.ConsistentLength {
+		report.addProblem("state is at block %d, but blockchain is consistent to block %d", blk.Head.Number, report.ConsistentLength-1)
+		return false
+	}
+	return true
+}
+
+// rollback truncates the blockchain to the consistent length, and rolls the state back to the
+// top block if the state is ahead of it, which requires the archive of state.
+func rollback(chain block.Chain, stateDB db.MVCCDB, report *VerifyDBReport) error {
+	top := report.ConsistentLength - 1
+	if top < 0 {
+		return fmt.Errorf("no consistent block to roll back to")
+	}
+	if report.StateNumber < 0 || report.StateNumber > top {
+		hash, err := chain.GetHashByNumber(top)
+		if err != nil {
+			return err
+		}
+		if err := stateDB.RollbackTo(string(hash), top); err != nil {
+			return fmt.Errorf("fail to roll state back to block %d, %v", top, err)
+		}
+		ilog.Infof("Rolled state back to block %d", top)
+		report.StateTag = common.Base58Encode(hash)
+		report.StateNumber = top
+	}
+	if report.ConsistentLength < chain.Length() {
+		if err := chain.Truncate(report.ConsistentLength); err != nil {
+			return fmt.Errorf("fail to truncate blockchain to block %d, %v", top, err)
+		}
+		ilog.Infof("Truncated blockchain to block %d", top)
+		report.Length = report.ConsistentLength
+	}
+	return nil
+}
+
+func verifyDB(chain block.Chain, stateDB db.MVCCDB, opts *VerifyDBOptions) (*VerifyDBReport, error) {
+	report, err := verifyChain(chain, opts)
+	if err != nil {
+		return nil, err
+	}
+	ok := verifyState(chain, stateDB, report) && report.ConsistentLength == report.Length
+	if !ok && opts.Rollback {
+		if err := rollback(chain, stateDB, report); err != nil {
+			return report, err
+		}
+		// the rollback can't fix the state at a pruned block or a block off the chain, so
+		// the databases are verified again
+		again, err := verifyChain(chain, &VerifyDBOptions{Start: opts.Start, End: opts.End})
+		if err != nil {
+			return report, err
+		}
+		if !verifyState(chain, stateDB, again) || again.ConsistentLength != again.Length {
+			report.Problems = append(report.Problems, again.Problems...)
+			return report, fmt.Errorf("the state can't be replayed after the rollback")
+		}
+		report.Length = again.Length
+		report.StateTag = again.StateTag
+		report.StateNumber = again.StateNumber
+		ok = true
+	}
+	report.Consistent = ok && report.BrokenIndexes == 0
+	return report, nil
+}
+
+// openDB opens the blockchain db and the state db of the config. Unlike global.New, the
+// pruning and the account tx index aren't enabled, which run in background.
+func openDB(conf *common.Config) (block.Chain, db.MVCCDB, error) {
+	storageType, err := kv.ParseStorageType(conf.DB.StorageType)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid storage type: %v", err)
+	}
+	cacheType, err := mvcc.ParseCacheType(conf.DB.CacheType)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid cache type: %v", err)
+	}
+	chain, err := block.NewBlockChainWithStorage(conf.DB.LdbPath+"BlockChainDB", storageType)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to open blockchain: %v", err)
+	}
+	stateDB, err := db.NewCacheMVCCDB(conf.DB.LdbPath+"StateDB", cacheType, storageType)
+	if err != nil {
+		chain.Close()
+		return nil, nil, fmt.Errorf("fail to open statedb: %v", err)
+	}
+	if conf.DB.Archive {
+		stateDB.EnableArchive()
+	}
+	if conf.DB.StateRoot {
+		if err := stateDB.EnableStateRoot(); err != nil {
+			stateDB.Close()
+			chain.Close()
+			return nil, nil, fmt.Errorf("fail to enable state root: %v", err)
+		}
+	}
+	return chain, stateDB, nil
+}
+
+// VerifyDB checks the blockchain db and the state db of the config, and optionally repairs
+// the indexes or rolls the databases back to the consistent height. The server must not be
+// running on the databases.
+func VerifyDB(conf *common.Config, opts *VerifyDBOptions) (*VerifyDBReport, error) {
+	chain, stateDB, err := openDB(conf)
+	if err != nil {
+		return nil, err
+	}
+	defer stateDB.Close()
+	defer chain.Close()
+
+	return verifyDB(chain, stateDB, opts)
+}
@@ -0,0 +1,109 @@
+package iserver
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/iost-official/go-iost/vm/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newVerifyTestBlock(number int64, parent []byte) *block.Block {
+	blk := &block.Block{
+		Head: &block.BlockHead{
+			ParentHash: parent,
+			Info:       []byte{},
+			Number:     number,
+			Witness:    "witness",
+		},
+		Sign:     &crypto.Signature{},
+		Txs:      []*tx.Tx{},
+		Receipts: []*tx.TxReceipt{},
+	}
+	blk.Head.TxMerkleHash = blk.CalculateTxMerkleHash()
+	blk.Head.TxReceiptMerkleHash = blk.CalculateTxReceiptMerkleHash()
+	blk.CalculateHeadHash()
+	return blk
+}
+
+func TestVerifyDB(t *testing.T) {
+	dir, err := ioutil.TempDir("", "verifydb")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	chain, stateDB := openSnapshotDB(t, filepath.Join(dir, "db"))
+	defer chain.Close()
+	defer stateDB.Close()
+	stateDB.EnableArchive()
+	var blks []*block.Block
+	parent := []byte("parent")
+	for i := int64(0); i < 5; i++ {
+		blk := newVerifyTestBlock(i, parent)
+		require.Nil(t, chain.Push(blk))
+		vi := database.NewVisitor(0, stateDB)
+		vi.Put("number", database.MustMarshal(blk.Head.Number))
+		vi.Commit()
+		stateDB.Commit()
+		stateDB.Tag(string(blk.HeadHash()))
+		require.Nil(t, stateDB.FlushBlock(string(blk.HeadHash()), blk.Head.Number))
+		blks = append(blks, blk)
+		parent = blk.HeadHash()
+	}
+
+	report, err := verifyDB(chain, stateDB, &VerifyDBOptions{})
+	require.Nil(t, err)
+	assert.True(t, report.Consistent)
+	assert.Equal(t, int64(5), report.Verified)
+	assert.Equal(t, int64(4), report.StateNumber)
+	assert.Empty(t, report.Problems)
+
+	// the tail of blockchain is lost while the state has been flushed
+	require.Nil(t, chain.Truncate(3))
+	report, err = verifyDB(chain, stateDB, &VerifyDBOptions{})
+	require.Nil(t, err)
+	assert.False(t, report.Consistent)
+	assert.Equal(t, int64(-1), report.StateNumber)
+	assert.Len(t, report.Problems, 1)
+
+	report, err = verifyDB(chain, stateDB, &VerifyDBOptions{Rollback: true})
+	require.Nil(t, err)
+	assert.True(t, report.Consistent)
+	assert.Equal(t, int64(2), report.StateNumber)
+	assert.Equal(t, string(blks[2].HeadHash()), stateDB.CurrentTag())
+	assert.Equal(t, int64(2), database.MustUnmarshal(database.NewVisitor(0, stateDB).Get("number")))
+
+	// the block not linked to its parent is rolled back
+	require.Nil(t, chain.Push(newVerifyTestBlock(3, []byte("fork"))))
+	report, err = verifyDB(chain, stateDB, &VerifyDBOptions{})
+	require.Nil(t, err)
+	assert.False(t, report.Consistent)
+	assert.Equal(t, int64(3), report.ConsistentLength)
+	report, err = verifyDB(chain, stateDB, &VerifyDBOptions{Start: 1, End: 2})
+	require.Nil(t, err)
+	assert.Equal(t, int64(2), report.Verified)
+	assert.Equal(t, int64(4), report.ConsistentLength)
+	report, err = verifyDB(chain, stateDB, &VerifyDBOptions{Rollback: true})
+	require.Nil(t, err)
+	assert.True(t, report.Consistent)
+	assert.Equal(t, int64(3), chain.Length())
+
+	// the state at a block off the chain can't be rolled back
+	fork := newVerifyTestBlock(2, blks[1].HeadHash())
+	fork.Head.Witness = "fork"
+	fork.CalculateHeadHash()
+	require.Nil(t, chain.Push(fork))
+	require.Nil(t, chain.Push(blks[2]))
+	stateDB.Commit()
+	stateDB.Tag(string(fork.HeadHash()))
+	require.Nil(t, stateDB.FlushBlock(string(fork.HeadHash()), fork.Head.Number))
+	report, err = verifyDB(chain, stateDB, &VerifyDBOptions{Rollback: true})
+	assert.NotNil(t, err)
+	assert.False(t, report.Consistent)
+	assert.Equal(t, string(fork.HeadHash()), stateDB.CurrentTag())
+}
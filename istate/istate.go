@@ -0,0 +1,397 @@
+// Package istate inspects the state database of an iserver offline.
+package istate
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/db"
+	"github.com/iost-official/go-iost/db/kv"
+	"github.com/iost-official/go-iost/vm/database"
+)
+
+const (
+	contractOwnerKey = "system.iost-contract_owner"
+	tokenBalanceKey  = database.TokenContractName + "-TB"
+	usedRAMKey       = database.RAMContractName + "-UR"
+)
+
+// State is a read only view of a state database.
+type State struct {
+	db db.MVCCDB
+	vi *database.Visitor
+}
+
+// Open opens the state database in path. The database must not be opened by a running server.
+func Open(path string, storageType kv.StorageType) (*State, error) {
+	stateDB, err := db.NewMVCCDBWithStorage(path, storageType)
+	if err != nil {
+		return nil, fmt.Errorf("fail to open state db %v, %v", path, err)
+	}
+	return &State{
+		db: stateDB,
+		vi: database.NewVisitor(0, stateDB),
+	}, nil
+}
+
+// Close closes the state database.
+func (s *State) Close() {
+	s.db.Close()
+}
+
+// Tag returns the base58 encoded hash of the block which the state is at.
+func (s *State) Tag() string {
+	return common.Base58Encode([]byte(s.db.CurrentTag()))
+}
+
+// keys returns the visitor keys prefixed with prefix.
+func (s *State) keys(prefix string) ([]string, error) {
+	return s.db.Keys(database.StateTable, prefix)
+}
+
+// Value is a decoded value in the state.
+type Value struct {
+	Value interface{} `json:"value"`
+	Payer string      `json:"payer,omitempty"`
+}
+
+func (v *Value) String() string {
+	if v == nil {
+		return ""
+	}
+	b, err := json.Marshal(v.Value)
+	if err != nil {
+		return fmt.Sprint(v.Value)
+	}
+	return string(b)
+}
+
+// decode decodes the serialized value, the value which can't be decoded is kept as a string.
+func decode(raw string) *Value {
+	if strings.HasPrefix(raw, database.MapHolderPrefix) {
+		return &Value{Value: strings.Split(raw, database.ApplicationSeparator)[1:]}
+	}
+	v, payer := database.UnmarshalWithExtra(raw)
+	switch t := v.(type) {
+	case database.SerializedJSON:
+		if json.Valid(t) {
+			v = json.RawMessage(t)
+		} else {
+			v = string(t)
+		}
+	case *common.Fixed:
+		v = t.ToString()
+	case error:
+		return &Value{Value: raw}
+	}
+	return &Value{Value: v, Payer: payer}
+}
+
+// ContractInfo is the summary of a contract.
+type ContractInfo struct {
+	ID       string `json:"id"`
+	Owner    string `json:"owner"`
+	Lang     string `json:"lang"`
+	Version  string `json:"version"`
+	ABICount int    `json:"abi_count"`
+	CodeSize int    `json:"code_size"`
+}
+
+// ContractList is a list of contracts.
+type ContractList []*ContractInfo
+
+// Contracts returns all the contracts in the state.
+func (s *State) Contracts() (ContractList, error) {
+	keys, err := s.keys(database.ContractPrefix)
+	if err != nil {
+		return nil, err
+	}
+	contracts := make(ContractList, 0, len(keys))
+	for _, k := range keys {
+		id := strings.TrimPrefix(k, database.ContractPrefix)
+		c := s.vi.Contract(id)
+		if c == nil {
+			return nil, fmt.Errorf("contract %v is corrupted", id)
+		}
+		info := &ContractInfo{
+			ID:       id,
+			CodeSize: len(c.Code),
+		}
+		if owner, ok := database.Unmarshal(s.vi.MGet(contractOwnerKey, id)).(string); ok {
+			info.Owner = owner
+		}
+		if c.Info != nil {
+			info.Lang = c.Info.Lang
+			info.Version = c.Info.Version
+			info.ABICount = len(c.Info.Abi)
+		}
+		contracts = append(contracts, info)
+	}
+	return contracts, nil
+}
+
+// StorageEntry is a value in the storage of a contract.
+type StorageEntry struct {
+	Key string `json:"key"`
+	*Value
+}
+
+// StorageMap is a map in the storage of a contract.
+type StorageMap struct {
+	Key    string          `json:"key"`
+	Fields []*StorageEntry `json:"fields"`
+}
+
+// ContractStorage is the storage of a contract.
+type ContractStorage struct {
+	ID     string          `json:"id"`
+	Values []*StorageEntry `json:"values"`
+	Maps   []*StorageMap   `json:"maps"`
+}
+
+// Storage returns the values and maps stored by the contract.
+func (s *State) Storage(id string) (*ContractStorage, error) {
+	if !s.vi.HasContract(id) {
+		return nil, fmt.Errorf("contract %v not found", id)
+	}
+	storage := &ContractStorage{
+		ID:     id,
+		Values: make([]*StorageEntry, 0),
+		Maps:   make([]*StorageMap, 0),
+	}
+	prefix := id + database.Separator
+	keys, err := s.keys(database.BasicPrefix + prefix)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range keys {
+		key := strings.TrimPrefix(k, database.BasicPrefix)
+		storage.Values = append(storage.Values, &StorageEntry{
+			Key:   strings.TrimPrefix(key, prefix),
+			Value: decode(s.vi.Get(key)),
+		})
+	}
+
+	keys, err = s.keys(database.MapPrefix + prefix)
+	if err != nil {
+		return nil, err
+	}
+	// the header of a map lists its fields, which is truncated for the large maps, so the
+	// fields are also collected by the keys following the header
+	maps := make(map[string]*StorageMap)
+	seen := make(map[string]bool)
+	var headers []string
+	for _, k := range keys {
+		key := strings.TrimPrefix(k, database.MapPrefix)
+		raw, err := s.db.Get(database.StateTable, k)
+		if err != nil {
+			return nil, err
+		}
+		if !strings.HasPrefix(raw, database.MapHolderPrefix) {
+			continue
+		}
+		m := &StorageMap{Key: strings.TrimPrefix(key, prefix), Fields: make([]*StorageEntry, 0)}
+		maps[key] = m
+		headers = append(headers, key)
+		storage.Maps = append(storage.Maps, m)
+		for _, field := range s.vi.MKeys(key) {
+			seen[key+database.Separator+field] = true
+			m.Fields = append(m.Fields, &StorageEntry{Key: field, Value: decode(s.vi.MGet(key, field))})
+		}
+	}
+	// the longest header is the owner of a field, as map keys may contain the separator
+	sort.Slice(headers, func(i, j int) bool { return len(headers[i]) > len(headers[j]) })
+	for _, k := range keys {
+		key := strings.TrimPrefix(k, database.MapPrefix)
+		if _, ok := maps[key]; ok || seen[key] {
+			continue
+		}
+		for _, h := range headers {
+			if strings.HasPrefix(key, h+database.Separator) {
+				field := strings.TrimPrefix(key, h+database.Separator)
+				maps[h].Fields = append(maps[h].Fields, &StorageEntry{Key: field, Value: decode(s.vi.MGet(h, field))})
+				break
+			}
+		}
+	}
+	for _, m := range storage.Maps {
+		sort.Slice(m.Fields, func(i, j int) bool { return m.Fields[i].Key < m.Fields[j].Key })
+	}
+	return storage, nil
+}
+
+// TokenBalance is the balance of a token held by an account.
+type TokenBalance struct {
+	Account string `json:"account,omitempty"`
+	Token   string `json:"token,omitempty"`
+	Balance string `json:"balance"`
+	Frozen  string `json:"frozen"`
+}
+
+// AccountInfo is the tokens and RAM of an account.
+type AccountInfo struct {
+	Name   string                   `json:"name"`
+	Tokens []*TokenBalance          `json:"tokens"`
+	RAM    *database.AccountRAMInfo `json:"ram"`
+}
+
+// Account returns the balances of all the tokens held by the account and its RAM usage.
+func (s *State) Account(name string) (*AccountInfo, error) {
+	prefix := database.MapPrefix + tokenBalanceKey + name + database.Separator
+	keys, err := s.keys(prefix)
+	if err != nil {
+		return nil, err
+	}
+	info := &AccountInfo{
+		Name:   name,
+		Tokens: make([]*TokenBalance, 0, len(keys)),
+		RAM:    s.vi.GetAccountRAMInfo(name),
+	}
+	for _, k := range keys {
+		token := strings.TrimPrefix(k, prefix)
+		b := s.tokenBalance(token, name)
+		b.Token = token
+		info.Tokens = append(info.Tokens, b)
+	}
+	return info, nil
+}
+
+func (s *State) tokenBalance(token, acc string) *TokenBalance {
+	return &TokenBalance{
+		Balance: s.vi.TokenBalanceFixed(token, acc).ToString(),
+		Frozen:  s.vi.FreezedTokenBalanceFixed(token, acc).ToString(),
+	}
+}
+
+// BalanceList is a list of token balances.
+type BalanceList []*TokenBalance
+
+// Balances returns the balances of all the holders of the token.
+func (s *State) Balances(token string) (BalanceList, error) {
+	prefix := database.MapPrefix + tokenBalanceKey
+	suffix := database.Separator + token
+	keys, err := s.keys(prefix)
+	if err != nil {
+		return nil, err
+	}
+	balances := make(BalanceList, 0)
+	for _, k := range keys {
+		// the header of the balance map of an account has no suffix
+		if !strings.HasSuffix(k, suffix) {
+			continue
+		}
+		acc := strings.TrimSuffix(strings.TrimPrefix(k, prefix), suffix)
+		if strings.Contains(acc, database.Separator) {
+			continue
+		}
+		b := s.tokenBalance(token, acc)
+		b.Account = acc
+		balances = append(balances, b)
+	}
+	return balances, nil
+}
+
+// AccountRAM is the RAM usage of an account.
+type AccountRAM struct {
+	Account string `json:"account"`
+	*database.AccountRAMInfo
+}
+
+// RAMList is a list of RAM usages.
+type RAMList []*AccountRAM
+
+// RAM returns the RAM usage of all the accounts, ordered by the used RAM descending.
+func (s *State) RAM() (RAMList, error) {
+	prefix := database.BasicPrefix + usedRAMKey
+	keys, err := s.keys(prefix)
+	if err != nil {
+		return nil, err
+	}
+	usages := make(RAMList, 0, len(keys))
+	for _, k := range keys {
+		acc := strings.TrimPrefix(k, prefix)
+		usages = append(usages, &AccountRAM{Account: acc, AccountRAMInfo: s.vi.GetAccountRAMInfo(acc)})
+	}
+	sort.SliceStable(usages, func(i, j int) bool { return usages[i].Used > usages[j].Used })
+	return usages, nil
+}
+
+// DiffEntry is a key whose values differ in two states, the missing value is nil.
+type DiffEntry struct {
+	Key string `json:"key"`
+	A   *Value `json:"a"`
+	B   *Value `json:"b"`
+}
+
+// Diff is the difference of two states.
+type Diff struct {
+	TagA    string       `json:"tag_a"`
+	TagB    string       `json:"tag_b"`
+	Entries []*DiffEntry `json:"entries"`
+}
+
+// Diff compares all the keys and values of the two states.
+func (s *State) Diff(other *State) (*Diff, error) {
+	keysA, err := s.keys("")
+	if err != nil {
+		return nil, err
+	}
+	keysB, err := other.keys("")
+	if err != nil {
+		return nil, err
+	}
+	diff := &Diff{
+		TagA:    s.Tag(),
+		TagB:    other.Tag(),
+		Entries: make([]*DiffEntry, 0),
+	}
+	i, j := 0, 0
+	for i < len(keysA) || j < len(keysB) {
+		var key string
+		switch {
+		case j >= len(keysB) || (i < len(keysA) && keysA[i] < keysB[j]):
+			key = keysA[i]
+			i++
+		case i >= len(keysA) || keysB[j] < keysA[i]:
+			key = keysB[j]
+			j++
+		default:
+			key = keysA[i]
+			i++
+			j++
+		}
+		a, err := s.db.Get(database.StateTable, key)
+		if err != nil {
+			return nil, err
+		}
+		b, err := other.db.Get(database.StateTable, key)
+		if err != nil {
+			return nil, err
+		}
+		if a == b {
+			continue
+		}
+		entry := &DiffEntry{Key: key}
+		if a != "" {
+			entry.A = s.decodeKey(key, a)
+		}
+		if b != "" {
+			entry.B = other.decodeKey(key, b)
+		}
+		diff.Entries = append(diff.Entries, entry)
+	}
+	return diff, nil
+}
+
+// decodeKey decodes the value of the key, the contracts are decoded by the contract handler.
+func (s *State) decodeKey(key, raw string) *Value {
+	if strings.HasPrefix(key, database.ContractPrefix) {
+		if c := s.vi.Contract(strings.TrimPrefix(key, database.ContractPrefix)); c != nil {
+			return &Value{Value: c}
+		}
+	}
+	return decode(raw)
+}
@@ -0,0 +1,90 @@
+package istate
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/iost-official/go-iost/core/contract"
+	"github.com/iost-official/go-iost/db/kv"
+	"github.com/iost-official/go-iost/vm/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestState(t *testing.T, path string, balance int64) *State {
+	s, err := Open(path, kv.LevelDBStorage)
+	require.Nil(t, err)
+	vi := s.vi
+	vi.SetContract(&contract.Contract{ID: "Contractabc", Info: &contract.Info{Lang: "javascript", Version: "1.0.0"}, Code: "code"})
+	vi.MPut(contractOwnerKey, "Contractabc", database.MustMarshal("alice", "alice"))
+	vi.Put("Contractabc-count", database.MustMarshal(int64(3), "alice"))
+	vi.MPut("Contractabc-users", "alice", database.MustMarshal(database.SerializedJSON(`{"age":1}`), "alice"))
+	vi.MPut("Contractabc-users", "bob", database.MustMarshal("b", "bob"))
+	vi.MPut("token.iost-TIiost", "decimal", database.MustMarshal(int64(8)))
+	vi.SetTokenBalance("iost", "alice", balance)
+	vi.SetTokenBalance("iost", "bob", 100000000)
+	vi.Put("ram.iost-URalice", database.MustMarshal("100"))
+	vi.Put("ram.iost-TRalice", database.MustMarshal("1000"))
+	vi.Put("ram.iost-URbob", database.MustMarshal("200"))
+	vi.Commit()
+	return s
+}
+
+func TestState(t *testing.T) {
+	dir, err := ioutil.TempDir("", "istate")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	s := newTestState(t, filepath.Join(dir, "a"), 250000000)
+	defer s.Close()
+
+	contracts, err := s.Contracts()
+	require.Nil(t, err)
+	assert.Equal(t, ContractList{{ID: "Contractabc", Owner: "alice", Lang: "javascript", Version: "1.0.0", CodeSize: 4}}, contracts)
+
+	storage, err := s.Storage("Contractabc")
+	require.Nil(t, err)
+	assert.Equal(t, []*StorageEntry{{Key: "count", Value: &Value{Value: int64(3), Payer: "alice"}}}, storage.Values)
+	require.Len(t, storage.Maps, 1)
+	assert.Equal(t, "users", storage.Maps[0].Key)
+	require.Len(t, storage.Maps[0].Fields, 2)
+	assert.Equal(t, "bob", storage.Maps[0].Fields[1].Key)
+	assert.Equal(t, `{"age":1}`, storage.Maps[0].Fields[0].Value.String())
+	_, err = s.Storage("Contractnone")
+	assert.NotNil(t, err)
+
+	account, err := s.Account("alice")
+	require.Nil(t, err)
+	assert.Equal(t, []*TokenBalance{{Token: "iost", Balance: "2.5", Frozen: "0"}}, account.Tokens)
+	assert.Equal(t, &database.AccountRAMInfo{Used: 100, Total: 1000}, account.RAM)
+
+	balances, err := s.Balances("iost")
+	require.Nil(t, err)
+	assert.Equal(t, BalanceList{
+		{Account: "alice", Balance: "2.5", Frozen: "0"},
+		{Account: "bob", Balance: "1", Frozen: "0"},
+	}, balances)
+
+	ram, err := s.RAM()
+	require.Nil(t, err)
+	require.Len(t, ram, 2)
+	assert.Equal(t, "bob", ram[0].Account)
+
+	other := newTestState(t, filepath.Join(dir, "b"), 300000000)
+	defer other.Close()
+	diff, err := s.Diff(other)
+	require.Nil(t, err)
+	assert.Equal(t, []*DiffEntry{{
+		Key: "m-token.iost-TBalice-iost",
+		A:   &Value{Value: int64(250000000)},
+		B:   &Value{Value: int64(300000000)},
+	}}, diff.Entries)
+
+	var buf bytes.Buffer
+	assert.Nil(t, Write(&buf, TableFormat, balances))
+	assert.Equal(t, "ACCOUNT  BALANCE  FROZEN\nalice    2.5      0\nbob      1        0\n", buf.String())
+	assert.NotNil(t, Write(&buf, "xml", balances))
+}
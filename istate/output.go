@@ -0,0 +1,117 @@
+package istate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// Output formats
+const (
+	JSONFormat  = "json"
+	TableFormat = "table"
+)
+
+// Table is the rows of a result printed as a table.
+type Table struct {
+	Header []string
+	Rows   [][]string
+}
+
+// Tabler is a result which can be printed as a table.
+type Tabler interface {
+	Table() *Table
+}
+
+// Write writes the result to w in the format.
+func Write(w io.Writer, format string, v Tabler) error {
+	switch format {
+	case JSONFormat:
+		b, err := json.MarshalIndent(v, "", "    ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(b))
+		return err
+	case TableFormat:
+		t := v.Table()
+		tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, strings.Join(t.Header, "\t"))
+		for _, row := range t.Rows {
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+		return tw.Flush()
+	}
+	return fmt.Errorf("unknown format %v", format)
+}
+
+func itoa(i int64) string {
+	return strconv.FormatInt(i, 10)
+}
+
+// Table implements Tabler.
+func (l ContractList) Table() *Table {
+	t := &Table{Header: []string{"ID", "OWNER", "LANG", "VERSION", "ABIS", "CODE SIZE"}}
+	for _, c := range l {
+		t.Rows = append(t.Rows, []string{c.ID, c.Owner, c.Lang, c.Version, strconv.Itoa(c.ABICount), strconv.Itoa(c.CodeSize)})
+	}
+	return t
+}
+
+// Table implements Tabler.
+func (c *ContractStorage) Table() *Table {
+	t := &Table{Header: []string{"KEY", "FIELD", "VALUE", "PAYER"}}
+	for _, e := range c.Values {
+		t.Rows = append(t.Rows, []string{e.Key, "", e.Value.String(), e.Payer})
+	}
+	for _, m := range c.Maps {
+		for _, e := range m.Fields {
+			t.Rows = append(t.Rows, []string{m.Key, e.Key, e.Value.String(), e.Payer})
+		}
+	}
+	return t
+}
+
+// Table implements Tabler.
+func (a *AccountInfo) Table() *Table {
+	t := &Table{Header: []string{"ITEM", "BALANCE", "FROZEN"}}
+	for _, b := range a.Tokens {
+		t.Rows = append(t.Rows, []string{b.Token, b.Balance, b.Frozen})
+	}
+	t.Rows = append(t.Rows,
+		[]string{"ram used", itoa(a.RAM.Used), ""},
+		[]string{"ram available", itoa(a.RAM.Available), ""},
+		[]string{"ram total", itoa(a.RAM.Total), ""},
+	)
+	return t
+}
+
+// Table implements Tabler.
+func (l BalanceList) Table() *Table {
+	t := &Table{Header: []string{"ACCOUNT", "BALANCE", "FROZEN"}}
+	for _, b := range l {
+		t.Rows = append(t.Rows, []string{b.Account, b.Balance, b.Frozen})
+	}
+	return t
+}
+
+// Table implements Tabler.
+func (l RAMList) Table() *Table {
+	t := &Table{Header: []string{"ACCOUNT", "USED", "AVAILABLE", "TOTAL"}}
+	for _, r := range l {
+		t.Rows = append(t.Rows, []string{r.Account, itoa(r.Used), itoa(r.Available), itoa(r.Total)})
+	}
+	return t
+}
+
+// Table implements Tabler.
+func (d *Diff) Table() *Table {
+	t := &Table{Header: []string{"KEY", "A " + d.TagA, "B " + d.TagB}}
+	for _, e := range d.Entries {
+		t.Rows = append(t.Rows, []string{e.Key, e.A.String(), e.B.String()})
+	}
+	return t
+}
@@ -9,10 +9,12 @@ import (
 	"sync"
 	"time"
 
+	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/core/tx"
 	"github.com/iost-official/go-iost/ilog"
 	"github.com/iost-official/go-iost/rpc/pb"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 // Constant of Client
@@ -31,11 +33,23 @@ type Client struct {
 	o    sync.Once
 	Name string
 	Addr string
+	// TLS is used if CA is set, Cert and Key are the client certificate for mutual TLS
+	CA   string
+	Cert string
+	Key  string
 }
 
 func (c *Client) getGRPC() (rpcpb.ApiServiceClient, error) {
 	c.o.Do(func() {
-		conn, err := grpc.Dial(c.Addr, grpc.WithInsecure())
+		opt := grpc.WithInsecure()
+		if c.CA != "" {
+			conf, err := common.NewClientTLSConfig(c.CA, c.Cert, c.Key)
+			if err != nil {
+				panic(err)
+			}
+			opt = grpc.WithTransportCredentials(credentials.NewTLS(conf))
+		}
+		conn, err := grpc.Dial(c.Addr, opt)
 		if err != nil {
 			panic(err)
 		}
@@ -24,6 +24,7 @@ var AccountCaseAction = func(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	setTLS(c, it)
 
 	accounts, err := it.CreateAccountN(anum)
 	if err != nil {
@@ -53,6 +53,7 @@ var BenchmarkAction = func(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	setTLS(c, it)
 
 	txType := None
 	cid := ""
@@ -34,6 +34,7 @@ var CommonVoteCaseAction = func(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	setTLS(c, it)
 	client := it.GetClients()[0]
 	accounts, err := itest.LoadAccounts(afile)
 	if err != nil {
@@ -53,6 +53,7 @@ var ContractCaseAction = func(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	setTLS(c, it)
 
 	contract, err := itest.LoadContract(codefile, abifile)
 	if err != nil {
@@ -1,6 +1,7 @@
 package run
 
 import (
+	"github.com/iost-official/go-iost/itest"
 	"github.com/urfave/cli"
 )
 
@@ -51,4 +52,24 @@ var Flags = []cli.Flag{
 		Value: 100,
 		Usage: "The number of accounts to generated if no given account file",
 	},
+	cli.StringFlag{
+		Name:  "tls_ca",
+		Value: "",
+		Usage: "Connect to iserver with TLS, the server certificate is verified by the CA `FILE`",
+	},
+	cli.StringFlag{
+		Name:  "tls_cert",
+		Value: "",
+		Usage: "Load the client certificate for mutual TLS from `FILE`",
+	},
+	cli.StringFlag{
+		Name:  "tls_key",
+		Value: "",
+		Usage: "Load the client key for mutual TLS from `FILE`",
+	},
+}
+
+// setTLS applies the tls flags to the clients of itest
+func setTLS(c *cli.Context, it *itest.ITest) {
+	it.SetTLS(c.GlobalString("tls_ca"), c.GlobalString("tls_cert"), c.GlobalString("tls_key"))
 }
@@ -46,6 +46,7 @@ var TransferCaseAction = func(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	setTLS(c, it)
 
 	accounts, err := itest.LoadAccounts(afile)
 	if err != nil {
@@ -51,6 +51,7 @@ var VoteCaseAction = func(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	setTLS(c, it)
 
 	accounts, err := itest.LoadAccounts(afile)
 	if err != nil {
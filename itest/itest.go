@@ -51,6 +51,18 @@ func (t *ITest) GetClients() []*Client {
 	return t.clients
 }
 
+// SetTLS sets the tls files of the clients whose CA is not set in config
+func (t *ITest) SetTLS(ca, cert, key string) {
+	if ca == "" {
+		return
+	}
+	for _, c := range t.clients {
+		if c.CA == "" {
+			c.CA, c.Cert, c.Key = ca, cert, key
+		}
+	}
+}
+
 // Load will load the itest from file
 func Load(keysfile, configfile string) (*ITest, error) {
 	ilog.Infof("Load itest from file...")
@@ -0,0 +1,50 @@
+// Copyright © 2018 NAME HERE <EMAIL ADDRESS>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iwallet
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var historyLimit int64
+var historyCursor string
+
+// historyCmd represents the history command
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "find transactions of an account",
+	Long:  `find transactions related to an account, the account tx index should be enabled on the node`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		txs, err := sdk.getTxsByAccount(args[0], historyLimit, historyCursor)
+		if err != nil {
+			fmt.Println(err.Error())
+			return
+		}
+		fmt.Println(marshalTextString(txs))
+		if txs.Cursor != "" {
+			fmt.Printf("more transactions: iwallet history %v --cursor %v\n", args[0], txs.Cursor)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(historyCmd)
+	historyCmd.Flags().Int64VarP(&historyLimit, "limit", "", 50, "max number of transactions to print")
+	historyCmd.Flags().StringVarP(&historyCursor, "cursor", "", "", "continue from the cursor printed by the previous query")
+}
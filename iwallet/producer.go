@@ -0,0 +1,74 @@
+package iwallet
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	rpcpb "github.com/iost-official/go-iost/rpc/pb"
+	"github.com/spf13/cobra"
+)
+
+var producerRaw bool
+
+// producerCmd prints the producer schedule
+var producerCmd = &cobra.Command{
+	Use:   "producer",
+	Short: "Show the producer schedule and why the last irreversible block advances or not",
+	Long: `Show the witnesses producing in the following slots, the confirming state of the witnesses,
+and the blocks waiting for confirmations to become irreversible`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		s, err := sdk.getProducerSchedule()
+		if err != nil {
+			fmt.Println(err.Error())
+			return
+		}
+		if producerRaw {
+			fmt.Println(marshalTextString(s))
+			return nil
+		}
+		printProducerSchedule(s)
+		return nil
+	},
+}
+
+func printProducerSchedule(s *rpcpb.ProducerScheduleResponse) {
+	fmt.Printf("head block: %v, last irreversible block: %v, confirmations needed: %v\n", s.HeadBlock, s.LibBlock, s.ConfirmationsNeeded)
+	if len(s.PendingWitnesses) > 0 {
+		fmt.Printf("pending witnesses after block %v: %v\n", s.PendingNumber, s.PendingWitnesses)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "\nSLOT\tTIME\tWITNESS")
+	now := time.Now()
+	for i, slot := range s.Slots {
+		t := time.Unix(0, slot.Time)
+		when := fmt.Sprintf("in %v", t.Sub(now).Round(time.Second))
+		if i == 0 {
+			when = "current"
+		}
+		fmt.Fprintf(w, "%v\t%v (%v)\t%v\n", slot.Slot, t.Format("15:04:05"), when, slot.Witness)
+	}
+
+	fmt.Fprintln(w, "\nWITNESS\tWATERMARK\tLAST BLOCK\tCONFIRM UNTIL")
+	for _, wit := range s.Witnesses {
+		last, until := "-", "-"
+		if wit.LastBlock >= 0 {
+			last, until = fmt.Sprint(wit.LastBlock), fmt.Sprint(wit.ConfirmUntil)
+		}
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\n", wit.Witness, wit.Watermark, last, until)
+	}
+
+	fmt.Fprintln(w, "\nBLOCK\tHASH\tWITNESS\tCONFIRM UNTIL\tCONFIRMATIONS")
+	for _, b := range s.PendingBlocks {
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v/%v\n", b.Number, b.Hash, b.Witness, b.ConfirmUntil, b.Confirmations, s.ConfirmationsNeeded)
+	}
+	w.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(producerCmd)
+	producerCmd.Flags().BoolVarP(&producerRaw, "raw", "", false, "print the raw response")
+}
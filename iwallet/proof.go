@@ -0,0 +1,131 @@
+// Copyright © 2018 NAME HERE <EMAIL ADDRESS>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iwallet
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/merkletree"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/iost-official/go-iost/rpc/pb"
+	"github.com/spf13/cobra"
+)
+
+var proofReceipt bool
+var proofFile string
+var proofBlockHash string
+
+// verifyProofCmd represents the verify-proof command
+var verifyProofCmd = &cobra.Command{
+	Use:   "verify-proof",
+	Short: "verify the merkle proof of a transaction",
+	Long: `verify that a transaction or its receipt is included in a block signed by the block witness
+	the proof is fetched from the server, or read from a json file returned by the getTxProof/getReceiptProof api
+	example:iwallet verify-proof 5bBU6ZkUYgvLnbqa8SbTXKSwVqCjkbxzNRLGTwRvRqm8 --receipt --block_hash 8FtNn8C1Y6rsNZR3hzuSGN3o1Kz8MJRwTmYAHHrEFmUE
+	`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		var proof *rpcpb.MerkleProofResponse
+		if proofFile != "" {
+			data, err := readFile(proofFile)
+			if err != nil {
+				return fmt.Errorf("read proof file failed: %v", err)
+			}
+			proof = &rpcpb.MerkleProofResponse{}
+			if err := jsonpb.UnmarshalString(string(data), proof); err != nil {
+				return fmt.Errorf("invalid proof file: %v", err)
+			}
+		} else {
+			proof, err = sdk.getTxProof(args[0], proofReceipt)
+			if err != nil {
+				return err
+			}
+		}
+		head, err := verifyProof(args[0], proof, proofReceipt)
+		if err != nil {
+			return fmt.Errorf("verify proof failed: %v", err)
+		}
+		hash, _ := head.Hash()
+		if proofBlockHash != "" && proofBlockHash != common.Base58Encode(hash) {
+			return fmt.Errorf("verify proof failed: block hash mismatch, expected %v, got %v", proofBlockHash, common.Base58Encode(hash))
+		}
+		fmt.Printf("proof is valid. block number: %v, block hash: %v, witness: %v\n", head.Number, common.Base58Encode(hash), head.Witness)
+		if proofBlockHash == "" {
+			fmt.Println("the block hash isn't checked, set --block_hash to the hash you trust")
+		}
+		return nil
+	},
+}
+
+// verifyProof checks the merkle proof of the tx, and returns the block head signed by the witness.
+func verifyProof(txHash string, proof *rpcpb.MerkleProofResponse, receipt bool) (*block.BlockHead, error) {
+	head := &block.BlockHead{}
+	if err := head.Decode(proof.BlockHead); err != nil {
+		return nil, fmt.Errorf("invalid block head: %v", err)
+	}
+	hash, err := head.Hash()
+	if err != nil {
+		return nil, err
+	}
+	if proof.Signature == nil {
+		return nil, fmt.Errorf("block signature not given")
+	}
+	sig := &crypto.Signature{
+		Algorithm: crypto.Algorithm(proof.Signature.Algorithm),
+		Sig:       proof.Signature.Signature,
+	}
+	sig.SetPubkey(account.DecodePubkey(head.Witness))
+	if !sig.Verify(hash) {
+		return nil, fmt.Errorf("invalid block signature of witness %v", head.Witness)
+	}
+
+	var leaf, root []byte
+	if receipt {
+		r := &tx.TxReceipt{}
+		if err := r.Decode(proof.Data); err != nil {
+			return nil, fmt.Errorf("invalid receipt: %v", err)
+		}
+		if !bytes.Equal(r.TxHash, common.Base58Decode(txHash)) {
+			return nil, fmt.Errorf("receipt of tx %v is given", common.Base58Encode(r.TxHash))
+		}
+		leaf, root = r.Hash(), head.TxReceiptMerkleHash
+	} else {
+		t := &tx.Tx{}
+		if err := t.Decode(proof.Data); err != nil {
+			return nil, fmt.Errorf("invalid tx: %v", err)
+		}
+		if !bytes.Equal(t.Hash(), common.Base58Decode(txHash)) {
+			return nil, fmt.Errorf("tx %v is given", common.Base58Encode(t.Hash()))
+		}
+		leaf, root = t.Hash(), head.TxMerkleHash
+	}
+	if !merkletree.VerifyMerklePath(leaf, proof.Index, proof.Path, root) {
+		return nil, fmt.Errorf("merkle path mismatch")
+	}
+	return head, nil
+}
+
+func init() {
+	rootCmd.AddCommand(verifyProofCmd)
+	verifyProofCmd.Flags().BoolVarP(&proofReceipt, "receipt", "", false, "verify the receipt of the transaction instead of the transaction")
+	verifyProofCmd.Flags().StringVarP(&proofFile, "file", "", "", "read the proof from the json file instead of the server")
+	verifyProofCmd.Flags().StringVarP(&proofBlockHash, "block_hash", "", "", "the trusted block hash which the proof should match")
+}
@@ -44,6 +44,10 @@ func init() {
 	rootCmd.PersistentFlags().BoolVarP(&sdk.verbose, "verbose", "", true, "print verbose information")
 	rootCmd.PersistentFlags().StringVarP(&sdk.accountName, "account", "", "", "which account to use")
 	rootCmd.PersistentFlags().StringVarP(&sdk.server, "server", "s", "localhost:30002", "Set server of this client")
+	rootCmd.PersistentFlags().BoolVarP(&sdk.useTLS, "tls", "", false, "connect to the server with TLS, the server certificate is verified by the system roots if tls_ca is not set")
+	rootCmd.PersistentFlags().StringVarP(&sdk.tlsCA, "tls_ca", "", "", "the CA file to verify the server certificate, which implies --tls")
+	rootCmd.PersistentFlags().StringVarP(&sdk.tlsCert, "tls_cert", "", "", "the client certificate file for mutual TLS")
+	rootCmd.PersistentFlags().StringVarP(&sdk.tlsKey, "tls_key", "", "", "the client key file for mutual TLS")
 	rootCmd.PersistentFlags().BoolVarP(&sdk.useLongestChain, "use_longest", "", false, "get balance on longest chain")
 	rootCmd.PersistentFlags().BoolVarP(&sdk.checkResult, "check_result", "", true, "Check publish/call status after sending to chain")
 	rootCmd.PersistentFlags().Float32VarP(&sdk.checkResultDelay, "check_result_delay", "", 3, "RPC checking will occur at [checkResultDelay] seconds after sending to chain.")
@@ -52,6 +56,7 @@ func init() {
 	rootCmd.PersistentFlags().Float64VarP(&sdk.gasLimit, "gas_limit", "l", 1000000, "gasLimit for a transaction")
 	rootCmd.PersistentFlags().Float64VarP(&sdk.gasRatio, "gas_ratio", "p", 1.0, "gasRatio for a transaction")
 	rootCmd.PersistentFlags().StringVarP(&sdk.amountLimit, "amount_limit", "", "*:unlimited", "amount limit for one transaction, eg iost:300.00|ram:2000")
+	rootCmd.PersistentFlags().BoolVarP(&sdk.estimate, "estimate", "", false, "estimate gas_limit and amount_limit by the rpc server before sending a transaction")
 	rootCmd.PersistentFlags().Int64VarP(&sdk.expiration, "expiration", "e", 60*5, "expiration time for a transaction,for example,-e 60 means the tx will expire after 60 seconds from now on")
 	rootCmd.PersistentFlags().Uint32VarP(&sdk.chainID, "chain_id", "", uint32(1024), "chain_id which distinguishes different network")
 
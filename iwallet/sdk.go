@@ -18,11 +18,16 @@ import (
 	"github.com/iost-official/go-iost/rpc/pb"
 	"github.com/mitchellh/go-homedir"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 // SDK ...
 type SDK struct {
 	server      string
+	useTLS      bool
+	tlsCA       string
+	tlsCert     string
+	tlsKey      string
 	accountName string
 	keyPair     *account.KeyPair
 	signAlgo    string
@@ -32,6 +37,7 @@ type SDK struct {
 	expiration  int64
 	amountLimit string
 	delaySecond int64
+	estimate    bool
 
 	checkResult         bool
 	checkResultDelay    float32
@@ -76,6 +82,27 @@ func (s *SDK) SetServer(server string) {
 	s.server = server
 }
 
+// SetTLS sets the tls options of the connection to server. TLS is used if useTLS is true
+// or caFile is given, and the client certificate is presented for mutual TLS if given.
+func (s *SDK) SetTLS(useTLS bool, caFile, certFile, keyFile string) {
+	s.useTLS = useTLS
+	s.tlsCA = caFile
+	s.tlsCert = certFile
+	s.tlsKey = keyFile
+}
+
+// dial connects to the server.
+func (s *SDK) dial() (*grpc.ClientConn, error) {
+	if !s.useTLS && s.tlsCA == "" {
+		return grpc.Dial(s.server, grpc.WithInsecure())
+	}
+	conf, err := common.NewClientTLSConfig(s.tlsCA, s.tlsCert, s.tlsKey)
+	if err != nil {
+		return nil, err
+	}
+	return grpc.Dial(s.server, grpc.WithTransportCredentials(credentials.NewTLS(conf)))
+}
+
 // SetAmountLimit ...
 func (s *SDK) SetAmountLimit(amountLimit string) {
 	s.amountLimit = amountLimit
@@ -179,7 +206,7 @@ func (s *SDK) checkPubKey(k string) bool {
 
 // GetContractStorage ...
 func (s *SDK) GetContractStorage(r *rpcpb.GetContractStorageRequest) (*rpcpb.GetContractStorageResponse, error) {
-	conn, err := grpc.Dial(s.server, grpc.WithInsecure())
+	conn, err := s.dial()
 	if err != nil {
 		return nil, err
 	}
@@ -193,7 +220,7 @@ func (s *SDK) GetContractStorage(r *rpcpb.GetContractStorageRequest) (*rpcpb.Get
 }
 
 func (s *SDK) getNodeInfo() (*rpcpb.NodeInfoResponse, error) {
-	conn, err := grpc.Dial(s.server, grpc.WithInsecure())
+	conn, err := s.dial()
 	if err != nil {
 		return nil, err
 	}
@@ -207,7 +234,7 @@ func (s *SDK) getNodeInfo() (*rpcpb.NodeInfoResponse, error) {
 }
 
 func (s *SDK) getChainInfo() (*rpcpb.ChainInfoResponse, error) {
-	conn, err := grpc.Dial(s.server, grpc.WithInsecure())
+	conn, err := s.dial()
 	if err != nil {
 		return nil, err
 	}
@@ -220,9 +247,19 @@ func (s *SDK) getChainInfo() (*rpcpb.ChainInfoResponse, error) {
 	return value, nil
 }
 
+func (s *SDK) getProducerSchedule() (*rpcpb.ProducerScheduleResponse, error) {
+	conn, err := s.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	client := rpcpb.NewApiServiceClient(conn)
+	return client.GetProducerSchedule(context.Background(), &rpcpb.EmptyRequest{})
+}
+
 // getAccountInfo return account info
 func (s *SDK) getAccountInfo(id string) (*rpcpb.Account, error) {
-	conn, err := grpc.Dial(s.server, grpc.WithInsecure())
+	conn, err := s.dial()
 	if err != nil {
 		return nil, err
 	}
@@ -236,7 +273,7 @@ func (s *SDK) getAccountInfo(id string) (*rpcpb.Account, error) {
 	return value, nil
 }
 func (s *SDK) getGetBlockByNum(num int64, complete bool) (*rpcpb.BlockResponse, error) {
-	conn, err := grpc.Dial(s.server, grpc.WithInsecure())
+	conn, err := s.dial()
 	if err != nil {
 		return nil, err
 	}
@@ -246,7 +283,7 @@ func (s *SDK) getGetBlockByNum(num int64, complete bool) (*rpcpb.BlockResponse,
 }
 
 func (s *SDK) getGetBlockByHash(hash string, complete bool) (*rpcpb.BlockResponse, error) {
-	conn, err := grpc.Dial(s.server, grpc.WithInsecure())
+	conn, err := s.dial()
 	if err != nil {
 		return nil, err
 	}
@@ -256,7 +293,7 @@ func (s *SDK) getGetBlockByHash(hash string, complete bool) (*rpcpb.BlockRespons
 }
 
 func (s *SDK) getTxByHash(hash string) (*rpcpb.TransactionResponse, error) {
-	conn, err := grpc.Dial(s.server, grpc.WithInsecure())
+	conn, err := s.dial()
 	if err != nil {
 		return nil, err
 	}
@@ -265,9 +302,32 @@ func (s *SDK) getTxByHash(hash string) (*rpcpb.TransactionResponse, error) {
 	return client.GetTxByHash(context.Background(), &rpcpb.TxHashRequest{Hash: hash})
 }
 
+func (s *SDK) getTxsByAccount(name string, limit int64, cursor string) (*rpcpb.GetTxsByAccountResponse, error) {
+	conn, err := s.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	client := rpcpb.NewApiServiceClient(conn)
+	return client.GetTxsByAccount(context.Background(), &rpcpb.GetTxsByAccountRequest{Account: name, Limit: limit, Cursor: cursor})
+}
+
+func (s *SDK) getTxProof(hash string, receipt bool) (*rpcpb.MerkleProofResponse, error) {
+	conn, err := s.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	client := rpcpb.NewApiServiceClient(conn)
+	if receipt {
+		return client.GetReceiptProof(context.Background(), &rpcpb.TxHashRequest{Hash: hash})
+	}
+	return client.GetTxProof(context.Background(), &rpcpb.TxHashRequest{Hash: hash})
+}
+
 // GetTxReceiptByTxHash ...
 func (s *SDK) GetTxReceiptByTxHash(txHashStr string) (*rpcpb.TxReceipt, error) {
-	conn, err := grpc.Dial(s.server, grpc.WithInsecure())
+	conn, err := s.dial()
 	if err != nil {
 		return nil, err
 	}
@@ -276,12 +336,44 @@ func (s *SDK) GetTxReceiptByTxHash(txHashStr string) (*rpcpb.TxReceipt, error) {
 	return client.GetTxReceiptByTxHash(context.Background(), &rpcpb.TxHashRequest{Hash: txHashStr})
 }
 
+func (s *SDK) estimateResources(stx *rpcpb.TransactionRequest) (*rpcpb.EstimateResourcesResponse, error) {
+	conn, err := s.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	client := rpcpb.NewApiServiceClient(conn)
+	return client.EstimateResources(context.Background(), stx)
+}
+
+// fillResources sets the gas limit and amount limit of the tx by the estimation of the server.
+func (s *SDK) fillResources(t *rpcpb.TransactionRequest) error {
+	stx, err := s.signTx(t)
+	if err != nil {
+		return fmt.Errorf("sign tx error %v", err)
+	}
+	res, err := s.estimateResources(stx)
+	if err != nil {
+		return fmt.Errorf("estimate resources error %v", err)
+	}
+	if res.TxReceipt.StatusCode != rpcpb.TxReceipt_SUCCESS {
+		return fmt.Errorf("estimate resources failed: %v %v", res.TxReceipt.StatusCode, res.TxReceipt.Message)
+	}
+	t.GasLimit = res.GasLimit
+	t.AmountLimit = res.AmountLimit
+	if s.verbose {
+		fmt.Printf("estimated gas used: %v, ram usage: %v\n", res.GasUsed, res.RamUsage)
+		fmt.Printf("set gas limit to %v, amount limit to %v\n", t.GasLimit, t.AmountLimit)
+	}
+	return nil
+}
+
 func (s *SDK) sendTx(stx *rpcpb.TransactionRequest) (string, error) {
 	fmt.Println("sending tx")
 	if sdk.verbose {
 		fmt.Println(stx)
 	}
-	conn, err := grpc.Dial(s.server, grpc.WithInsecure())
+	conn, err := s.dial()
 	if err != nil {
 		return "", err
 	}
@@ -537,6 +629,11 @@ func (s *SDK) PublishContract(codePath string, abiPath string, conID string, upd
 	if err != nil {
 		return nil, "", err
 	}
+	if s.estimate {
+		if err = s.fillResources(trx); err != nil {
+			return nil, "", err
+		}
+	}
 	stx, err = s.signTx(trx)
 	if err != nil {
 		return nil, "", fmt.Errorf("sign tx error %v", err)
@@ -556,6 +653,11 @@ func (s *SDK) SendTx(actions []*rpcpb.Action) (txHash string, err error) {
 	if err != nil {
 		return "", err
 	}
+	if s.estimate {
+		if err = s.fillResources(trx); err != nil {
+			return "", err
+		}
+	}
 	stx, err := s.signTx(trx)
 	if err != nil {
 		return "", fmt.Errorf("sign tx error %v", err)
@@ -0,0 +1,50 @@
+package p2p
+
+// LocalService is the Service of a node without network, which drops all the messages sent
+// and never receives any message.
+type LocalService struct{}
+
+var _ Service = &LocalService{}
+
+// NewLocalService returns a LocalService instance.
+func NewLocalService() *LocalService {
+	return &LocalService{}
+}
+
+// Start starts the service.
+func (ls *LocalService) Start() error {
+	return nil
+}
+
+// Stop stops the service.
+func (ls *LocalService) Stop() {}
+
+// ID returns the ID of the local node.
+func (ls *LocalService) ID() string {
+	return "local"
+}
+
+// ConnectBPs does nothing.
+func (ls *LocalService) ConnectBPs([]string) {}
+
+// PutPeerToBlack does nothing.
+func (ls *LocalService) PutPeerToBlack(string) {}
+
+// Broadcast drops the message.
+func (ls *LocalService) Broadcast([]byte, MessageType, MessagePriority) {}
+
+// SendToPeer drops the message.
+func (ls *LocalService) SendToPeer(PeerID, []byte, MessageType, MessagePriority) {}
+
+// Register returns a channel which never receives any message.
+func (ls *LocalService) Register(string, ...MessageType) chan IncomingMessage {
+	return make(chan IncomingMessage)
+}
+
+// Deregister does nothing.
+func (ls *LocalService) Deregister(string, ...MessageType) {}
+
+// GetAllNeighbors returns no neighbor.
+func (ls *LocalService) GetAllNeighbors() []*Peer {
+	return nil
+}
@@ -0,0 +1,218 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/textproto"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/iost-official/go-iost/common"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// The metadata keys carrying the client information, which are set by the gateway and JSON-RPC server.
+const (
+	apiKeyHeader       = "X-Api-Key"
+	apiKeyMetadata     = "x-api-key"
+	forwardedForHeader = "X-Forwarded-For"
+	forwardedMetadata  = "x-forwarded-for"
+)
+
+const (
+	maxLimiters     = 10000
+	limiterIdleTime = 10 * time.Minute
+)
+
+// The reasons of rejected requests, reported by metrics.
+const (
+	rejectDisabled     = "disabled"
+	rejectUnauthorized = "unauthorized"
+	rejectRateLimited  = "rate_limited"
+)
+
+type clientLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// accessControl enforces the api keys, method lists and rate limits of the rpc server.
+type accessControl struct {
+	keys          map[string]bool
+	requireKey    bool
+	allowMethods  map[string]bool
+	denyMethods   map[string]bool
+	ipLimit       rate.Limit
+	ipBurst       int
+	keyLimit      rate.Limit
+	keyBurst      int
+	limiters      map[string]*clientLimiter
+	limitersMutex sync.Mutex
+}
+
+func newAccessControl(conf *common.RPCConfig) *accessControl {
+	ac := &accessControl{
+		keys:         toSet(conf.APIKeys),
+		requireKey:   conf.RequireAPIKey,
+		allowMethods: toSet(conf.AllowMethods),
+		denyMethods:  toSet(conf.DenyMethods),
+		ipLimit:      rate.Inf,
+		keyLimit:     rate.Inf,
+		limiters:     make(map[string]*clientLimiter),
+	}
+	if conf.RateLimit > 0 {
+		ac.ipLimit, ac.ipBurst = rate.Limit(conf.RateLimit), burst(conf.RateLimit, conf.RateBurst)
+	}
+	if conf.KeyRateLimit > 0 {
+		ac.keyLimit, ac.keyBurst = rate.Limit(conf.KeyRateLimit), burst(conf.KeyRateLimit, conf.KeyRateBurst)
+	}
+	return ac
+}
+
+func toSet(list []string) map[string]bool {
+	set := make(map[string]bool)
+	for _, s := range list {
+		set[s] = true
+	}
+	return set
+}
+
+func burst(limit float64, b int) int {
+	if b > 0 {
+		return b
+	}
+	if limit < 1 {
+		return 1
+	}
+	return int(limit)
+}
+
+// methodName returns the short name of the grpc method, e.g. /rpcpb.ApiService/GetChainInfo -> GetChainInfo.
+func methodName(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
+
+// clientInfo returns the ip and api key of the client. The forwarded ip is trusted only
+// if the request comes from the gateway or JSON-RPC server.
+func clientInfo(ctx context.Context) (ip string, key string) {
+	forwarded := false
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		ip = p.Addr.String()
+		if host, _, err := net.SplitHostPort(ip); err == nil {
+			ip = host
+		}
+		_, forwarded = p.Addr.(pipeAddr)
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ip, ""
+	}
+	if keys := md.Get(apiKeyMetadata); len(keys) > 0 {
+		key = keys[0]
+	}
+	if forwarded {
+		if fwd := md.Get(forwardedMetadata); len(fwd) > 0 {
+			list := strings.Split(fwd[len(fwd)-1], ",")
+			if last := strings.TrimSpace(list[len(list)-1]); last != "" {
+				ip = last
+			}
+		}
+	}
+	return ip, key
+}
+
+// check returns nil if the request of the method is allowed.
+func (ac *accessControl) check(ctx context.Context, fullMethod string) error {
+	method := methodName(fullMethod)
+	if ac.denyMethods[method] || (len(ac.allowMethods) > 0 && !ac.allowMethods[method]) {
+		rejectCounter.Add(1, map[string]string{"method": method, "reason": rejectDisabled})
+		return status.Errorf(codes.PermissionDenied, "method %v is disabled", method)
+	}
+	ip, key := clientInfo(ctx)
+	if key != "" && !ac.keys[key] {
+		rejectCounter.Add(1, map[string]string{"method": method, "reason": rejectUnauthorized})
+		return status.Error(codes.Unauthenticated, "invalid api key")
+	}
+	if key == "" && ac.requireKey {
+		rejectCounter.Add(1, map[string]string{"method": method, "reason": rejectUnauthorized})
+		return status.Error(codes.Unauthenticated, "api key is required")
+	}
+	if !ac.allow(ip, key) {
+		rejectCounter.Add(1, map[string]string{"method": method, "reason": rejectRateLimited})
+		return status.Error(codes.ResourceExhausted, "rate limit exceeded")
+	}
+	return nil
+}
+
+// allow takes a token from the bucket of the api key, or the ip if no key is given.
+func (ac *accessControl) allow(ip string, key string) bool {
+	id, limit, b := "ip:"+ip, ac.ipLimit, ac.ipBurst
+	if key != "" {
+		id, limit, b = "key:"+key, ac.keyLimit, ac.keyBurst
+	}
+	if limit == rate.Inf {
+		return true
+	}
+	now := time.Now()
+	ac.limitersMutex.Lock()
+	defer ac.limitersMutex.Unlock()
+	if len(ac.limiters) >= maxLimiters {
+		for k, l := range ac.limiters {
+			if now.Sub(l.lastSeen) > limiterIdleTime {
+				delete(ac.limiters, k)
+			}
+		}
+	}
+	l, ok := ac.limiters[id]
+	if !ok {
+		l = &clientLimiter{limiter: rate.NewLimiter(limit, b)}
+		ac.limiters[id] = l
+	}
+	l.lastSeen = now
+	return l.limiter.AllowN(now, 1)
+}
+
+func (ac *accessControl) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := ac.check(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (ac *accessControl) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := ac.check(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// gatewayHeaderMatcher forwards the api key header to grpc besides the default headers.
+func gatewayHeaderMatcher(key string) (string, bool) {
+	if textproto.CanonicalMIMEHeaderKey(key) == apiKeyHeader {
+		return apiKeyMetadata, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// forwardClientInfo appends the ip and api key of the http request to the outgoing grpc metadata.
+func forwardClientInfo(ctx context.Context, r *http.Request) context.Context {
+	var pairs []string
+	if key := r.Header.Get(apiKeyHeader); key != "" {
+		pairs = append(pairs, apiKeyMetadata, key)
+	}
+	if ip, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		if fwd := r.Header.Get(forwardedForHeader); fwd != "" {
+			ip = fwd + ", " + ip
+		}
+		pairs = append(pairs, forwardedMetadata, ip)
+	}
+	return metadata.AppendToOutgoingContext(ctx, pairs...)
+}
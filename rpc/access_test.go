@@ -0,0 +1,81 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func clientContext(addr string, kv ...string) context.Context {
+	var netAddr net.Addr = pipeAddr{}
+	if addr != "pipe" {
+		netAddr, _ = net.ResolveTCPAddr("tcp", addr)
+	}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: netAddr})
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(kv...))
+}
+
+func TestClientInfo(t *testing.T) {
+	ip, key := clientInfo(clientContext("1.2.3.4:5000", forwardedMetadata, "5.6.7.8", apiKeyMetadata, "k1"))
+	assert.Equal(t, "1.2.3.4", ip)
+	assert.Equal(t, "k1", key)
+
+	ip, _ = clientInfo(clientContext("127.0.0.1:5000", forwardedMetadata, "5.6.7.8"))
+	assert.Equal(t, "127.0.0.1", ip)
+
+	ip, _ = clientInfo(clientContext("pipe", forwardedMetadata, "9.9.9.9, 5.6.7.8"))
+	assert.Equal(t, "5.6.7.8", ip)
+
+	r := httptest.NewRequest("POST", "/", nil)
+	r.RemoteAddr = "5.6.7.8:1234"
+	r.Header.Set(forwardedForHeader, "9.9.9.9")
+	r.Header.Set(apiKeyHeader, "k2")
+	md, _ := metadata.FromOutgoingContext(forwardClientInfo(context.Background(), r))
+	ip, key = clientInfo(clientContext("pipe", forwardedMetadata, md.Get(forwardedMetadata)[0], apiKeyMetadata, md.Get(apiKeyMetadata)[0]))
+	assert.Equal(t, "5.6.7.8", ip)
+	assert.Equal(t, "k2", key)
+}
+
+func TestAccessControl(t *testing.T) {
+	ac := newAccessControl(&common.RPCConfig{
+		RateLimit:    1,
+		RateBurst:    2,
+		KeyRateLimit: 1,
+		KeyRateBurst: 3,
+		APIKeys:      []string{"k1"},
+		DenyMethods:  []string{"SendTransaction"},
+	})
+	code := func(ctx context.Context, method string) codes.Code {
+		return status.Code(ac.check(ctx, "/rpcpb.ApiService/"+method))
+	}
+	ctx := clientContext("1.2.3.4:5000")
+	assert.Equal(t, codes.PermissionDenied, code(ctx, "SendTransaction"))
+	assert.Equal(t, codes.OK, code(ctx, "GetChainInfo"))
+	assert.Equal(t, codes.OK, code(ctx, "GetChainInfo"))
+	assert.Equal(t, codes.ResourceExhausted, code(ctx, "GetChainInfo"))
+	assert.Equal(t, codes.OK, code(clientContext("1.2.3.5:5000"), "GetChainInfo"))
+
+	assert.Equal(t, codes.Unauthenticated, code(clientContext("1.2.3.4:5000", apiKeyMetadata, "k2"), "GetChainInfo"))
+	keyCtx := clientContext("1.2.3.4:5000", apiKeyMetadata, "k1")
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, codes.OK, code(keyCtx, "GetChainInfo"))
+	}
+	assert.Equal(t, codes.ResourceExhausted, code(keyCtx, "GetChainInfo"))
+
+	ac = newAccessControl(&common.RPCConfig{
+		APIKeys:       []string{"k1"},
+		RequireAPIKey: true,
+		AllowMethods:  []string{"GetChainInfo"},
+	})
+	assert.Equal(t, codes.Unauthenticated, code(ctx, "GetChainInfo"))
+	assert.Equal(t, codes.OK, code(keyCtx, "GetChainInfo"))
+	assert.Equal(t, codes.PermissionDenied, code(keyCtx, "GetBlockByNumber"))
+}
@@ -1,12 +1,14 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/iost-official/go-iost/vm"
@@ -16,16 +18,20 @@ import (
 	"github.com/iost-official/go-iost/consensus/pob"
 	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/core/contract"
 	"github.com/iost-official/go-iost/core/event"
 	"github.com/iost-official/go-iost/core/global"
 	"github.com/iost-official/go-iost/core/tx"
 	"github.com/iost-official/go-iost/core/txpool"
+	"github.com/iost-official/go-iost/db"
 	"github.com/iost-official/go-iost/ilog"
 	"github.com/iost-official/go-iost/p2p"
 	"github.com/iost-official/go-iost/rpc/pb"
 	"github.com/iost-official/go-iost/verifier"
 	"github.com/iost-official/go-iost/vm/database"
 	"github.com/iost-official/go-iost/vm/host"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 //go:generate mockgen -destination mock_rpc/mock_api.go -package main github.com/iost-official/go-iost/rpc/pb ApiServiceServer
@@ -102,7 +108,7 @@ func (as *APIService) GetChainInfo(context.Context, *rpcpb.EmptyRequest) (*rpcpb
 		NetName:         netName,
 		ProtocolVersion: version,
 		ChainId:         as.bv.Config().P2P.ChainID,
-		WitnessList:     pob.GetStaticProperty().WitnessList,
+		WitnessList:     as.bc.LinkedRoot().Active(),
 		HeadBlock:       headBlock.Head.Number,
 		HeadBlockHash:   common.Base58Encode(headBlock.HeadHash()),
 		LibBlock:        libBlock.Head.Number,
@@ -110,6 +116,50 @@ func (as *APIService) GetChainInfo(context.Context, *rpcpb.EmptyRequest) (*rpcpb
 	}, nil
 }
 
+// GetProducerSchedule returns the producer schedule and the confirming state of the blocks.
+func (as *APIService) GetProducerSchedule(context.Context, *rpcpb.EmptyRequest) (*rpcpb.ProducerScheduleResponse, error) {
+	// the head is read after the root, which is its ancestor
+	root := as.bc.LinkedRoot()
+	head := as.bc.Head()
+	schedule, err := pob.GetSchedule(head, root, time.Now().UnixNano())
+	if err != nil {
+		return nil, err
+	}
+	res := &rpcpb.ProducerScheduleResponse{
+		ActiveWitnesses:     root.Active(),
+		PendingWitnesses:    root.Pending(),
+		PendingNumber:       root.PendingNum(),
+		ConfirmationsNeeded: schedule.ConfirmLimit,
+		HeadBlock:           head.Head.Number,
+		LibBlock:            root.Head.Number,
+	}
+	for _, s := range schedule.Slots {
+		res.Slots = append(res.Slots, &rpcpb.ProducerScheduleResponse_Slot{
+			Slot:    s.Slot,
+			Time:    s.Time,
+			Witness: s.Witness,
+		})
+	}
+	for _, w := range schedule.Witnesses {
+		res.Witnesses = append(res.Witnesses, &rpcpb.ProducerScheduleResponse_Witness{
+			Witness:      w.Witness,
+			Watermark:    w.Watermark,
+			LastBlock:    w.LastBlock,
+			ConfirmUntil: w.ConfirmUntil,
+		})
+	}
+	for _, b := range schedule.PendingBlocks {
+		res.PendingBlocks = append(res.PendingBlocks, &rpcpb.ProducerScheduleResponse_PendingBlock{
+			Number:        b.Number,
+			Hash:          common.Base58Encode(b.Hash),
+			Witness:       b.Witness,
+			ConfirmUntil:  b.ConfirmUntil,
+			Confirmations: b.Confirmations,
+		})
+	}
+	return res, nil
+}
+
 // GetTxByHash returns the transaction corresponding to the given hash.
 func (as *APIService) GetTxByHash(ctx context.Context, req *rpcpb.TxHashRequest) (*rpcpb.TransactionResponse, error) {
 	txHashBytes := common.Base58Decode(req.GetHash())
@@ -136,10 +186,51 @@ func (as *APIService) GetTxByHash(ctx context.Context, req *rpcpb.TxHashRequest)
 		}
 	}
 
-	return &rpcpb.TransactionResponse{
+	res := &rpcpb.TransactionResponse{
 		Status:      status,
 		Transaction: toPbTx(t, txReceipt),
-	}, nil
+	}
+	if status == rpcpb.TransactionResponse_PENDING {
+		pending, _ := as.txpool.PendingTx()
+		res.PoolStatus = poolStatus(pendingTxs(pending), txHashBytes, time.Now().UnixNano())
+	}
+	return res, nil
+}
+
+// GetPendingTxs returns the pending transactions in txpool.
+func (as *APIService) GetPendingTxs(ctx context.Context, req *rpcpb.GetPendingTxsRequest) (*rpcpb.GetPendingTxsResponse, error) {
+	var offset int64
+	if req.GetCursor() != "" {
+		var err error
+		offset, err = decodeOffsetCursor(req.GetCursor())
+		if err != nil {
+			return nil, err
+		}
+	}
+	limit := clampLimit(req.GetLimit(), defaultPageLimit, maxPageLimit)
+	pending, _ := as.txpool.PendingTx()
+	txs := make([]*tx.Tx, 0)
+	for _, t := range pendingTxs(pending) {
+		if matchPendingTx(t, req.GetPublisher(), req.GetContract()) {
+			txs = append(txs, t)
+		}
+	}
+	res := &rpcpb.GetPendingTxsResponse{
+		Total: int64(len(txs)),
+	}
+	for i := offset; i < offset+limit && i < int64(len(txs)); i++ {
+		res.Transactions = append(res.Transactions, toPbTx(txs[i], nil))
+	}
+	if offset+limit < int64(len(txs)) {
+		res.Cursor = encodeOffsetCursor(offset + limit)
+	}
+	return res, nil
+}
+
+// GetPendingTxStats returns the statistics of the pending transactions in txpool.
+func (as *APIService) GetPendingTxStats(ctx context.Context, req *rpcpb.EmptyRequest) (*rpcpb.PendingTxStatsResponse, error) {
+	pending, _ := as.txpool.PendingTx()
+	return pendingTxStats(pendingTxs(pending), time.Now().UnixNano()), nil
 }
 
 // GetTxReceiptByTxHash returns transaction receipts corresponding to the given tx hash.
@@ -152,6 +243,77 @@ func (as *APIService) GetTxReceiptByTxHash(ctx context.Context, req *rpcpb.TxHas
 	return toPbTxReceipt(receipt), nil
 }
 
+// GetTxProof returns the merkle proof of the transaction in its block.
+func (as *APIService) GetTxProof(ctx context.Context, req *rpcpb.TxHashRequest) (*rpcpb.MerkleProofResponse, error) {
+	return as.getMerkleProof(common.Base58Decode(req.GetHash()), false)
+}
+
+// GetReceiptProof returns the merkle proof of the transaction receipt in its block.
+func (as *APIService) GetReceiptProof(ctx context.Context, req *rpcpb.TxHashRequest) (*rpcpb.MerkleProofResponse, error) {
+	return as.getMerkleProof(common.Base58Decode(req.GetHash()), true)
+}
+
+func (as *APIService) getMerkleProof(txHash []byte, receipt bool) (*rpcpb.MerkleProofResponse, error) {
+	blk, status, err := as.getBlockByTxHash(txHash)
+	if err != nil {
+		return nil, err
+	}
+	index := -1
+	for i, t := range blk.Txs {
+		if bytes.Equal(t.Hash(), txHash) {
+			index = i
+			break
+		}
+	}
+	if index < 0 || index >= len(blk.Receipts) {
+		return nil, errors.New("tx not found")
+	}
+	var (
+		data []byte
+		mp   [][]byte
+	)
+	if receipt {
+		data = blk.Receipts[index].Encode()
+		mp, err = blk.TxReceiptMerklePath(index)
+	} else {
+		data = blk.Txs[index].Encode()
+		mp, err = blk.TxMerklePath(index)
+	}
+	if err != nil {
+		return nil, err
+	}
+	head, err := blk.Head.Encode()
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.MerkleProofResponse{
+		Status:    status,
+		Data:      data,
+		Index:     int64(index),
+		Path:      mp,
+		BlockHead: head,
+		BlockHash: common.Base58Encode(blk.HeadHash()),
+		Signature: toPbSignature(blk.Sign),
+	}, nil
+}
+
+// getBlockByTxHash returns the block containing the tx in the longest chain.
+func (as *APIService) getBlockByTxHash(txHash []byte) (*block.Block, rpcpb.BlockResponse_Status, error) {
+	root := as.bc.LinkedRoot()
+	for bcn := as.bc.Head(); bcn != nil && bcn != root; bcn = bcn.GetParent() {
+		for _, t := range bcn.Block.Txs {
+			if bytes.Equal(t.Hash(), txHash) {
+				return bcn.Block, rpcpb.BlockResponse_PENDING, nil
+			}
+		}
+	}
+	blk, err := as.blockchain.GetBlockByTxHash(txHash)
+	if err != nil {
+		return nil, rpcpb.BlockResponse_IRREVERSIBLE, errors.New("tx not found")
+	}
+	return blk, rpcpb.BlockResponse_IRREVERSIBLE, nil
+}
+
 // GetBlockByHash returns block corresponding to the given hash.
 func (as *APIService) GetBlockByHash(ctx context.Context, req *rpcpb.GetBlockByHashRequest) (*rpcpb.BlockResponse, error) {
 	hashBytes := common.Base58Decode(req.GetHash())
@@ -165,7 +327,7 @@ func (as *APIService) GetBlockByHash(ctx context.Context, req *rpcpb.GetBlockByH
 		status = rpcpb.BlockResponse_IRREVERSIBLE
 		blk, err = as.blockchain.GetBlockByHash(hashBytes)
 		if err != nil {
-			return nil, err
+			return nil, blockError(err)
 		}
 	}
 	return &rpcpb.BlockResponse{
@@ -176,29 +338,200 @@ func (as *APIService) GetBlockByHash(ctx context.Context, req *rpcpb.GetBlockByH
 
 // GetBlockByNumber returns block corresponding to the given number.
 func (as *APIService) GetBlockByNumber(ctx context.Context, req *rpcpb.GetBlockByNumberRequest) (*rpcpb.BlockResponse, error) {
-	number := req.GetNumber()
-	var (
-		blk *block.Block
-		err error
-	)
+	blk, status, err := as.getBlockByNumber(req.GetNumber())
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.BlockResponse{
+		Status: status,
+		Block:  toPbBlock(blk, req.GetComplete()),
+	}, nil
+}
+
+func (as *APIService) getBlockByNumber(number int64) (*block.Block, rpcpb.BlockResponse_Status, error) {
 	status := rpcpb.BlockResponse_PENDING
-	blk, err = as.bc.GetBlockByNumber(number)
+	blk, err := as.bc.GetBlockByNumber(number)
 	if err != nil {
 		status = rpcpb.BlockResponse_IRREVERSIBLE
 		blk, err = as.blockchain.GetBlockByNumber(number)
+		if err != nil {
+			return nil, status, blockError(err)
+		}
+	}
+	return blk, status, nil
+}
+
+// blockError converts the error of a pruned block to the NotFound status.
+func blockError(err error) error {
+	if _, ok := err.(*block.PrunedError); ok {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return err
+}
+
+// blockRange checks the requested range and clamps its end to the head block.
+func (as *APIService) blockRange(start, end int64) (int64, int64, error) {
+	head := as.bc.Head().Head.Number
+	if end <= 0 || end > head {
+		end = head
+	}
+	if start < 0 || start > end {
+		return 0, 0, fmt.Errorf("invalid block range [%d, %d], head block is %d", start, end, head)
+	}
+	return start, end, nil
+}
+
+// GetBlocksByRange streams blocks in the given range.
+func (as *APIService) GetBlocksByRange(req *rpcpb.GetBlocksByRangeRequest, res rpcpb.ApiService_GetBlocksByRangeServer) error {
+	start, end, err := as.blockRange(req.GetStartNumber(), req.GetEndNumber())
+	if err != nil {
+		return err
+	}
+	limit := clampLimit(req.GetLimit(), defaultBlocksLimit, maxBlocksLimit)
+	if end-start+1 > limit {
+		end = start + limit - 1
+	}
+	for number := start; number <= end; number++ {
+		select {
+		case <-as.quitCh:
+			return nil
+		case <-res.Context().Done():
+			return res.Context().Err()
+		default:
+		}
+		blk, status, err := as.getBlockByNumber(number)
+		if err != nil {
+			return err
+		}
+		err = res.Send(&rpcpb.BlockResponse{
+			Status: status,
+			Block:  toPbBlock(blk, req.GetComplete()),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetTxsByBlockRange streams transactions packed in blocks in the given range.
+func (as *APIService) GetTxsByBlockRange(req *rpcpb.GetTxsByBlockRangeRequest, res rpcpb.ApiService_GetTxsByBlockRangeServer) error {
+	start, index := req.GetStartNumber(), int64(0)
+	if req.GetCursor() != "" {
+		var err error
+		start, index, err = decodeTxCursor(req.GetCursor())
+		if err != nil {
+			return err
+		}
+	}
+	start, end, err := as.blockRange(start, req.GetEndNumber())
+	if err != nil {
+		return err
+	}
+	capped := end-start+1 > maxBlocksLimit
+	if capped {
+		end = start + maxBlocksLimit - 1
+	}
+	limit := clampLimit(req.GetLimit(), defaultTxsLimit, maxTxsLimit)
+	var sent int64
+	for number := start; number <= end && sent < limit; number++ {
+		select {
+		case <-as.quitCh:
+			return nil
+		case <-res.Context().Done():
+			return res.Context().Err()
+		default:
+		}
+		blk, status, err := as.getBlockByNumber(number)
+		if err != nil {
+			return err
+		}
+		txStatus := rpcpb.TransactionResponse_PACKED
+		if status == rpcpb.BlockResponse_IRREVERSIBLE {
+			txStatus = rpcpb.TransactionResponse_IRREVERSIBLE
+		}
+		hash := common.Base58Encode(blk.HeadHash())
+		for i := index; i < int64(len(blk.Txs)) && sent < limit; i++ {
+			var receipt *tx.TxReceipt
+			if i < int64(len(blk.Receipts)) {
+				receipt = blk.Receipts[i]
+			}
+			err = res.Send(&rpcpb.BlockTxResponse{
+				Status:      txStatus,
+				Transaction: toPbTx(blk.Txs[i], receipt),
+				BlockNumber: number,
+				BlockHash:   hash,
+				Index:       i,
+				Cursor:      encodeTxCursor(number, i+1),
+			})
+			if err != nil {
+				return err
+			}
+			sent++
+		}
+		index = 0
+	}
+	if capped && sent < limit {
+		// the blocks after end aren't scanned, let the client continue from the next block
+		return res.Send(&rpcpb.BlockTxResponse{
+			BlockNumber: end,
+			Cursor:      encodeTxCursor(end+1, 0),
+		})
+	}
+	return nil
+}
+
+// GetTxsByAccount returns transactions related to the given account.
+func (as *APIService) GetTxsByAccount(ctx context.Context, req *rpcpb.GetTxsByAccountRequest) (*rpcpb.GetTxsByAccountResponse, error) {
+	var number, index int64
+	if req.GetCursor() != "" {
+		var err error
+		number, index, err = decodeTxCursor(req.GetCursor())
 		if err != nil {
 			return nil, err
 		}
 	}
-	return &rpcpb.BlockResponse{
-		Status: status,
-		Block:  toPbBlock(blk, req.GetComplete()),
-	}, nil
+	limit := clampLimit(req.GetLimit(), defaultPageLimit, maxPageLimit)
+	entries, err := as.blockchain.GetAccountTxs(req.GetAccount(), number, index, int(limit)+1)
+	if err != nil {
+		return nil, err
+	}
+	res := &rpcpb.GetTxsByAccountResponse{}
+	if int64(len(entries)) > limit {
+		res.Cursor = encodeTxCursor(entries[limit].BlockNumber, entries[limit].Index)
+		entries = entries[:limit]
+	}
+	for _, e := range entries {
+		t, err := as.blockchain.GetTx(e.TxHash)
+		if err != nil {
+			return nil, err
+		}
+		receipt, err := as.blockchain.GetReceiptByTxHash(e.TxHash)
+		if err != nil {
+			return nil, err
+		}
+		blockHash, err := as.blockchain.GetHashByNumber(e.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+		res.Transactions = append(res.Transactions, &rpcpb.BlockTxResponse{
+			Status:      rpcpb.TransactionResponse_IRREVERSIBLE,
+			Transaction: toPbTx(t, receipt),
+			BlockNumber: e.BlockNumber,
+			BlockHash:   common.Base58Encode(blockHash),
+			Index:       e.Index,
+			Cursor:      encodeTxCursor(e.BlockNumber, e.Index+1),
+		})
+	}
+	return res, nil
 }
 
 // GetAccount returns account information corresponding to the given account name.
 func (as *APIService) GetAccount(ctx context.Context, req *rpcpb.GetAccountRequest) (*rpcpb.Account, error) {
-	dbVisitor := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, blkHead, err := as.getStateDBVisitorAt(req.ByLongestChain, req.GetBlockNumber(), req.GetBlockHash())
+	if err != nil {
+		return nil, err
+	}
 	// pack basic account information
 	acc, _ := host.ReadAuth(dbVisitor, req.GetName())
 	if acc == nil {
@@ -217,13 +550,7 @@ func (as *APIService) GetAccount(ctx context.Context, req *rpcpb.GetAccountReque
 	}
 
 	// pack gas information
-	var blkTime int64
-	if req.GetByLongestChain() {
-		blkTime = as.bc.Head().Head.Time
-	} else {
-		blkTime = as.bc.LinkedRoot().Head.Time
-	}
-	pGas := dbVisitor.PGasAtTime(req.GetName(), blkTime)
+	pGas := dbVisitor.PGasAtTime(req.GetName(), blkHead.Time)
 	tGas := dbVisitor.TGas(req.GetName())
 	totalGas := pGas.Add(tGas)
 	gasLimit := dbVisitor.GasLimit(req.GetName())
@@ -266,7 +593,10 @@ func (as *APIService) GetAccount(ctx context.Context, req *rpcpb.GetAccountReque
 
 // GetTokenBalance returns contract information corresponding to the given contract ID.
 func (as *APIService) GetTokenBalance(ctx context.Context, req *rpcpb.GetTokenBalanceRequest) (*rpcpb.GetTokenBalanceResponse, error) {
-	dbVisitor := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, _, err := as.getStateDBVisitorAt(req.ByLongestChain, req.GetBlockNumber(), req.GetBlockHash())
+	if err != nil {
+		return nil, err
+	}
 	// pack basic account information
 	acc, _ := host.ReadAuth(dbVisitor, req.GetAccount())
 	if acc == nil {
@@ -290,7 +620,10 @@ func (as *APIService) GetTokenBalance(ctx context.Context, req *rpcpb.GetTokenBa
 
 // GetToken721Balance returns balance of account of an specific token721 token.
 func (as *APIService) GetToken721Balance(ctx context.Context, req *rpcpb.GetTokenBalanceRequest) (*rpcpb.GetToken721BalanceResponse, error) {
-	dbVisitor := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, _, err := as.getStateDBVisitorAt(req.ByLongestChain, req.GetBlockNumber(), req.GetBlockHash())
+	if err != nil {
+		return nil, err
+	}
 	// pack basic account information
 	acc, _ := host.ReadAuth(dbVisitor, req.GetAccount())
 	if acc == nil {
@@ -357,7 +690,10 @@ func (as *APIService) GetGasRatio(ctx context.Context, req *rpcpb.EmptyRequest)
 
 // GetContractStorage returns contract storage corresponding to the given key and field.
 func (as *APIService) GetContractStorage(ctx context.Context, req *rpcpb.GetContractStorageRequest) (*rpcpb.GetContractStorageResponse, error) {
-	dbVisitor := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, _, err := as.getStateDBVisitorAt(req.ByLongestChain, req.GetBlockNumber(), req.GetBlockHash())
+	if err != nil {
+		return nil, err
+	}
 	h := host.NewHost(host.NewContext(nil), dbVisitor, nil, nil)
 	var value interface{}
 	switch {
@@ -381,16 +717,127 @@ func (as *APIService) GetContractStorage(ctx context.Context, req *rpcpb.GetCont
 	}, nil
 }
 
-// GetContractStorageFields returns contract storage corresponding to the given fields.
+// GetContractStorageFields returns the fields of the map in contract storage page by page.
 func (as *APIService) GetContractStorageFields(ctx context.Context, req *rpcpb.GetContractStorageFieldsRequest) (*rpcpb.GetContractStorageFieldsResponse, error) {
-	dbVisitor := as.getStateDBVisitor(req.ByLongestChain)
-	h := host.NewHost(host.NewContext(nil), dbVisitor, nil, nil)
+	limit := clampLimit(req.GetLimit(), defaultFieldsLimit, maxFieldsLimit)
+	return storageFields(as.getStateDB(req.ByLongestChain), req.GetId(), req.GetKey(), req.GetCursor(), limit)
+}
 
-	value, _ := h.GlobalMapKeys(req.GetId(), req.GetKey())
+// storageFields returns at most limit fields of the map from the cursor. The fields are
+// read from the state db in the order of names instead of the map header, which doesn't
+// list all the fields of the large maps.
+func storageFields(stateDB db.MVCCDB, id, key, cursor string, limit int64) (*rpcpb.GetContractStorageFieldsResponse, error) {
+	prefix := database.MapPrefix + id + database.Separator + key + database.Separator
+	// the keys of fields are less than the prefix with the last separator increased
+	end := prefix[:len(prefix)-1] + string(database.Separator[0]+1)
+	iter, err := stateDB.NewIterator(database.StateTable, prefix+cursor, end)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Release()
 
-	return &rpcpb.GetContractStorageFieldsResponse{
-		Fields: value,
-	}, nil
+	res := &rpcpb.GetContractStorageFieldsResponse{}
+	for iter.Next() {
+		// the headers of the maps whose keys start with the key are not fields
+		if strings.HasPrefix(iter.Value(), database.MapHolderPrefix) {
+			continue
+		}
+		field := strings.TrimPrefix(iter.Key(), prefix)
+		owned, err := ownedBySubMap(stateDB, prefix, field)
+		if err != nil {
+			return nil, err
+		}
+		if owned {
+			continue
+		}
+		if int64(len(res.Fields)) == limit {
+			res.Cursor = field
+			break
+		}
+		res.Fields = append(res.Fields, field)
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ownedBySubMap returns whether the field belongs to another map whose key is the key of
+// the map with the separator and a prefix of the field, as the longest header is the owner
+// of a field.
+func ownedBySubMap(stateDB db.MVCCDB, prefix, field string) (bool, error) {
+	for i := len(field) - 1; i > 0; i-- {
+		if field[i] != database.Separator[0] {
+			continue
+		}
+		header, err := stateDB.Get(database.StateTable, prefix+field[:i])
+		if err != nil {
+			return false, err
+		}
+		if strings.HasPrefix(header, database.MapHolderPrefix) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// GetStateProof returns the value in contract storage with its proof to the state root.
+func (as *APIService) GetStateProof(ctx context.Context, req *rpcpb.GetStateProofRequest) (*rpcpb.GetStateProofResponse, error) {
+	node := as.bc.LinkedRoot()
+	if req.ByLongestChain {
+		node = as.bc.Head()
+	}
+	key := database.BasicPrefix + req.GetId() + database.Separator + req.GetKey()
+	if req.GetField() != "" {
+		key = database.MapPrefix + req.GetId() + database.Separator + req.GetKey() + database.Separator + req.GetField()
+	}
+	return stateProof(as.bv.StateDB(), node.HeadHash(), key)
+}
+
+// stateProof returns the value of the key in the state after the block, with its proof.
+func stateProof(stateDB db.MVCCDB, hash []byte, key string) (*rpcpb.GetStateProofResponse, error) {
+	root, err := stateDB.StateRoot(string(hash))
+	if err != nil {
+		return nil, err
+	}
+	proof, err := stateDB.StateProof(string(hash), database.StateTable, key)
+	if err != nil {
+		return nil, err
+	}
+	stateDB = stateDB.Fork()
+	if !stateDB.Checkout(string(hash)) {
+		return nil, fmt.Errorf("state of block %v not found", common.Base58Encode(hash))
+	}
+	value, err := stateDB.Get(database.StateTable, key)
+	if err != nil {
+		return nil, err
+	}
+	res := &rpcpb.GetStateProofResponse{
+		BlockHash: common.Base58Encode(hash),
+		StateRoot: common.Base58Encode(root),
+		Value:     value,
+		Exists:    value != "",
+		LeafKey:   common.Base58Encode(proof.LeafKey),
+		LeafValue: common.Base58Encode(proof.LeafValue),
+	}
+	for _, s := range proof.Siblings {
+		res.Siblings = append(res.Siblings, common.Base58Encode(s))
+	}
+	return res, nil
+}
+
+// GetEquivocations returns the evidences of the witnesses signing two blocks of the same number or slot.
+func (as *APIService) GetEquivocations(ctx context.Context, req *rpcpb.GetEquivocationsRequest) (*rpcpb.GetEquivocationsResponse, error) {
+	limit := clampLimit(req.GetLimit(), defaultPageLimit, maxPageLimit)
+	evidences, err := as.blockchain.GetEvidences(req.GetWitness(), int(limit))
+	if err != nil {
+		return nil, err
+	}
+	res := &rpcpb.GetEquivocationsResponse{}
+	for _, e := range evidences {
+		res.Equivocations = append(res.Equivocations, toPbEquivocation(e))
+	}
+	return res, nil
 }
 
 func (as *APIService) tryTransaction(t *tx.Tx) (*tx.TxReceipt, error) {
@@ -441,17 +888,84 @@ func (as *APIService) ExecTransaction(ctx context.Context, req *rpcpb.Transactio
 	return toPbTxReceipt(receipt), nil
 }
 
+// EstimateResources runs a signed transaction against the head state with enough gas limit
+// and no amount limit, and returns the resources used by the transaction.
+func (as *APIService) EstimateResources(ctx context.Context, req *rpcpb.TransactionRequest) (*rpcpb.EstimateResourcesResponse, error) {
+	t := toCoreTx(req)
+	dbVisitor := as.getStateDBVisitor(true)
+	currentGas := dbVisitor.TotalGasAtTime(t.Publisher, as.bc.Head().Head.Time)
+	t.GasLimit = currentGas.ChangeDecimal(2).Value
+	if t.GasLimit > tx.MaxGasLimit {
+		t.GasLimit = tx.MaxGasLimit
+	}
+	t.AmountLimit = []*contract.Amount{{Token: "*", Val: "unlimited"}}
+	receipt, err := as.tryTransaction(t)
+	if err != nil {
+		return nil, err
+	}
+	ret := &rpcpb.EstimateResourcesResponse{
+		TxReceipt: toPbTxReceipt(receipt),
+		GasUsed:   float64(receipt.GasUsage) / 100,
+		RamUsage:  receipt.RAMUsage,
+		GasLimit:  float64(suggestGasLimit(receipt.GasUsage)) / 100,
+	}
+	if receipt.Status.Code == tx.Success {
+		ret.AmountLimit = requiredAmountLimit(receipt.Receipts, dbVisitor.Decimal)
+	}
+	return ret, nil
+}
+
 // Subscribe used for event.
+// If the request has a cursor or a from_block_number, the stored contract receipts
+// are replayed before sending live events.
 func (as *APIService) Subscribe(req *rpcpb.SubscribeRequest, res rpcpb.ApiService_SubscribeServer) error {
 
 	topics := make([]event.Topic, 0)
+	replayReceipt := false
 	for _, t := range req.Topics {
 		topics = append(topics, event.Topic(t))
+		if t == rpcpb.Event_CONTRACT_RECEIPT {
+			replayReceipt = true
+		}
 	}
 	var filter *event.Meta
 	if req.GetFilter() != nil {
 		filter = &event.Meta{
-			ContractID: req.GetFilter().GetContractId(),
+			ContractID:   req.GetFilter().GetContractId(),
+			FuncName:     req.GetFilter().GetFuncName(),
+			Accounts:     req.GetFilter().GetAccounts(),
+			Irreversible: req.GetFilter().GetIrreversible(),
+		}
+	}
+
+	var from, txIndex, index int64
+	var err error
+	if req.GetCursor() != "" {
+		from, txIndex, index, err = decodeEventCursor(req.GetCursor())
+		if err != nil {
+			return err
+		}
+	} else {
+		from = req.GetFromBlockNumber()
+	}
+	replayReceipt = replayReceipt && (req.GetCursor() != "" || from > 0)
+
+	cursor := req.GetCursor()
+	send := func(e *rpcpb.Event, c string) error {
+		err := res.Send(&rpcpb.SubscribeResponse{Event: e, Cursor: c})
+		if err != nil {
+			ilog.Errorf("stream send failed. err=%v", err)
+			return err
+		}
+		cursor = c
+		return nil
+	}
+
+	replayed := int64(-1) // receipts of the blocks up to replayed have been sent
+	if replayReceipt {
+		replayed, err = as.replayReceipts(res.Context(), from, txIndex, index, filter, send)
+		if err != nil {
+			return err
 		}
 	}
 
@@ -460,36 +974,149 @@ func (as *APIService) Subscribe(req *rpcpb.SubscribeRequest, res rpcpb.ApiServic
 	ch := ec.Subscribe(id, topics, filter)
 	defer ec.Unsubscribe(id, topics)
 
-	timeup := time.NewTimer(time.Hour)
+	if replayReceipt {
+		// replays the blocks produced before subscribing
+		replayed, err = as.replayReceipts(res.Context(), replayed+1, 0, 0, filter, send)
+		if err != nil {
+			return err
+		}
+	}
+	if cursor == "" {
+		cursor = encodeEventCursor(as.lastBlockNumber(filter)+1, 0, 0)
+	}
+
 	for {
 		select {
-		case <-timeup.C:
-			return nil
 		case <-as.quitCh:
 			return nil
 		case <-res.Context().Done():
 			return res.Context().Err()
 		case ev := <-ch:
+			if ev.Topic == event.Overflow {
+				ilog.Debugf("subscription %d overflowed at block %d", id, ev.BlockNumber)
+				return res.Send(&rpcpb.SubscribeResponse{Cursor: cursor, Overflow: true})
+			}
+			if ev.Topic == event.ContractReceipt && ev.BlockNumber <= replayed {
+				continue
+			}
 			e := &rpcpb.Event{
-				Topic: rpcpb.Event_Topic(ev.Topic),
-				Data:  ev.Data,
-				Time:  ev.Time,
+				Topic:       rpcpb.Event_Topic(ev.Topic),
+				Data:        ev.Data,
+				Time:        ev.Time,
+				BlockNumber: ev.BlockNumber,
 			}
-			err := res.Send(&rpcpb.SubscribeResponse{Event: e})
-			if err != nil {
-				ilog.Errorf("stream send failed. err=%v", err)
+			// the position of a live event in its block is unknown, so resuming from
+			// the cursor replays the whole block.
+			if err := send(e, encodeEventCursor(ev.BlockNumber, 0, 0)); err != nil {
 				return err
 			}
 		}
 	}
 }
 
-func (as *APIService) getStateDBVisitor(longestChain bool) *database.Visitor {
+// lastBlockNumber returns the number of the last block whose receipts can be sent to the subscription.
+func (as *APIService) lastBlockNumber(filter *event.Meta) int64 {
+	if filter != nil && filter.Irreversible {
+		return as.bc.LinkedRoot().Head.Number
+	}
+	return as.bc.Head().Head.Number
+}
+
+// replayReceipts sends the stored contract receipts from the index-th receipt of the txIndex-th
+// transaction of block number until the last block. It returns the last replayed block number.
+func (as *APIService) replayReceipts(ctx context.Context, number, txIndex, index int64, filter *event.Meta, send func(*rpcpb.Event, string) error) (int64, error) {
+	from := number
+	for ; number <= as.lastBlockNumber(filter); number++ {
+		select {
+		case <-as.quitCh:
+			return 0, errors.New("server is stopped")
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		default:
+		}
+		blk, _, err := as.getBlockByNumber(number)
+		if err != nil {
+			return 0, fmt.Errorf("fail to get block %d, %v", number, err)
+		}
+		for i, r := range blk.Receipts {
+			if i >= len(blk.Txs) {
+				break
+			}
+			if number == from && int64(i) < txIndex {
+				continue
+			}
+			for j, rec := range r.Receipts {
+				if number == from && int64(i) == txIndex && int64(j) < index {
+					continue
+				}
+				if filter != nil && !filter.Match(event.ReceiptMeta(blk.Txs[i].Publisher, rec, filter.Irreversible)) {
+					continue
+				}
+				e := &rpcpb.Event{
+					Topic:       rpcpb.Event_CONTRACT_RECEIPT,
+					Data:        rec.Content,
+					Time:        blk.Head.Time,
+					BlockNumber: number,
+				}
+				if err := send(e, encodeEventCursor(number, int64(i), int64(j+1))); err != nil {
+					return 0, err
+				}
+			}
+		}
+	}
+	return number - 1, nil
+}
+
+func (as *APIService) getStateDB(longestChain bool) db.MVCCDB {
 	stateDB := as.bv.StateDB().Fork()
 	if longestChain {
 		stateDB.Checkout(string(as.bc.Head().HeadHash()))
 	} else {
 		stateDB.Checkout(string(as.bc.LinkedRoot().HeadHash()))
 	}
-	return database.NewVisitor(0, stateDB)
+	return stateDB
+}
+
+func (as *APIService) getStateDBVisitor(longestChain bool) *database.Visitor {
+	return database.NewVisitor(0, as.getStateDB(longestChain))
+}
+
+// getStateDBVisitorAt returns the state visitor at the block of the hash, or the number if
+// it's positive, together with the block head. The state of pending blocks is read from the
+// block cache, and that of irreversible blocks from the archive.
+func (as *APIService) getStateDBVisitorAt(longestChain bool, number int64, hash string) (*database.Visitor, *block.BlockHead, error) {
+	if hash == "" && number <= 0 {
+		node := as.bc.LinkedRoot()
+		if longestChain {
+			node = as.bc.Head()
+		}
+		stateDB := as.bv.StateDB().Fork()
+		stateDB.Checkout(string(node.HeadHash()))
+		return database.NewVisitor(0, stateDB), node.Head, nil
+	}
+	var (
+		blk *block.Block
+		err error
+	)
+	if hash != "" {
+		hashBytes := common.Base58Decode(hash)
+		blk, err = as.bc.GetBlockByHash(hashBytes)
+		if err != nil {
+			blk, err = as.blockchain.GetBlockByHash(hashBytes)
+		}
+	} else {
+		blk, _, err = as.getBlockByNumber(number)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("block not found: %v", err)
+	}
+	stateDB := as.bv.StateDB().Fork()
+	if stateDB.Checkout(string(blk.HeadHash())) {
+		return database.NewVisitor(0, stateDB), blk.Head, nil
+	}
+	state, err := as.bv.StateDB().StateAt(blk.Head.Number)
+	if err != nil {
+		return nil, nil, err
+	}
+	return database.NewVisitor(0, state), blk.Head, nil
 }
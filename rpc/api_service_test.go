@@ -0,0 +1,163 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/core/mocks"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/db"
+	"github.com/iost-official/go-iost/db/smt"
+	"github.com/iost-official/go-iost/rpc/pb"
+	"github.com/iost-official/go-iost/vm/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageFields(t *testing.T) {
+	dir, err := ioutil.TempDir("", "storage_fields")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	stateDB, err := db.NewMVCCDB(dir)
+	require.Nil(t, err)
+	defer stateDB.Close()
+
+	vi := database.NewVisitor(0, stateDB)
+	for _, f := range []string{"c", "a", "d", "b", "e", "y-h"} {
+		vi.MPut("Contractabc-users", f, database.MustMarshal(f))
+	}
+	vi.MPut("Contractabc-users-x", "f", database.MustMarshal("f"))
+	vi.MPut("Contractabc-usersx", "g", database.MustMarshal("g"))
+	vi.Commit()
+
+	res, err := storageFields(stateDB, "Contractabc", "users", "", 2)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"a", "b"}, res.Fields)
+	assert.Equal(t, "c", res.Cursor)
+
+	res, err = storageFields(stateDB, "Contractabc", "users", res.Cursor, 2)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"c", "d"}, res.Fields)
+
+	res, err = storageFields(stateDB, "Contractabc", "users", res.Cursor, 10)
+	require.Nil(t, err)
+	// the field of the map users-x isn't a field of users
+	assert.Equal(t, []string{"e", "y-h"}, res.Fields)
+	assert.Empty(t, res.Cursor)
+
+	res, err = storageFields(stateDB, "Contractabc", "users", "x-f", 10)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"y-h"}, res.Fields)
+
+	res, err = storageFields(stateDB, "Contractabc", "none", "", 10)
+	require.Nil(t, err)
+	assert.Empty(t, res.Fields)
+}
+
+func TestStateProof(t *testing.T) {
+	dir, err := ioutil.TempDir("", "state_proof")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	stateDB, err := db.NewMVCCDB(dir)
+	require.Nil(t, err)
+	defer stateDB.Close()
+
+	_, err = stateProof(stateDB, []byte("block"), "b-Contractabc-a")
+	assert.Equal(t, db.ErrStateRootDisabled, err)
+	require.Nil(t, stateDB.EnableStateRoot())
+	vi := database.NewVisitor(0, stateDB)
+	vi.Put("Contractabc-a", database.MustMarshal("a"))
+	vi.Commit()
+	stateDB.Tag("block")
+
+	for _, key := range []string{"b-Contractabc-a", "b-Contractabc-b"} {
+		res, err := stateProof(stateDB, []byte("block"), key)
+		require.Nil(t, err)
+		assert.Equal(t, common.Base58Encode([]byte("block")), res.BlockHash)
+		proof := &smt.Proof{
+			LeafKey:   common.Base58Decode(res.LeafKey),
+			LeafValue: common.Base58Decode(res.LeafValue),
+		}
+		for _, s := range res.Siblings {
+			proof.Siblings = append(proof.Siblings, common.Base58Decode(s))
+		}
+		var value []byte
+		if res.Exists {
+			value = []byte(res.Value)
+		}
+		assert.Nil(t, proof.Verify(common.Base58Decode(res.StateRoot), []byte(database.StateTable+"/"+key), value))
+	}
+}
+
+// headBlockCache is a block cache without pending blocks.
+type headBlockCache struct {
+	blockcache.BlockCache
+	head *blockcache.BlockCacheNode
+}
+
+func (bc *headBlockCache) Head() *blockcache.BlockCacheNode {
+	return bc.head
+}
+
+func (bc *headBlockCache) GetBlockByNumber(number int64) (*block.Block, error) {
+	return nil, errors.New("block not found")
+}
+
+type txsStream struct {
+	rpcpb.ApiService_GetTxsByBlockRangeServer
+	responses []*rpcpb.BlockTxResponse
+}
+
+func (s *txsStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *txsStream) Send(res *rpcpb.BlockTxResponse) error {
+	s.responses = append(s.responses, res)
+	return nil
+}
+
+func TestGetTxsByBlockRange(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	chain := core_mock.NewMockChain(ctl)
+	chain.EXPECT().GetBlockByNumber(gomock.Any()).AnyTimes().DoAndReturn(func(number int64) (*block.Block, error) {
+		blk := &block.Block{Head: &block.BlockHead{Number: number}}
+		if number == 1200 {
+			blk.Txs = append(blk.Txs, tx.NewTx(nil, nil, 1000, 1, 0, 0, 0))
+		}
+		blk.CalculateHeadHash()
+		return blk, nil
+	})
+	head := &block.Block{Head: &block.BlockHead{Number: 2500}}
+	as := &APIService{
+		bc:         &headBlockCache{head: blockcache.NewBCN(nil, head)},
+		blockchain: chain,
+		quitCh:     make(chan struct{}),
+	}
+
+	// the blocks without transactions are skipped by the cursor at the end of the stream
+	s := &txsStream{}
+	require.Nil(t, as.GetTxsByBlockRange(&rpcpb.GetTxsByBlockRangeRequest{}, s))
+	require.Len(t, s.responses, 1)
+	assert.Nil(t, s.responses[0].Transaction)
+	assert.Equal(t, "1000:0", s.responses[0].Cursor)
+
+	s = &txsStream{}
+	require.Nil(t, as.GetTxsByBlockRange(&rpcpb.GetTxsByBlockRangeRequest{Cursor: "1000:0"}, s))
+	require.Len(t, s.responses, 2)
+	assert.Equal(t, int64(1200), s.responses[0].BlockNumber)
+	assert.NotNil(t, s.responses[0].Transaction)
+	assert.Equal(t, "2000:0", s.responses[1].Cursor)
+
+	s = &txsStream{}
+	require.Nil(t, as.GetTxsByBlockRange(&rpcpb.GetTxsByBlockRangeRequest{Cursor: "2000:0"}, s))
+	assert.Empty(t, s.responses)
+}
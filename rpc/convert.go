@@ -49,6 +49,17 @@ func toPbAmountLimit(a *contract.Amount) *rpcpb.AmountLimit {
 	}
 }
 
+func toPbSignature(s *crypto.Signature) *rpcpb.Signature {
+	if s == nil {
+		return nil
+	}
+	return &rpcpb.Signature{
+		Algorithm: rpcpb.Signature_Algorithm(s.Algorithm),
+		Signature: s.Sig,
+		PublicKey: s.Pubkey,
+	}
+}
+
 func toPbTx(t *tx.Tx, tr *tx.TxReceipt) *rpcpb.Transaction {
 	ret := &rpcpb.Transaction{
 		Hash:       common.Base58Encode(t.Hash()),
@@ -84,6 +95,7 @@ func toPbBlock(blk *block.Block, complete bool) *rpcpb.Block {
 		Time:                blk.Head.Time,
 		GasUsage:            float64(blk.CalculateGasUsage()) / 100,
 		TxCount:             int64(len(blk.Txs)),
+		StateRoot:           common.Base58Encode(blk.Head.StateRoot),
 	}
 	var info verifier.Info
 	json.Unmarshal(blk.Head.Info, &info)
@@ -102,6 +114,23 @@ func toPbBlock(blk *block.Block, complete bool) *rpcpb.Block {
 	return ret
 }
 
+func toPbEquivocation(e *block.Evidence) *rpcpb.Equivocation {
+	ret := &rpcpb.Equivocation{
+		Witness: e.Witness(),
+		Reason:  e.Reason(),
+		Time:    e.Time,
+	}
+	for _, s := range []*block.SignedHead{e.First, e.Second} {
+		rawHead, _ := s.Head.Encode()
+		ret.Blocks = append(ret.Blocks, &rpcpb.SignedBlockHead{
+			Block:     toPbBlock(s.Block(), false),
+			Signature: toPbSignature(s.Sign),
+			RawHead:   rawHead,
+		})
+	}
+	return ret
+}
+
 func toPbItem(item *account.Item) *rpcpb.Account_Item {
 	return &rpcpb.Account_Item{
 		Id:         item.ID,
@@ -0,0 +1,86 @@
+package rpc
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/rpc/pb"
+	"github.com/iost-official/go-iost/vm/host"
+)
+
+// gasLimitMargin is the percentage of the suggested gas limit over the gas used.
+const gasLimitMargin = 120
+
+// suggestGasLimit returns the gas limit with safety margin, in units of 0.01 gas.
+func suggestGasLimit(gasUsage int64) int64 {
+	limit := (gasUsage*gasLimitMargin + 99) / 100
+	if limit < tx.MinGasLimit {
+		return tx.MinGasLimit
+	}
+	if limit > tx.MaxGasLimit {
+		return tx.MaxGasLimit
+	}
+	return limit
+}
+
+// requiredAmountLimit returns the amount of each token spent by accounts in the receipts,
+// which is checked against the amount limit of tx. Amounts are summed over all actions,
+// so the result is an upper bound of the amount limit.
+func requiredAmountLimit(receipts []*tx.Receipt, decimal func(token string) int) []*rpcpb.AmountLimit {
+	amounts := make(map[string]*common.Fixed)
+	for _, r := range receipts {
+		var args []interface{}
+		var token, from, value string
+		var ok bool
+		switch r.FuncName {
+		case "token.iost/transfer", "token.iost/transferFreeze":
+			if json.Unmarshal([]byte(r.Content), &args) != nil || len(args) < 4 {
+				continue
+			}
+			to, _ := args[2].(string)
+			from, _ = args[1].(string)
+			if from == to {
+				continue
+			}
+			value, ok = args[3].(string)
+		case "token.iost/destroy":
+			if json.Unmarshal([]byte(r.Content), &args) != nil || len(args) < 3 {
+				continue
+			}
+			from, _ = args[1].(string)
+			value, ok = args[2].(string)
+		default:
+			continue
+		}
+		token, _ = args[0].(string)
+		if !ok || token == "" || host.IsContract(from) {
+			continue
+		}
+		d := decimal(token)
+		if d < 0 {
+			continue
+		}
+		amount, err := common.NewFixed(value, d)
+		if err != nil {
+			continue
+		}
+		if a, exist := amounts[token]; exist {
+			amounts[token] = a.Add(amount)
+		} else {
+			amounts[token] = amount
+		}
+	}
+	ret := make([]*rpcpb.AmountLimit, 0, len(amounts))
+	for token, amount := range amounts {
+		ret = append(ret, &rpcpb.AmountLimit{
+			Token: token,
+			Value: amount.ToString(),
+		})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Token < ret[j].Token
+	})
+	return ret
+}
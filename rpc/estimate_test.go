@@ -0,0 +1,34 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/rpc/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuggestGasLimit(t *testing.T) {
+	assert.Equal(t, int64(tx.MinGasLimit), suggestGasLimit(1000))
+	assert.Equal(t, int64(1200002), suggestGasLimit(1000001))
+	assert.Equal(t, int64(tx.MaxGasLimit), suggestGasLimit(tx.MaxGasLimit))
+}
+
+func TestRequiredAmountLimit(t *testing.T) {
+	decimal := func(token string) int {
+		if token == "iost" {
+			return 8
+		}
+		return -1
+	}
+	receipts := []*tx.Receipt{
+		{FuncName: "token.iost/transfer", Content: `["iost","alice","bob","1.5",""]`},
+		{FuncName: "token.iost/transferFreeze", Content: `["iost","alice","bob","2",1,""]`},
+		{FuncName: "token.iost/transfer", Content: `["iost","alice","alice","100",""]`},
+		{FuncName: "token.iost/transfer", Content: `["iost","vote.iost","alice","100",""]`},
+		{FuncName: "token.iost/destroy", Content: `["iost","bob","0.5"]`},
+		{FuncName: "token.iost/transfer", Content: `["unknown","alice","bob","1",""]`},
+		{FuncName: "token.iost/issue", Content: `["iost","alice","100"]`},
+	}
+	assert.Equal(t, []*rpcpb.AmountLimit{{Token: "iost", Value: "4"}}, requiredAmountLimit(receipts, decimal))
+}
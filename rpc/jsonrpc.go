@@ -0,0 +1,459 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/gorilla/websocket"
+	"github.com/iost-official/go-iost/ilog"
+	"github.com/iost-official/go-iost/rpc/pb"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	jsonrpcVersion         = "2.0"
+	maxJSONRPCBodySize     = 5 * 1024 * 1024
+	maxJSONRPCBatchSize    = 100
+	maxJSONRPCConnRequests = 16 // the max number of in-flight requests of a websocket connection
+	jsonrpcNotifyMethod    = "subscription"
+	jsonrpcUnsubscribeName = "unsubscribe"
+)
+
+// Standard JSON-RPC 2.0 error codes.
+const (
+	jsonrpcParseError     = -32700
+	jsonrpcInvalidRequest = -32600
+	jsonrpcMethodNotFound = -32601
+	jsonrpcInvalidParams  = -32602
+	jsonrpcInternalError  = -32603
+	jsonrpcServerError    = -32000
+)
+
+var (
+	errStreamNeedsWebsocket = errors.New("streaming method is only available over websocket")
+	errBatchTooLarge        = errors.New("batch too large")
+	errEmptyBatch           = errors.New("empty batch")
+	errSubscriptionNotFound = errors.New("subscription not found")
+
+	contextType   = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorType     = reflect.TypeOf((*error)(nil)).Elem()
+	protoMsgType  = reflect.TypeOf((*proto.Message)(nil)).Elem()
+	nullJSONRPCID = json.RawMessage("null")
+)
+
+type jsonrpcRequest struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+func (r *jsonrpcRequest) isNotification() bool {
+	return r.ID == nil
+}
+
+type jsonrpcError struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+type jsonrpcResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *jsonrpcError   `json:"error,omitempty"`
+}
+
+type jsonrpcNotification struct {
+	Version string               `json:"jsonrpc"`
+	Method  string               `json:"method"`
+	Params  jsonrpcSubscribeData `json:"params"`
+}
+
+type jsonrpcSubscribeData struct {
+	Subscription string          `json:"subscription"`
+	Result       json.RawMessage `json:"result"`
+}
+
+func newJSONRPCError(id json.RawMessage, code int, err error) *jsonrpcResponse {
+	if id == nil {
+		id = nullJSONRPCID
+	}
+	e := &jsonrpcError{Code: code, Message: err.Error()}
+	if st, ok := status.FromError(err); ok && code == jsonrpcServerError {
+		e.Message = st.Message()
+		e.Data = st.Code().String()
+	}
+	return &jsonrpcResponse{Version: jsonrpcVersion, ID: id, Error: e}
+}
+
+// jsonrpcMethod is an ApiServiceClient method callable through JSON-RPC.
+type jsonrpcMethod struct {
+	fn      reflect.Value
+	reqType reflect.Type
+	stream  bool
+}
+
+// jsonrpcHandler serves JSON-RPC 2.0 requests over http and websocket by
+// forwarding them onto the grpc api service, just like the gateway does.
+type jsonrpcHandler struct {
+	methods   map[string]*jsonrpcMethod
+	marshaler *jsonpb.Marshaler
+	checker   func(r *http.Request) bool
+
+	// the open websocket connections, which are hijacked and not closed by http.Server.Shutdown
+	connsMu sync.Mutex
+	conns   map[*jsonrpcConn]struct{}
+	closed  bool
+}
+
+func newJSONRPCHandler(client rpcpb.ApiServiceClient, checkOrigin func(r *http.Request) bool) *jsonrpcHandler {
+	h := &jsonrpcHandler{
+		methods:   make(map[string]*jsonrpcMethod),
+		marshaler: &jsonpb.Marshaler{OrigName: true, EmitDefaults: true},
+		checker:   checkOrigin,
+		conns:     make(map[*jsonrpcConn]struct{}),
+	}
+	cv := reflect.ValueOf(client)
+	ct := reflect.TypeOf((*rpcpb.ApiServiceClient)(nil)).Elem()
+	for i := 0; i < ct.NumMethod(); i++ {
+		m := ct.Method(i)
+		// Every grpc client method looks like: func(context.Context, *Request, ...grpc.CallOption) (Response, error)
+		if m.Type.NumIn() != 3 || m.Type.NumOut() != 2 || m.Type.In(0) != contextType ||
+			!m.Type.In(1).Implements(protoMsgType) || m.Type.Out(1) != errorType {
+			continue
+		}
+		h.methods[jsonrpcMethodName(m.Name)] = &jsonrpcMethod{
+			fn:      cv.MethodByName(m.Name),
+			reqType: m.Type.In(1).Elem(),
+			stream:  !m.Type.Out(0).Implements(protoMsgType),
+		}
+	}
+	return h
+}
+
+// jsonrpcMethodName converts grpc method name to JSON-RPC method name, e.g. GetChainInfo -> getChainInfo.
+func jsonrpcMethodName(name string) string {
+	r, n := utf8.DecodeRuneInString(name)
+	return string(unicode.ToLower(r)) + name[n:]
+}
+
+func (h *jsonrpcHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if websocket.IsWebSocketUpgrade(r) {
+		h.serveWebsocket(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxJSONRPCBodySize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+	res := h.handleMessage(forwardClientInfo(r.Context(), r), body, nil)
+	if res == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(res)
+}
+
+// handleMessage processes a single request or a batch, and returns the encoded response.
+// It returns nil if nothing needs to be replied, i.e. notifications only.
+func (h *jsonrpcHandler) handleMessage(ctx context.Context, body []byte, conn *jsonrpcConn) []byte {
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '[' {
+		var batch []json.RawMessage
+		if err := json.Unmarshal(body, &batch); err != nil {
+			return h.encode(newJSONRPCError(nil, jsonrpcParseError, err))
+		}
+		if len(batch) == 0 {
+			return h.encode(newJSONRPCError(nil, jsonrpcInvalidRequest, errEmptyBatch))
+		}
+		if len(batch) > maxJSONRPCBatchSize {
+			return h.encode(newJSONRPCError(nil, jsonrpcInvalidRequest, errBatchTooLarge))
+		}
+		responses := make([]*jsonrpcResponse, len(batch))
+		var wg sync.WaitGroup
+		for i, msg := range batch {
+			wg.Add(1)
+			go func(i int, msg json.RawMessage) {
+				defer wg.Done()
+				responses[i] = h.handleRequest(ctx, msg, conn)
+			}(i, msg)
+		}
+		wg.Wait()
+		ret := make([]*jsonrpcResponse, 0, len(responses))
+		for _, res := range responses {
+			if res != nil {
+				ret = append(ret, res)
+			}
+		}
+		if len(ret) == 0 {
+			return nil
+		}
+		return h.encode(ret)
+	}
+	res := h.handleRequest(ctx, body, conn)
+	if res == nil {
+		return nil
+	}
+	return h.encode(res)
+}
+
+func (h *jsonrpcHandler) encode(v interface{}) []byte {
+	bytes, err := json.Marshal(v)
+	if err != nil {
+		ilog.Errorf("marshal jsonrpc response failed. err=%v", err)
+		bytes, _ = json.Marshal(newJSONRPCError(nil, jsonrpcInternalError, err))
+	}
+	return bytes
+}
+
+func (h *jsonrpcHandler) handleRequest(ctx context.Context, msg json.RawMessage, conn *jsonrpcConn) *jsonrpcResponse {
+	req := &jsonrpcRequest{}
+	if err := json.Unmarshal(msg, req); err != nil {
+		if _, ok := err.(*json.SyntaxError); ok {
+			return newJSONRPCError(nil, jsonrpcParseError, err)
+		}
+		return newJSONRPCError(nil, jsonrpcInvalidRequest, err)
+	}
+	if req.Version != jsonrpcVersion || req.Method == "" {
+		return newJSONRPCError(req.ID, jsonrpcInvalidRequest, errors.New("invalid jsonrpc request"))
+	}
+
+	result, code, err := h.call(ctx, req, conn)
+	if req.isNotification() {
+		return nil
+	}
+	if err != nil {
+		return newJSONRPCError(req.ID, code, err)
+	}
+	return &jsonrpcResponse{
+		Version: jsonrpcVersion,
+		ID:      req.ID,
+		Result:  result,
+	}
+}
+
+func (h *jsonrpcHandler) call(ctx context.Context, req *jsonrpcRequest, conn *jsonrpcConn) (json.RawMessage, int, error) {
+	if req.Method == jsonrpcUnsubscribeName && conn != nil {
+		var params []string
+		if err := json.Unmarshal(req.Params, &params); err != nil || len(params) != 1 {
+			return nil, jsonrpcInvalidParams, errors.New("unsubscribe expects one subscription id")
+		}
+		if !conn.unsubscribe(params[0]) {
+			return nil, jsonrpcServerError, errSubscriptionNotFound
+		}
+		return json.RawMessage("true"), 0, nil
+	}
+
+	m, ok := h.methods[req.Method]
+	if !ok {
+		return nil, jsonrpcMethodNotFound, errors.New("method not found: " + req.Method)
+	}
+	if m.stream && conn == nil {
+		return nil, jsonrpcInvalidRequest, errStreamNeedsWebsocket
+	}
+	param, err := h.parseParams(m.reqType, req.Params)
+	if err != nil {
+		return nil, jsonrpcInvalidParams, err
+	}
+
+	if m.stream {
+		id := conn.subscribe(ctx, m, param)
+		return json.RawMessage(strconv.Quote(id)), 0, nil
+	}
+
+	out := m.fn.Call([]reflect.Value{reflect.ValueOf(ctx), param})
+	if e := out[1].Interface(); e != nil {
+		return nil, jsonrpcServerError, e.(error)
+	}
+	result, err := h.marshaler.MarshalToString(out[0].Interface().(proto.Message))
+	if err != nil {
+		return nil, jsonrpcInternalError, err
+	}
+	return json.RawMessage(result), 0, nil
+}
+
+// parseParams decodes params into the grpc request message. Params can be given
+// either by-name as an object, or by-position as an array holding that object.
+func (h *jsonrpcHandler) parseParams(t reflect.Type, params json.RawMessage) (reflect.Value, error) {
+	param := reflect.New(t)
+	params = bytes.TrimSpace(params)
+	if len(params) == 0 || bytes.Equal(params, nullJSONRPCID) {
+		return param, nil
+	}
+	if params[0] == '[' {
+		var arr []json.RawMessage
+		if err := json.Unmarshal(params, &arr); err != nil {
+			return param, err
+		}
+		if len(arr) == 0 {
+			return param, nil
+		}
+		if len(arr) > 1 {
+			return param, errors.New("by-position params should contain exactly one object")
+		}
+		params = arr[0]
+	}
+	u := &jsonpb.Unmarshaler{}
+	if err := u.Unmarshal(bytes.NewReader(params), param.Interface().(proto.Message)); err != nil {
+		return param, err
+	}
+	return param, nil
+}
+
+func (h *jsonrpcHandler) serveWebsocket(w http.ResponseWriter, r *http.Request) {
+	upgrader := websocket.Upgrader{CheckOrigin: h.checker}
+	ws, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		ilog.Debugf("upgrade jsonrpc websocket failed. err=%v", err)
+		return
+	}
+	ctx, cancel := context.WithCancel(forwardClientInfo(context.Background(), r))
+	conn := &jsonrpcConn{
+		ws:      ws,
+		handler: h,
+		subs:    make(map[string]context.CancelFunc),
+	}
+	defer func() {
+		cancel()
+		ws.Close()
+	}()
+	if !h.addConn(conn) {
+		return
+	}
+	defer h.removeConn(conn)
+
+	ws.SetReadLimit(maxJSONRPCBodySize)
+	// the messages aren't read while the connection has too many in-flight requests
+	sem := make(chan struct{}, maxJSONRPCConnRequests)
+	for {
+		_, msg, err := ws.ReadMessage()
+		if err != nil {
+			return
+		}
+		sem <- struct{}{}
+		go func() {
+			defer func() { <-sem }()
+			if res := h.handleMessage(ctx, msg, conn); res != nil {
+				conn.write(res)
+			}
+		}()
+	}
+}
+
+// addConn tracks the websocket connection, it returns false if the handler is closed.
+func (h *jsonrpcHandler) addConn(conn *jsonrpcConn) bool {
+	h.connsMu.Lock()
+	defer h.connsMu.Unlock()
+	if h.closed {
+		return false
+	}
+	h.conns[conn] = struct{}{}
+	return true
+}
+
+func (h *jsonrpcHandler) removeConn(conn *jsonrpcConn) {
+	h.connsMu.Lock()
+	defer h.connsMu.Unlock()
+	delete(h.conns, conn)
+}
+
+// close closes the open websocket connections, whose subscriptions are cancelled then,
+// and rejects the new ones.
+func (h *jsonrpcHandler) close() {
+	h.connsMu.Lock()
+	defer h.connsMu.Unlock()
+	h.closed = true
+	for conn := range h.conns {
+		conn.ws.Close()
+	}
+}
+
+// jsonrpcConn is a websocket connection, which also carries the stream subscriptions.
+type jsonrpcConn struct {
+	ws      *websocket.Conn
+	handler *jsonrpcHandler
+
+	writeMu sync.Mutex
+
+	subID  uint64
+	subsMu sync.Mutex
+	subs   map[string]context.CancelFunc
+}
+
+func (c *jsonrpcConn) write(msg []byte) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	return c.ws.WriteMessage(websocket.TextMessage, msg)
+}
+
+func (c *jsonrpcConn) subscribe(ctx context.Context, m *jsonrpcMethod, param reflect.Value) string {
+	id := strconv.FormatUint(atomic.AddUint64(&c.subID, 1), 10)
+	ctx, cancel := context.WithCancel(ctx)
+	c.subsMu.Lock()
+	c.subs[id] = cancel
+	c.subsMu.Unlock()
+
+	go func() {
+		defer c.unsubscribe(id)
+		out := m.fn.Call([]reflect.Value{reflect.ValueOf(ctx), param})
+		if e := out[1].Interface(); e != nil {
+			ilog.Debugf("jsonrpc subscription failed. err=%v", e)
+			return
+		}
+		recv := out[0].MethodByName("Recv")
+		for {
+			ret := recv.Call(nil)
+			if e := ret[1].Interface(); e != nil {
+				return
+			}
+			result, err := c.handler.marshaler.MarshalToString(ret[0].Interface().(proto.Message))
+			if err != nil {
+				ilog.Errorf("marshal jsonrpc notification failed. err=%v", err)
+				return
+			}
+			notification := &jsonrpcNotification{
+				Version: jsonrpcVersion,
+				Method:  jsonrpcNotifyMethod,
+				Params: jsonrpcSubscribeData{
+					Subscription: id,
+					Result:       json.RawMessage(result),
+				},
+			}
+			if err := c.write(c.handler.encode(notification)); err != nil {
+				return
+			}
+		}
+	}()
+	return id
+}
+
+func (c *jsonrpcConn) unsubscribe(id string) bool {
+	c.subsMu.Lock()
+	defer c.subsMu.Unlock()
+	cancel, ok := c.subs[id]
+	if ok {
+		cancel()
+		delete(c.subs, id)
+	}
+	return ok
+}
@@ -0,0 +1,171 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/iost-official/go-iost/rpc/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeAPIClient struct {
+	rpcpb.ApiServiceClient
+}
+
+func (c *fakeAPIClient) GetChainInfo(ctx context.Context, in *rpcpb.EmptyRequest, opts ...grpc.CallOption) (*rpcpb.ChainInfoResponse, error) {
+	return &rpcpb.ChainInfoResponse{NetName: "debugnet", HeadBlock: 10}, nil
+}
+
+func (c *fakeAPIClient) GetBlockByNumber(ctx context.Context, in *rpcpb.GetBlockByNumberRequest, opts ...grpc.CallOption) (*rpcpb.BlockResponse, error) {
+	if in.Number > 10 {
+		return nil, status.Error(codes.NotFound, "block not found")
+	}
+	return &rpcpb.BlockResponse{Block: &rpcpb.Block{Number: in.Number}}, nil
+}
+
+func decodeResponses(t *testing.T, bytes []byte) []*jsonrpcResponse {
+	var ret []*jsonrpcResponse
+	if err := json.Unmarshal(bytes, &ret); err != nil {
+		res := &jsonrpcResponse{}
+		if err := json.Unmarshal(bytes, res); err != nil {
+			t.Fatal(err)
+		}
+		ret = append(ret, res)
+	}
+	return ret
+}
+
+func TestJSONRPCCall(t *testing.T) {
+	h := newJSONRPCHandler(&fakeAPIClient{}, nil)
+
+	res := decodeResponses(t, h.handleMessage(context.Background(), []byte(`{"jsonrpc":"2.0","id":1,"method":"getChainInfo"}`), nil))
+	assert.Len(t, res, 1)
+	assert.Nil(t, res[0].Error)
+	assert.Equal(t, "1", string(res[0].ID))
+	info := make(map[string]interface{})
+	assert.Nil(t, json.Unmarshal(res[0].Result, &info))
+	assert.Equal(t, "debugnet", info["net_name"])
+	assert.Equal(t, "10", info["head_block"])
+
+	res = decodeResponses(t, h.handleMessage(context.Background(), []byte(`{"jsonrpc":"2.0","id":"a","method":"getBlockByNumber","params":[{"number":11}]}`), nil))
+	assert.Equal(t, jsonrpcServerError, res[0].Error.Code)
+	assert.Equal(t, "block not found", res[0].Error.Message)
+	assert.Equal(t, "NotFound", res[0].Error.Data)
+
+	res = decodeResponses(t, h.handleMessage(context.Background(), []byte(`{"jsonrpc":"2.0","id":2,"method":"getNothing"}`), nil))
+	assert.Equal(t, jsonrpcMethodNotFound, res[0].Error.Code)
+
+	res = decodeResponses(t, h.handleMessage(context.Background(), []byte(`{"jsonrpc":"2.0","id":3,"method":"getBlockByNumber","params":{"number":"x"}}`), nil))
+	assert.Equal(t, jsonrpcInvalidParams, res[0].Error.Code)
+
+	res = decodeResponses(t, h.handleMessage(context.Background(), []byte(`{"jsonrpc":"2.0","id":4,"method":"subscribe"}`), nil))
+	assert.Equal(t, jsonrpcInvalidRequest, res[0].Error.Code)
+	assert.Equal(t, errStreamNeedsWebsocket.Error(), res[0].Error.Message)
+
+	res = decodeResponses(t, h.handleMessage(context.Background(), []byte(`{"jsonrpc":"2.0",`), nil))
+	assert.Equal(t, jsonrpcParseError, res[0].Error.Code)
+	assert.Equal(t, "null", string(res[0].ID))
+}
+
+func TestJSONRPCBatch(t *testing.T) {
+	h := newJSONRPCHandler(&fakeAPIClient{}, nil)
+
+	body := []byte(`[
+		{"jsonrpc":"2.0","id":1,"method":"getBlockByNumber","params":{"number":1,"complete":true}},
+		{"jsonrpc":"2.0","method":"getChainInfo"},
+		{"jsonrpc":"1.0","id":2,"method":"getChainInfo"},
+		{"jsonrpc":"2.0","id":3,"method":"getBlockByNumber","params":{"number":"2"}}
+	]`)
+	res := decodeResponses(t, h.handleMessage(context.Background(), body, nil))
+	assert.Len(t, res, 3)
+	assert.Equal(t, "1", string(res[0].ID))
+	assert.Nil(t, res[0].Error)
+	assert.Equal(t, "2", string(res[1].ID))
+	assert.Equal(t, jsonrpcInvalidRequest, res[1].Error.Code)
+	assert.Equal(t, "3", string(res[2].ID))
+	blk := &struct {
+		Block struct {
+			Number string `json:"number"`
+		} `json:"block"`
+	}{}
+	assert.Nil(t, json.Unmarshal(res[2].Result, blk))
+	assert.Equal(t, "2", blk.Block.Number)
+
+	assert.Nil(t, h.handleMessage(context.Background(), []byte(`[{"jsonrpc":"2.0","method":"getChainInfo"}]`), nil))
+
+	res = decodeResponses(t, h.handleMessage(context.Background(), []byte(`[]`), nil))
+	assert.Equal(t, jsonrpcInvalidRequest, res[0].Error.Code)
+	assert.Equal(t, errEmptyBatch.Error(), res[0].Error.Message)
+}
+
+func TestJSONRPCMethodName(t *testing.T) {
+	assert.Equal(t, "getChainInfo", jsonrpcMethodName("GetChainInfo"))
+	assert.Equal(t, "sendTransaction", jsonrpcMethodName("SendTransaction"))
+}
+
+// blockingAPIClient blocks the calls until release is closed, and counts the in-flight calls.
+type blockingAPIClient struct {
+	rpcpb.ApiServiceClient
+	inFlight int32
+	release  chan struct{}
+}
+
+func (c *blockingAPIClient) GetChainInfo(ctx context.Context, in *rpcpb.EmptyRequest, opts ...grpc.CallOption) (*rpcpb.ChainInfoResponse, error) {
+	atomic.AddInt32(&c.inFlight, 1)
+	defer atomic.AddInt32(&c.inFlight, -1)
+	<-c.release
+	return &rpcpb.ChainInfoResponse{NetName: "debugnet"}, nil
+}
+
+func TestJSONRPCWebsocket(t *testing.T) {
+	client := &blockingAPIClient{release: make(chan struct{})}
+	h := newJSONRPCHandler(client, nil)
+	server := httptest.NewServer(h)
+	defer server.Close()
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	ws, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.Nil(t, err)
+	defer ws.Close()
+
+	// the in-flight requests of a connection are limited
+	for i := 0; i < 2*maxJSONRPCConnRequests; i++ {
+		require.Nil(t, ws.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":1,"method":"getChainInfo"}`)))
+	}
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, int32(maxJSONRPCConnRequests), atomic.LoadInt32(&client.inFlight))
+	close(client.release)
+	for i := 0; i < 2*maxJSONRPCConnRequests; i++ {
+		_, msg, err := ws.ReadMessage()
+		require.Nil(t, err)
+		res := decodeResponses(t, msg)
+		assert.Nil(t, res[0].Error)
+	}
+
+	// the open connections are closed with the handler, and the new ones are rejected
+	h.close()
+	ws.SetReadDeadline(time.Now().Add(time.Second))
+	_, _, err = ws.ReadMessage()
+	require.NotNil(t, err)
+	nerr, ok := err.(net.Error)
+	assert.False(t, ok && nerr.Timeout())
+	ws2, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.Nil(t, err)
+	defer ws2.Close()
+	ws2.SetReadDeadline(time.Now().Add(time.Second))
+	_, _, err = ws2.ReadMessage()
+	require.NotNil(t, err)
+	nerr, ok = err.(net.Error)
+	assert.False(t, ok && nerr.Timeout())
+}
@@ -0,0 +1,90 @@
+package rpc
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/core/txpool"
+	"github.com/iost-official/go-iost/rpc/pb"
+)
+
+// pendingTxAgeBuckets is the upper bounds of the age histogram of pending txs.
+var pendingTxAgeBuckets = []time.Duration{
+	10 * time.Second,
+	time.Minute,
+	5 * time.Minute,
+	30 * time.Minute,
+}
+
+// pendingTxs returns the pending txs in the order of packing priority.
+func pendingTxs(m *txpool.SortedTxMap) []*tx.Tx {
+	txs := make([]*tx.Tx, 0, m.Size())
+	iter := m.Iter()
+	for t, ok := iter.Next(); ok; t, ok = iter.Next() {
+		txs = append(txs, t)
+	}
+	return txs
+}
+
+// matchPendingTx returns whether the tx is published by the publisher and calls the contract,
+// an empty filter matches all.
+func matchPendingTx(t *tx.Tx, publisher, contract string) bool {
+	if publisher != "" && t.Publisher != publisher {
+		return false
+	}
+	if contract == "" {
+		return true
+	}
+	for _, a := range t.Actions {
+		if a.Contract == contract {
+			return true
+		}
+	}
+	return false
+}
+
+// pendingTxStats returns the statistics of the pending txs at time now.
+func pendingTxStats(txs []*tx.Tx, now int64) *rpcpb.PendingTxStatsResponse {
+	res := &rpcpb.PendingTxStatsResponse{
+		Count: int64(len(txs)),
+	}
+	for _, b := range pendingTxAgeBuckets {
+		res.AgeHistogram = append(res.AgeHistogram, &rpcpb.PendingTxStatsResponse_AgeBucket{MaxAge: int64(b)})
+	}
+	res.AgeHistogram = append(res.AgeHistogram, &rpcpb.PendingTxStatsResponse_AgeBucket{})
+	for i, t := range txs {
+		ratio := float64(t.GasRatio) / 100
+		if i == 0 || ratio < res.MinGasRatio {
+			res.MinGasRatio = ratio
+		}
+		if i == 0 || ratio > res.MaxGasRatio {
+			res.MaxGasRatio = ratio
+		}
+		age := now - t.Time
+		bucket := len(pendingTxAgeBuckets)
+		for j, b := range pendingTxAgeBuckets {
+			if age <= int64(b) {
+				bucket = j
+				break
+			}
+		}
+		res.AgeHistogram[bucket].Count++
+	}
+	return res
+}
+
+// poolStatus returns the status of the tx in pending txs at time now, or nil if it isn't pending.
+func poolStatus(txs []*tx.Tx, hash []byte, now int64) *rpcpb.PoolStatus {
+	for i, t := range txs {
+		if bytes.Equal(t.Hash(), hash) {
+			return &rpcpb.PoolStatus{
+				Rank:         int64(i),
+				PoolSize:     int64(len(txs)),
+				Age:          now - t.Time,
+				WaitingDelay: t.IsDefer() && t.Time > now,
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package rpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/core/txpool"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPendingTxs(t *testing.T) {
+	now := time.Now().UnixNano()
+	newTx := func(publisher, contract string, gasRatio int64, age time.Duration) *tx.Tx {
+		t := tx.NewTx([]*tx.Action{tx.NewAction(contract, "hi", "[]")}, nil, 100000, gasRatio, now+int64(time.Hour), 0, 1024)
+		t.Publisher = publisher
+		t.Time = now - int64(age)
+		return t
+	}
+	txs := []*tx.Tx{
+		newTx("a", "token.iost", 100, 5*time.Second),
+		newTx("b", "token.iost", 300, time.Hour),
+		newTx("a", "ram.iost", 200, 2*time.Minute),
+		newTx("c", "token.iost", 100, 20*time.Second),
+	}
+	m := txpool.NewSortedTxMap()
+	for _, t := range txs {
+		m.Add(t)
+	}
+	pending := pendingTxs(m)
+	assert.Equal(t, []*tx.Tx{txs[1], txs[2], txs[3], txs[0]}, pending)
+
+	assert.True(t, matchPendingTx(txs[0], "", ""))
+	assert.True(t, matchPendingTx(txs[0], "a", "token.iost"))
+	assert.False(t, matchPendingTx(txs[0], "b", ""))
+	assert.False(t, matchPendingTx(txs[2], "", "token.iost"))
+
+	stats := pendingTxStats(pending, now)
+	assert.Equal(t, int64(4), stats.Count)
+	assert.Equal(t, 1.0, stats.MinGasRatio)
+	assert.Equal(t, 3.0, stats.MaxGasRatio)
+	counts := make([]int64, 0)
+	for _, b := range stats.AgeHistogram {
+		counts = append(counts, b.Count)
+	}
+	assert.Equal(t, []int64{1, 1, 1, 0, 1}, counts)
+	assert.Equal(t, int64(0), stats.AgeHistogram[len(stats.AgeHistogram)-1].MaxAge)
+
+	status := poolStatus(pending, txs[3].Hash(), now)
+	assert.Equal(t, int64(2), status.Rank)
+	assert.Equal(t, int64(4), status.PoolSize)
+	assert.Equal(t, int64(20*time.Second), status.Age)
+	assert.False(t, status.WaitingDelay)
+	assert.Nil(t, poolStatus(pending, []byte("not exist"), now))
+}
@@ -11,6 +11,7 @@ import (
 
 var (
 	requestCounter = metrics.NewCounter("iost_rpc_request", []string{"method"})
+	rejectCounter  = metrics.NewCounter("iost_rpc_reject", []string{"method", "reason"})
 )
 
 func metricsUnaryMiddleware(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
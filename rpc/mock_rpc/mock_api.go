@@ -34,6 +34,19 @@ func (m *MockApiServiceServer) EXPECT() *MockApiServiceServerMockRecorder {
 	return m.recorder
 }
 
+// EstimateResources mocks base method
+func (m *MockApiServiceServer) EstimateResources(arg0 context.Context, arg1 *pb.TransactionRequest) (*pb.EstimateResourcesResponse, error) {
+	ret := m.ctrl.Call(m, "EstimateResources", arg0, arg1)
+	ret0, _ := ret[0].(*pb.EstimateResourcesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateResources indicates an expected call of EstimateResources
+func (mr *MockApiServiceServerMockRecorder) EstimateResources(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateResources", reflect.TypeOf((*MockApiServiceServer)(nil).EstimateResources), arg0, arg1)
+}
+
 // ExecTransaction mocks base method
 func (m *MockApiServiceServer) ExecTransaction(arg0 context.Context, arg1 *pb.TransactionRequest) (*pb.TxReceipt, error) {
 	ret := m.ctrl.Call(m, "ExecTransaction", arg0, arg1)
@@ -86,6 +99,18 @@ func (mr *MockApiServiceServerMockRecorder) GetBlockByNumber(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockByNumber", reflect.TypeOf((*MockApiServiceServer)(nil).GetBlockByNumber), arg0, arg1)
 }
 
+// GetBlocksByRange mocks base method
+func (m *MockApiServiceServer) GetBlocksByRange(arg0 *pb.GetBlocksByRangeRequest, arg1 pb.ApiService_GetBlocksByRangeServer) error {
+	ret := m.ctrl.Call(m, "GetBlocksByRange", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetBlocksByRange indicates an expected call of GetBlocksByRange
+func (mr *MockApiServiceServerMockRecorder) GetBlocksByRange(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocksByRange", reflect.TypeOf((*MockApiServiceServer)(nil).GetBlocksByRange), arg0, arg1)
+}
+
 // GetChainInfo mocks base method
 func (m *MockApiServiceServer) GetChainInfo(arg0 context.Context, arg1 *pb.EmptyRequest) (*pb.ChainInfoResponse, error) {
 	ret := m.ctrl.Call(m, "GetChainInfo", arg0, arg1)
@@ -138,6 +163,19 @@ func (mr *MockApiServiceServerMockRecorder) GetContractStorageFields(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractStorageFields", reflect.TypeOf((*MockApiServiceServer)(nil).GetContractStorageFields), arg0, arg1)
 }
 
+// GetEquivocations mocks base method
+func (m *MockApiServiceServer) GetEquivocations(arg0 context.Context, arg1 *pb.GetEquivocationsRequest) (*pb.GetEquivocationsResponse, error) {
+	ret := m.ctrl.Call(m, "GetEquivocations", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetEquivocationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEquivocations indicates an expected call of GetEquivocations
+func (mr *MockApiServiceServerMockRecorder) GetEquivocations(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEquivocations", reflect.TypeOf((*MockApiServiceServer)(nil).GetEquivocations), arg0, arg1)
+}
+
 // GetGasRatio mocks base method
 func (m *MockApiServiceServer) GetGasRatio(arg0 context.Context, arg1 *pb.EmptyRequest) (*pb.GasRatioResponse, error) {
 	ret := m.ctrl.Call(m, "GetGasRatio", arg0, arg1)
@@ -164,6 +202,45 @@ func (mr *MockApiServiceServerMockRecorder) GetNodeInfo(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodeInfo", reflect.TypeOf((*MockApiServiceServer)(nil).GetNodeInfo), arg0, arg1)
 }
 
+// GetPendingTxStats mocks base method
+func (m *MockApiServiceServer) GetPendingTxStats(arg0 context.Context, arg1 *pb.EmptyRequest) (*pb.PendingTxStatsResponse, error) {
+	ret := m.ctrl.Call(m, "GetPendingTxStats", arg0, arg1)
+	ret0, _ := ret[0].(*pb.PendingTxStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingTxStats indicates an expected call of GetPendingTxStats
+func (mr *MockApiServiceServerMockRecorder) GetPendingTxStats(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingTxStats", reflect.TypeOf((*MockApiServiceServer)(nil).GetPendingTxStats), arg0, arg1)
+}
+
+// GetPendingTxs mocks base method
+func (m *MockApiServiceServer) GetPendingTxs(arg0 context.Context, arg1 *pb.GetPendingTxsRequest) (*pb.GetPendingTxsResponse, error) {
+	ret := m.ctrl.Call(m, "GetPendingTxs", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetPendingTxsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingTxs indicates an expected call of GetPendingTxs
+func (mr *MockApiServiceServerMockRecorder) GetPendingTxs(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingTxs", reflect.TypeOf((*MockApiServiceServer)(nil).GetPendingTxs), arg0, arg1)
+}
+
+// GetProducerSchedule mocks base method
+func (m *MockApiServiceServer) GetProducerSchedule(arg0 context.Context, arg1 *pb.EmptyRequest) (*pb.ProducerScheduleResponse, error) {
+	ret := m.ctrl.Call(m, "GetProducerSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*pb.ProducerScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProducerSchedule indicates an expected call of GetProducerSchedule
+func (mr *MockApiServiceServerMockRecorder) GetProducerSchedule(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProducerSchedule", reflect.TypeOf((*MockApiServiceServer)(nil).GetProducerSchedule), arg0, arg1)
+}
+
 // GetRAMInfo mocks base method
 func (m *MockApiServiceServer) GetRAMInfo(arg0 context.Context, arg1 *pb.EmptyRequest) (*pb.RAMInfoResponse, error) {
 	ret := m.ctrl.Call(m, "GetRAMInfo", arg0, arg1)
@@ -177,6 +254,32 @@ func (mr *MockApiServiceServerMockRecorder) GetRAMInfo(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRAMInfo", reflect.TypeOf((*MockApiServiceServer)(nil).GetRAMInfo), arg0, arg1)
 }
 
+// GetReceiptProof mocks base method
+func (m *MockApiServiceServer) GetReceiptProof(arg0 context.Context, arg1 *pb.TxHashRequest) (*pb.MerkleProofResponse, error) {
+	ret := m.ctrl.Call(m, "GetReceiptProof", arg0, arg1)
+	ret0, _ := ret[0].(*pb.MerkleProofResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReceiptProof indicates an expected call of GetReceiptProof
+func (mr *MockApiServiceServerMockRecorder) GetReceiptProof(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceiptProof", reflect.TypeOf((*MockApiServiceServer)(nil).GetReceiptProof), arg0, arg1)
+}
+
+// GetStateProof mocks base method
+func (m *MockApiServiceServer) GetStateProof(arg0 context.Context, arg1 *pb.GetStateProofRequest) (*pb.GetStateProofResponse, error) {
+	ret := m.ctrl.Call(m, "GetStateProof", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetStateProofResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStateProof indicates an expected call of GetStateProof
+func (mr *MockApiServiceServerMockRecorder) GetStateProof(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStateProof", reflect.TypeOf((*MockApiServiceServer)(nil).GetStateProof), arg0, arg1)
+}
+
 // GetToken721Balance mocks base method
 func (m *MockApiServiceServer) GetToken721Balance(arg0 context.Context, arg1 *pb.GetTokenBalanceRequest) (*pb.GetToken721BalanceResponse, error) {
 	ret := m.ctrl.Call(m, "GetToken721Balance", arg0, arg1)
@@ -242,6 +345,19 @@ func (mr *MockApiServiceServerMockRecorder) GetTxByHash(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxByHash", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxByHash), arg0, arg1)
 }
 
+// GetTxProof mocks base method
+func (m *MockApiServiceServer) GetTxProof(arg0 context.Context, arg1 *pb.TxHashRequest) (*pb.MerkleProofResponse, error) {
+	ret := m.ctrl.Call(m, "GetTxProof", arg0, arg1)
+	ret0, _ := ret[0].(*pb.MerkleProofResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxProof indicates an expected call of GetTxProof
+func (mr *MockApiServiceServerMockRecorder) GetTxProof(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxProof", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxProof), arg0, arg1)
+}
+
 // GetTxReceiptByTxHash mocks base method
 func (m *MockApiServiceServer) GetTxReceiptByTxHash(arg0 context.Context, arg1 *pb.TxHashRequest) (*pb.TxReceipt, error) {
 	ret := m.ctrl.Call(m, "GetTxReceiptByTxHash", arg0, arg1)
@@ -255,6 +371,31 @@ func (mr *MockApiServiceServerMockRecorder) GetTxReceiptByTxHash(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxReceiptByTxHash", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxReceiptByTxHash), arg0, arg1)
 }
 
+// GetTxsByAccount mocks base method
+func (m *MockApiServiceServer) GetTxsByAccount(arg0 context.Context, arg1 *pb.GetTxsByAccountRequest) (*pb.GetTxsByAccountResponse, error) {
+	ret := m.ctrl.Call(m, "GetTxsByAccount", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetTxsByAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxsByAccount indicates an expected call of GetTxsByAccount
+func (mr *MockApiServiceServerMockRecorder) GetTxsByAccount(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxsByAccount", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxsByAccount), arg0, arg1)
+}
+
+// GetTxsByBlockRange mocks base method
+func (m *MockApiServiceServer) GetTxsByBlockRange(arg0 *pb.GetTxsByBlockRangeRequest, arg1 pb.ApiService_GetTxsByBlockRangeServer) error {
+	ret := m.ctrl.Call(m, "GetTxsByBlockRange", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetTxsByBlockRange indicates an expected call of GetTxsByBlockRange
+func (mr *MockApiServiceServerMockRecorder) GetTxsByBlockRange(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxsByBlockRange", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxsByBlockRange), arg0, arg1)
+}
+
 // SendTransaction mocks base method
 func (m *MockApiServiceServer) SendTransaction(arg0 context.Context, arg1 *pb.TransactionRequest) (*pb.SendTransactionResponse, error) {
 	ret := m.ctrl.Call(m, "SendTransaction", arg0, arg1)
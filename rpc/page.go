@@ -0,0 +1,88 @@
+package rpc
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// The limits of range and paginated queries.
+const (
+	defaultBlocksLimit int64 = 100
+	maxBlocksLimit     int64 = 1000
+	defaultTxsLimit    int64 = 1000
+	maxTxsLimit        int64 = 10000
+	defaultPageLimit   int64 = 50
+	maxPageLimit       int64 = 1000
+	defaultFieldsLimit int64 = 1000
+	maxFieldsLimit     int64 = 10000
+)
+
+var (
+	errInvalidCursor = errors.New("invalid cursor")
+)
+
+func clampLimit(limit, defaultLimit, maxLimit int64) int64 {
+	if limit <= 0 {
+		return defaultLimit
+	}
+	if limit > maxLimit {
+		return maxLimit
+	}
+	return limit
+}
+
+// encodeTxCursor returns the cursor pointing at the index-th transaction of the block.
+func encodeTxCursor(number, index int64) string {
+	return strconv.FormatInt(number, 10) + ":" + strconv.FormatInt(index, 10)
+}
+
+func decodeTxCursor(cursor string) (number int64, index int64, err error) {
+	parts := strings.Split(cursor, ":")
+	if len(parts) != 2 {
+		return 0, 0, errInvalidCursor
+	}
+	number, err = strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || number < 0 {
+		return 0, 0, errInvalidCursor
+	}
+	index, err = strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || index < 0 {
+		return 0, 0, errInvalidCursor
+	}
+	return number, index, nil
+}
+
+// encodeEventCursor returns the cursor pointing at the index-th receipt of the tx-th transaction of the block.
+func encodeEventCursor(number, tx, index int64) string {
+	return encodeTxCursor(number, tx) + ":" + strconv.FormatInt(index, 10)
+}
+
+func decodeEventCursor(cursor string) (number int64, tx int64, index int64, err error) {
+	i := strings.LastIndex(cursor, ":")
+	if i < 0 {
+		return 0, 0, 0, errInvalidCursor
+	}
+	number, tx, err = decodeTxCursor(cursor[:i])
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	index, err = strconv.ParseInt(cursor[i+1:], 10, 64)
+	if err != nil || index < 0 {
+		return 0, 0, 0, errInvalidCursor
+	}
+	return number, tx, index, nil
+}
+
+// encodeOffsetCursor returns the cursor pointing at the offset-th item of a list.
+func encodeOffsetCursor(offset int64) string {
+	return strconv.FormatInt(offset, 10)
+}
+
+func decodeOffsetCursor(cursor string) (int64, error) {
+	offset, err := strconv.ParseInt(cursor, 10, 64)
+	if err != nil || offset < 0 {
+		return 0, errInvalidCursor
+	}
+	return offset, nil
+}
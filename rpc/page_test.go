@@ -0,0 +1,49 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxCursor(t *testing.T) {
+	number, index, err := decodeTxCursor(encodeTxCursor(12345, 67))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(12345), number)
+	assert.Equal(t, int64(67), index)
+
+	for _, c := range []string{"", "1", "1:2:3", "a:1", "1:-1"} {
+		_, _, err = decodeTxCursor(c)
+		assert.Equal(t, errInvalidCursor, err, c)
+	}
+}
+
+func TestEventCursor(t *testing.T) {
+	number, tx, index, err := decodeEventCursor(encodeEventCursor(12345, 67, 8))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(12345), number)
+	assert.Equal(t, int64(67), tx)
+	assert.Equal(t, int64(8), index)
+
+	for _, c := range []string{"", "1", "1:2", "1:2:3:4", "1:2:a", "1:2:-1"} {
+		_, _, _, err = decodeEventCursor(c)
+		assert.Equal(t, errInvalidCursor, err, c)
+	}
+}
+
+func TestClampLimit(t *testing.T) {
+	assert.Equal(t, defaultTxsLimit, clampLimit(0, defaultTxsLimit, maxTxsLimit))
+	assert.Equal(t, int64(10), clampLimit(10, defaultTxsLimit, maxTxsLimit))
+	assert.Equal(t, maxTxsLimit, clampLimit(maxTxsLimit+1, defaultTxsLimit, maxTxsLimit))
+}
+
+func TestOffsetCursor(t *testing.T) {
+	offset, err := decodeOffsetCursor(encodeOffsetCursor(100))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(100), offset)
+
+	for _, c := range []string{"", "a", "-1"} {
+		_, err = decodeOffsetCursor(c)
+		assert.Equal(t, errInvalidCursor, err, c)
+	}
+}
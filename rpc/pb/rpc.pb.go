@@ -140,7 +140,7 @@ func (x Signature_Algorithm) String() string {
 }
 
 func (Signature_Algorithm) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{10, 0}
+	return fileDescriptor_1b773bf3e696f610, []int{14, 0}
 }
 
 // The enumeration defines block status.
@@ -168,7 +168,7 @@ func (x BlockResponse_Status) String() string {
 }
 
 func (BlockResponse_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{13, 0}
+	return fileDescriptor_1b773bf3e696f610, []int{17, 0}
 }
 
 type Event_Topic int32
@@ -178,16 +178,32 @@ const (
 	Event_CONTRACT_RECEIPT Event_Topic = 0
 	// contract event
 	Event_CONTRACT_EVENT Event_Topic = 1
+	// new block linked to the chain, the data is the block head in json
+	Event_NEW_BLOCK Event_Topic = 2
+	// block becomes irreversible, the data is the block head in json
+	Event_IRREVERSIBLE_BLOCK Event_Topic = 3
+	// transaction dropped from the tx pool, the data is the tx hash and the reason in json
+	Event_TX_DROPPED Event_Topic = 4
+	// a witness signed two blocks of the same number or slot, the data is the blocks and the reason in json
+	Event_EQUIVOCATION Event_Topic = 5
 )
 
 var Event_Topic_name = map[int32]string{
 	0: "CONTRACT_RECEIPT",
 	1: "CONTRACT_EVENT",
+	2: "NEW_BLOCK",
+	3: "IRREVERSIBLE_BLOCK",
+	4: "TX_DROPPED",
+	5: "EQUIVOCATION",
 }
 
 var Event_Topic_value = map[string]int32{
-	"CONTRACT_RECEIPT": 0,
-	"CONTRACT_EVENT":   1,
+	"CONTRACT_RECEIPT":   0,
+	"CONTRACT_EVENT":     1,
+	"NEW_BLOCK":          2,
+	"IRREVERSIBLE_BLOCK": 3,
+	"TX_DROPPED":         4,
+	"EQUIVOCATION":       5,
 }
 
 func (x Event_Topic) String() string {
@@ -195,7 +211,7 @@ func (x Event_Topic) String() string {
 }
 
 func (Event_Topic) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{36, 0}
+	return fileDescriptor_1b773bf3e696f610, []int{54, 0}
 }
 
 // The message defines an empty request.
@@ -892,10 +908,12 @@ type TransactionResponse struct {
 	// transaction status
 	Status TransactionResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=rpcpb.TransactionResponse_Status" json:"status,omitempty"`
 	// transaction
-	Transaction          *Transaction `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Transaction *Transaction `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// the status in txpool, only set if the transaction is pending
+	PoolStatus           *PoolStatus `protobuf:"bytes,3,opt,name=pool_status,json=poolStatus,proto3" json:"pool_status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *TransactionResponse) Reset()         { *m = TransactionResponse{} }
@@ -937,6 +955,326 @@ func (m *TransactionResponse) GetTransaction() *Transaction {
 	return nil
 }
 
+func (m *TransactionResponse) GetPoolStatus() *PoolStatus {
+	if m != nil {
+		return m.PoolStatus
+	}
+	return nil
+}
+
+// The message defines the status of a pending transaction in txpool.
+type PoolStatus struct {
+	// the number of pending transactions ahead of it in the order of packing priority
+	Rank int64 `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	// the number of pending transactions
+	PoolSize int64 `protobuf:"varint,2,opt,name=pool_size,json=poolSize,proto3" json:"pool_size,omitempty"`
+	// the nanoseconds since the transaction time
+	Age int64 `protobuf:"varint,3,opt,name=age,proto3" json:"age,omitempty"`
+	// whether it's a deferred transaction waiting for its time
+	WaitingDelay         bool     `protobuf:"varint,4,opt,name=waiting_delay,json=waitingDelay,proto3" json:"waiting_delay,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PoolStatus) Reset()         { *m = PoolStatus{} }
+func (m *PoolStatus) String() string { return proto.CompactTextString(m) }
+func (*PoolStatus) ProtoMessage()    {}
+func (*PoolStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{10}
+}
+
+func (m *PoolStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PoolStatus.Unmarshal(m, b)
+}
+func (m *PoolStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PoolStatus.Marshal(b, m, deterministic)
+}
+func (m *PoolStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolStatus.Merge(m, src)
+}
+func (m *PoolStatus) XXX_Size() int {
+	return xxx_messageInfo_PoolStatus.Size(m)
+}
+func (m *PoolStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolStatus proto.InternalMessageInfo
+
+func (m *PoolStatus) GetRank() int64 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *PoolStatus) GetPoolSize() int64 {
+	if m != nil {
+		return m.PoolSize
+	}
+	return 0
+}
+
+func (m *PoolStatus) GetAge() int64 {
+	if m != nil {
+		return m.Age
+	}
+	return 0
+}
+
+func (m *PoolStatus) GetWaitingDelay() bool {
+	if m != nil {
+		return m.WaitingDelay
+	}
+	return false
+}
+
+// The request message containing the filters and pagination of pending transactions.
+type GetPendingTxsRequest struct {
+	// only return transactions published by the account if it's set
+	Publisher string `protobuf:"bytes,1,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	// only return transactions calling the contract if it's set
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// max number of transactions returned
+	Limit int64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// the cursor returned by the previous request
+	Cursor               string   `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPendingTxsRequest) Reset()         { *m = GetPendingTxsRequest{} }
+func (m *GetPendingTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPendingTxsRequest) ProtoMessage()    {}
+func (*GetPendingTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{11}
+}
+
+func (m *GetPendingTxsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPendingTxsRequest.Unmarshal(m, b)
+}
+func (m *GetPendingTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPendingTxsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPendingTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPendingTxsRequest.Merge(m, src)
+}
+func (m *GetPendingTxsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPendingTxsRequest.Size(m)
+}
+func (m *GetPendingTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPendingTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPendingTxsRequest proto.InternalMessageInfo
+
+func (m *GetPendingTxsRequest) GetPublisher() string {
+	if m != nil {
+		return m.Publisher
+	}
+	return ""
+}
+
+func (m *GetPendingTxsRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *GetPendingTxsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetPendingTxsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+// The message defines the pending transactions.
+type GetPendingTxsResponse struct {
+	// pending transactions in the order of packing priority
+	Transactions []*Transaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// the cursor of the next page, empty if there are no more transactions
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// the number of pending transactions matching the filters
+	Total                int64    `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPendingTxsResponse) Reset()         { *m = GetPendingTxsResponse{} }
+func (m *GetPendingTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPendingTxsResponse) ProtoMessage()    {}
+func (*GetPendingTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{12}
+}
+
+func (m *GetPendingTxsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPendingTxsResponse.Unmarshal(m, b)
+}
+func (m *GetPendingTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPendingTxsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPendingTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPendingTxsResponse.Merge(m, src)
+}
+func (m *GetPendingTxsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPendingTxsResponse.Size(m)
+}
+func (m *GetPendingTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPendingTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPendingTxsResponse proto.InternalMessageInfo
+
+func (m *GetPendingTxsResponse) GetTransactions() []*Transaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+func (m *GetPendingTxsResponse) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *GetPendingTxsResponse) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+// The message defines the statistics of pending transactions.
+type PendingTxStatsResponse struct {
+	// the number of pending transactions
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// the lowest gas ratio, transactions with a lower gas ratio are packed after all the pending ones
+	MinGasRatio float64 `protobuf:"fixed64,2,opt,name=min_gas_ratio,json=minGasRatio,proto3" json:"min_gas_ratio,omitempty"`
+	// the highest gas ratio
+	MaxGasRatio float64 `protobuf:"fixed64,3,opt,name=max_gas_ratio,json=maxGasRatio,proto3" json:"max_gas_ratio,omitempty"`
+	// the histogram of the ages of transactions
+	AgeHistogram         []*PendingTxStatsResponse_AgeBucket `protobuf:"bytes,4,rep,name=age_histogram,json=ageHistogram,proto3" json:"age_histogram,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *PendingTxStatsResponse) Reset()         { *m = PendingTxStatsResponse{} }
+func (m *PendingTxStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*PendingTxStatsResponse) ProtoMessage()    {}
+func (*PendingTxStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{13}
+}
+
+func (m *PendingTxStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingTxStatsResponse.Unmarshal(m, b)
+}
+func (m *PendingTxStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingTxStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *PendingTxStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTxStatsResponse.Merge(m, src)
+}
+func (m *PendingTxStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_PendingTxStatsResponse.Size(m)
+}
+func (m *PendingTxStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTxStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTxStatsResponse proto.InternalMessageInfo
+
+func (m *PendingTxStatsResponse) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *PendingTxStatsResponse) GetMinGasRatio() float64 {
+	if m != nil {
+		return m.MinGasRatio
+	}
+	return 0
+}
+
+func (m *PendingTxStatsResponse) GetMaxGasRatio() float64 {
+	if m != nil {
+		return m.MaxGasRatio
+	}
+	return 0
+}
+
+func (m *PendingTxStatsResponse) GetAgeHistogram() []*PendingTxStatsResponse_AgeBucket {
+	if m != nil {
+		return m.AgeHistogram
+	}
+	return nil
+}
+
+// The message defines a bucket of the age histogram.
+type PendingTxStatsResponse_AgeBucket struct {
+	// the upper bound of the age in nanoseconds, 0 means unbounded
+	MaxAge int64 `protobuf:"varint,1,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	// the number of transactions in the bucket
+	Count                int64    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingTxStatsResponse_AgeBucket) Reset()         { *m = PendingTxStatsResponse_AgeBucket{} }
+func (m *PendingTxStatsResponse_AgeBucket) String() string { return proto.CompactTextString(m) }
+func (*PendingTxStatsResponse_AgeBucket) ProtoMessage()    {}
+func (*PendingTxStatsResponse_AgeBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{13, 0}
+}
+
+func (m *PendingTxStatsResponse_AgeBucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingTxStatsResponse_AgeBucket.Unmarshal(m, b)
+}
+func (m *PendingTxStatsResponse_AgeBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingTxStatsResponse_AgeBucket.Marshal(b, m, deterministic)
+}
+func (m *PendingTxStatsResponse_AgeBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTxStatsResponse_AgeBucket.Merge(m, src)
+}
+func (m *PendingTxStatsResponse_AgeBucket) XXX_Size() int {
+	return xxx_messageInfo_PendingTxStatsResponse_AgeBucket.Size(m)
+}
+func (m *PendingTxStatsResponse_AgeBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTxStatsResponse_AgeBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTxStatsResponse_AgeBucket proto.InternalMessageInfo
+
+func (m *PendingTxStatsResponse_AgeBucket) GetMaxAge() int64 {
+	if m != nil {
+		return m.MaxAge
+	}
+	return 0
+}
+
+func (m *PendingTxStatsResponse_AgeBucket) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 // The message defines signature struct.
 type Signature struct {
 	// signature algorithm
@@ -954,7 +1292,7 @@ func (m *Signature) Reset()         { *m = Signature{} }
 func (m *Signature) String() string { return proto.CompactTextString(m) }
 func (*Signature) ProtoMessage()    {}
 func (*Signature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{10}
+	return fileDescriptor_1b773bf3e696f610, []int{14}
 }
 
 func (m *Signature) XXX_Unmarshal(b []byte) error {
@@ -1031,7 +1369,7 @@ func (m *TransactionRequest) Reset()         { *m = TransactionRequest{} }
 func (m *TransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*TransactionRequest) ProtoMessage()    {}
 func (*TransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{11}
+	return fileDescriptor_1b773bf3e696f610, []int{15}
 }
 
 func (m *TransactionRequest) XXX_Unmarshal(b []byte) error {
@@ -1161,17 +1499,19 @@ type Block struct {
 	// extra information
 	Info *Block_Info `protobuf:"bytes,11,opt,name=info,proto3" json:"info,omitempty"`
 	// block transactions
-	Transactions         []*Transaction `protobuf:"bytes,12,rep,name=transactions,proto3" json:"transactions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Transactions []*Transaction `protobuf:"bytes,12,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// state root after the block, empty if the producer doesn't compute it
+	StateRoot            string   `protobuf:"bytes,13,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Block) Reset()         { *m = Block{} }
 func (m *Block) String() string { return proto.CompactTextString(m) }
 func (*Block) ProtoMessage()    {}
 func (*Block) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{12}
+	return fileDescriptor_1b773bf3e696f610, []int{16}
 }
 
 func (m *Block) XXX_Unmarshal(b []byte) error {
@@ -1276,6 +1616,13 @@ func (m *Block) GetTransactions() []*Transaction {
 	return nil
 }
 
+func (m *Block) GetStateRoot() string {
+	if m != nil {
+		return m.StateRoot
+	}
+	return ""
+}
+
 // The message defines block extra information
 type Block_Info struct {
 	// pack mode
@@ -1293,7 +1640,7 @@ func (m *Block_Info) Reset()         { *m = Block_Info{} }
 func (m *Block_Info) String() string { return proto.CompactTextString(m) }
 func (*Block_Info) ProtoMessage()    {}
 func (*Block_Info) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{12, 0}
+	return fileDescriptor_1b773bf3e696f610, []int{16, 0}
 }
 
 func (m *Block_Info) XXX_Unmarshal(b []byte) error {
@@ -1349,7 +1696,7 @@ func (m *BlockResponse) Reset()         { *m = BlockResponse{} }
 func (m *BlockResponse) String() string { return proto.CompactTextString(m) }
 func (*BlockResponse) ProtoMessage()    {}
 func (*BlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{13}
+	return fileDescriptor_1b773bf3e696f610, []int{17}
 }
 
 func (m *BlockResponse) XXX_Unmarshal(b []byte) error {
@@ -1411,7 +1758,7 @@ func (m *ChainInfoResponse) Reset()         { *m = ChainInfoResponse{} }
 func (m *ChainInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*ChainInfoResponse) ProtoMessage()    {}
 func (*ChainInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{14}
+	return fileDescriptor_1b773bf3e696f610, []int{18}
 }
 
 func (m *ChainInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -1488,1229 +1835,2323 @@ func (m *ChainInfoResponse) GetWitnessList() []string {
 	return nil
 }
 
-// The request message containing the tx's hash.
-type TxHashRequest struct {
-	// tx hash
-	Hash                 string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+// The message defines producer schedule response.
+type ProducerScheduleResponse struct {
+	// the active witness list of the last irreversible block
+	ActiveWitnesses []string `protobuf:"bytes,1,rep,name=active_witnesses,json=activeWitnesses,proto3" json:"active_witnesses,omitempty"`
+	// the pending witness list, which becomes active after the block of pending_number is irreversible
+	PendingWitnesses []string `protobuf:"bytes,2,rep,name=pending_witnesses,json=pendingWitnesses,proto3" json:"pending_witnesses,omitempty"`
+	// the block number of the pending witness list
+	PendingNumber int64 `protobuf:"varint,3,opt,name=pending_number,json=pendingNumber,proto3" json:"pending_number,omitempty"`
+	// the slots of a round starting from the current one
+	Slots []*ProducerScheduleResponse_Slot `protobuf:"bytes,4,rep,name=slots,proto3" json:"slots,omitempty"`
+	// the confirming state of the witnesses in the schedule
+	Witnesses []*ProducerScheduleResponse_Witness `protobuf:"bytes,5,rep,name=witnesses,proto3" json:"witnesses,omitempty"`
+	// the blocks from the head block to the child of the last irreversible block
+	PendingBlocks []*ProducerScheduleResponse_PendingBlock `protobuf:"bytes,6,rep,name=pending_blocks,json=pendingBlocks,proto3" json:"pending_blocks,omitempty"`
+	// the confirmations for a block to become irreversible
+	ConfirmationsNeeded int64 `protobuf:"varint,7,opt,name=confirmations_needed,json=confirmationsNeeded,proto3" json:"confirmations_needed,omitempty"`
+	// head block height
+	HeadBlock int64 `protobuf:"varint,8,opt,name=head_block,json=headBlock,proto3" json:"head_block,omitempty"`
+	// last irreversible block number
+	LibBlock             int64    `protobuf:"varint,9,opt,name=lib_block,json=libBlock,proto3" json:"lib_block,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TxHashRequest) Reset()         { *m = TxHashRequest{} }
-func (m *TxHashRequest) String() string { return proto.CompactTextString(m) }
-func (*TxHashRequest) ProtoMessage()    {}
-func (*TxHashRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{15}
+func (m *ProducerScheduleResponse) Reset()         { *m = ProducerScheduleResponse{} }
+func (m *ProducerScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*ProducerScheduleResponse) ProtoMessage()    {}
+func (*ProducerScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{19}
 }
 
-func (m *TxHashRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TxHashRequest.Unmarshal(m, b)
+func (m *ProducerScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProducerScheduleResponse.Unmarshal(m, b)
 }
-func (m *TxHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TxHashRequest.Marshal(b, m, deterministic)
+func (m *ProducerScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProducerScheduleResponse.Marshal(b, m, deterministic)
 }
-func (m *TxHashRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TxHashRequest.Merge(m, src)
+func (m *ProducerScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProducerScheduleResponse.Merge(m, src)
 }
-func (m *TxHashRequest) XXX_Size() int {
-	return xxx_messageInfo_TxHashRequest.Size(m)
+func (m *ProducerScheduleResponse) XXX_Size() int {
+	return xxx_messageInfo_ProducerScheduleResponse.Size(m)
 }
-func (m *TxHashRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_TxHashRequest.DiscardUnknown(m)
+func (m *ProducerScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProducerScheduleResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TxHashRequest proto.InternalMessageInfo
+var xxx_messageInfo_ProducerScheduleResponse proto.InternalMessageInfo
 
-func (m *TxHashRequest) GetHash() string {
+func (m *ProducerScheduleResponse) GetActiveWitnesses() []string {
 	if m != nil {
-		return m.Hash
+		return m.ActiveWitnesses
 	}
-	return ""
+	return nil
 }
 
-// The request message containing the block's hash.
-type GetBlockByHashRequest struct {
-	// block hash
-	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	// complete means whether including the full transactions and transaction receipts
-	Complete             bool     `protobuf:"varint,2,opt,name=complete,proto3" json:"complete,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ProducerScheduleResponse) GetPendingWitnesses() []string {
+	if m != nil {
+		return m.PendingWitnesses
+	}
+	return nil
 }
 
-func (m *GetBlockByHashRequest) Reset()         { *m = GetBlockByHashRequest{} }
-func (m *GetBlockByHashRequest) String() string { return proto.CompactTextString(m) }
-func (*GetBlockByHashRequest) ProtoMessage()    {}
-func (*GetBlockByHashRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{16}
+func (m *ProducerScheduleResponse) GetPendingNumber() int64 {
+	if m != nil {
+		return m.PendingNumber
+	}
+	return 0
 }
 
-func (m *GetBlockByHashRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetBlockByHashRequest.Unmarshal(m, b)
-}
-func (m *GetBlockByHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetBlockByHashRequest.Marshal(b, m, deterministic)
+func (m *ProducerScheduleResponse) GetSlots() []*ProducerScheduleResponse_Slot {
+	if m != nil {
+		return m.Slots
+	}
+	return nil
 }
-func (m *GetBlockByHashRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetBlockByHashRequest.Merge(m, src)
+
+func (m *ProducerScheduleResponse) GetWitnesses() []*ProducerScheduleResponse_Witness {
+	if m != nil {
+		return m.Witnesses
+	}
+	return nil
 }
-func (m *GetBlockByHashRequest) XXX_Size() int {
-	return xxx_messageInfo_GetBlockByHashRequest.Size(m)
-}
-func (m *GetBlockByHashRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetBlockByHashRequest.DiscardUnknown(m)
+
+func (m *ProducerScheduleResponse) GetPendingBlocks() []*ProducerScheduleResponse_PendingBlock {
+	if m != nil {
+		return m.PendingBlocks
+	}
+	return nil
 }
 
-var xxx_messageInfo_GetBlockByHashRequest proto.InternalMessageInfo
+func (m *ProducerScheduleResponse) GetConfirmationsNeeded() int64 {
+	if m != nil {
+		return m.ConfirmationsNeeded
+	}
+	return 0
+}
 
-func (m *GetBlockByHashRequest) GetHash() string {
+func (m *ProducerScheduleResponse) GetHeadBlock() int64 {
 	if m != nil {
-		return m.Hash
+		return m.HeadBlock
 	}
-	return ""
+	return 0
 }
 
-func (m *GetBlockByHashRequest) GetComplete() bool {
+func (m *ProducerScheduleResponse) GetLibBlock() int64 {
 	if m != nil {
-		return m.Complete
+		return m.LibBlock
 	}
-	return false
+	return 0
 }
 
-// The request message containing the block's number.
-type GetBlockByNumberRequest struct {
-	// block number
-	Number int64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
-	// complete means whether including the full transactions and transaction receipts
-	Complete             bool     `protobuf:"varint,2,opt,name=complete,proto3" json:"complete,omitempty"`
+// The message defines a slot of a witness.
+type ProducerScheduleResponse_Slot struct {
+	// slot number
+	Slot int64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	// the start time of the slot in nanoseconds
+	Time int64 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	// the witness producing in the slot
+	Witness              string   `protobuf:"bytes,3,opt,name=witness,proto3" json:"witness,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetBlockByNumberRequest) Reset()         { *m = GetBlockByNumberRequest{} }
-func (m *GetBlockByNumberRequest) String() string { return proto.CompactTextString(m) }
-func (*GetBlockByNumberRequest) ProtoMessage()    {}
-func (*GetBlockByNumberRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{17}
+func (m *ProducerScheduleResponse_Slot) Reset()         { *m = ProducerScheduleResponse_Slot{} }
+func (m *ProducerScheduleResponse_Slot) String() string { return proto.CompactTextString(m) }
+func (*ProducerScheduleResponse_Slot) ProtoMessage()    {}
+func (*ProducerScheduleResponse_Slot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{19, 0}
 }
 
-func (m *GetBlockByNumberRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetBlockByNumberRequest.Unmarshal(m, b)
+func (m *ProducerScheduleResponse_Slot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProducerScheduleResponse_Slot.Unmarshal(m, b)
 }
-func (m *GetBlockByNumberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetBlockByNumberRequest.Marshal(b, m, deterministic)
+func (m *ProducerScheduleResponse_Slot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProducerScheduleResponse_Slot.Marshal(b, m, deterministic)
 }
-func (m *GetBlockByNumberRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetBlockByNumberRequest.Merge(m, src)
+func (m *ProducerScheduleResponse_Slot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProducerScheduleResponse_Slot.Merge(m, src)
 }
-func (m *GetBlockByNumberRequest) XXX_Size() int {
-	return xxx_messageInfo_GetBlockByNumberRequest.Size(m)
+func (m *ProducerScheduleResponse_Slot) XXX_Size() int {
+	return xxx_messageInfo_ProducerScheduleResponse_Slot.Size(m)
 }
-func (m *GetBlockByNumberRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetBlockByNumberRequest.DiscardUnknown(m)
+func (m *ProducerScheduleResponse_Slot) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProducerScheduleResponse_Slot.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetBlockByNumberRequest proto.InternalMessageInfo
+var xxx_messageInfo_ProducerScheduleResponse_Slot proto.InternalMessageInfo
 
-func (m *GetBlockByNumberRequest) GetNumber() int64 {
+func (m *ProducerScheduleResponse_Slot) GetSlot() int64 {
 	if m != nil {
-		return m.Number
+		return m.Slot
 	}
 	return 0
 }
 
-func (m *GetBlockByNumberRequest) GetComplete() bool {
+func (m *ProducerScheduleResponse_Slot) GetTime() int64 {
 	if m != nil {
-		return m.Complete
+		return m.Time
 	}
-	return false
+	return 0
 }
 
-// The message defines the account's frozen balance.
-type FrozenBalance struct {
-	// balance amount
-	Amount float64 `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	// free time
-	Time                 int64    `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+func (m *ProducerScheduleResponse_Slot) GetWitness() string {
+	if m != nil {
+		return m.Witness
+	}
+	return ""
+}
+
+// The message defines the confirming state of a witness.
+type ProducerScheduleResponse_Witness struct {
+	// witness public key
+	Witness string `protobuf:"bytes,1,opt,name=witness,proto3" json:"witness,omitempty"`
+	// the number after the last block the witness produced
+	Watermark int64 `protobuf:"varint,2,opt,name=watermark,proto3" json:"watermark,omitempty"`
+	// the last block of the witness after the last irreversible block on the head chain, -1 if there is none
+	LastBlock int64 `protobuf:"varint,3,opt,name=last_block,json=lastBlock,proto3" json:"last_block,omitempty"`
+	// the last block confirms the blocks from confirm_until to itself
+	ConfirmUntil         int64    `protobuf:"varint,4,opt,name=confirm_until,json=confirmUntil,proto3" json:"confirm_until,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FrozenBalance) Reset()         { *m = FrozenBalance{} }
-func (m *FrozenBalance) String() string { return proto.CompactTextString(m) }
-func (*FrozenBalance) ProtoMessage()    {}
-func (*FrozenBalance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{18}
+func (m *ProducerScheduleResponse_Witness) Reset()         { *m = ProducerScheduleResponse_Witness{} }
+func (m *ProducerScheduleResponse_Witness) String() string { return proto.CompactTextString(m) }
+func (*ProducerScheduleResponse_Witness) ProtoMessage()    {}
+func (*ProducerScheduleResponse_Witness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{19, 1}
 }
 
-func (m *FrozenBalance) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FrozenBalance.Unmarshal(m, b)
+func (m *ProducerScheduleResponse_Witness) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProducerScheduleResponse_Witness.Unmarshal(m, b)
 }
-func (m *FrozenBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FrozenBalance.Marshal(b, m, deterministic)
+func (m *ProducerScheduleResponse_Witness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProducerScheduleResponse_Witness.Marshal(b, m, deterministic)
 }
-func (m *FrozenBalance) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FrozenBalance.Merge(m, src)
+func (m *ProducerScheduleResponse_Witness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProducerScheduleResponse_Witness.Merge(m, src)
 }
-func (m *FrozenBalance) XXX_Size() int {
-	return xxx_messageInfo_FrozenBalance.Size(m)
+func (m *ProducerScheduleResponse_Witness) XXX_Size() int {
+	return xxx_messageInfo_ProducerScheduleResponse_Witness.Size(m)
 }
-func (m *FrozenBalance) XXX_DiscardUnknown() {
-	xxx_messageInfo_FrozenBalance.DiscardUnknown(m)
+func (m *ProducerScheduleResponse_Witness) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProducerScheduleResponse_Witness.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FrozenBalance proto.InternalMessageInfo
+var xxx_messageInfo_ProducerScheduleResponse_Witness proto.InternalMessageInfo
 
-func (m *FrozenBalance) GetAmount() float64 {
+func (m *ProducerScheduleResponse_Witness) GetWitness() string {
 	if m != nil {
-		return m.Amount
+		return m.Witness
+	}
+	return ""
+}
+
+func (m *ProducerScheduleResponse_Witness) GetWatermark() int64 {
+	if m != nil {
+		return m.Watermark
 	}
 	return 0
 }
 
-func (m *FrozenBalance) GetTime() int64 {
+func (m *ProducerScheduleResponse_Witness) GetLastBlock() int64 {
 	if m != nil {
-		return m.Time
+		return m.LastBlock
 	}
 	return 0
 }
 
-// The message defines the account's vote info.
-type VoteInfo struct {
-	// option name
-	Option string `protobuf:"bytes,1,opt,name=option,proto3" json:"option,omitempty"`
-	// votes
-	Votes string `protobuf:"bytes,2,opt,name=votes,proto3" json:"votes,omitempty"`
-	// cleared votes
-	ClearedVotes         string   `protobuf:"bytes,3,opt,name=cleared_votes,json=clearedVotes,proto3" json:"cleared_votes,omitempty"`
+func (m *ProducerScheduleResponse_Witness) GetConfirmUntil() int64 {
+	if m != nil {
+		return m.ConfirmUntil
+	}
+	return 0
+}
+
+// The message defines a block which isn't irreversible.
+type ProducerScheduleResponse_PendingBlock struct {
+	// block number
+	Number int64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// block hash
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// block producer witness
+	Witness string `protobuf:"bytes,3,opt,name=witness,proto3" json:"witness,omitempty"`
+	// the block confirms the blocks from confirm_until to itself
+	ConfirmUntil int64 `protobuf:"varint,4,opt,name=confirm_until,json=confirmUntil,proto3" json:"confirm_until,omitempty"`
+	// the number of the blocks confirming the block
+	Confirmations        int64    `protobuf:"varint,5,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *VoteInfo) Reset()         { *m = VoteInfo{} }
-func (m *VoteInfo) String() string { return proto.CompactTextString(m) }
-func (*VoteInfo) ProtoMessage()    {}
-func (*VoteInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{19}
+func (m *ProducerScheduleResponse_PendingBlock) Reset()         { *m = ProducerScheduleResponse_PendingBlock{} }
+func (m *ProducerScheduleResponse_PendingBlock) String() string { return proto.CompactTextString(m) }
+func (*ProducerScheduleResponse_PendingBlock) ProtoMessage()    {}
+func (*ProducerScheduleResponse_PendingBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{19, 2}
 }
 
-func (m *VoteInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VoteInfo.Unmarshal(m, b)
+func (m *ProducerScheduleResponse_PendingBlock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProducerScheduleResponse_PendingBlock.Unmarshal(m, b)
 }
-func (m *VoteInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VoteInfo.Marshal(b, m, deterministic)
+func (m *ProducerScheduleResponse_PendingBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProducerScheduleResponse_PendingBlock.Marshal(b, m, deterministic)
 }
-func (m *VoteInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VoteInfo.Merge(m, src)
+func (m *ProducerScheduleResponse_PendingBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProducerScheduleResponse_PendingBlock.Merge(m, src)
 }
-func (m *VoteInfo) XXX_Size() int {
-	return xxx_messageInfo_VoteInfo.Size(m)
+func (m *ProducerScheduleResponse_PendingBlock) XXX_Size() int {
+	return xxx_messageInfo_ProducerScheduleResponse_PendingBlock.Size(m)
 }
-func (m *VoteInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_VoteInfo.DiscardUnknown(m)
+func (m *ProducerScheduleResponse_PendingBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProducerScheduleResponse_PendingBlock.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VoteInfo proto.InternalMessageInfo
+var xxx_messageInfo_ProducerScheduleResponse_PendingBlock proto.InternalMessageInfo
 
-func (m *VoteInfo) GetOption() string {
+func (m *ProducerScheduleResponse_PendingBlock) GetNumber() int64 {
 	if m != nil {
-		return m.Option
+		return m.Number
 	}
-	return ""
+	return 0
 }
 
-func (m *VoteInfo) GetVotes() string {
+func (m *ProducerScheduleResponse_PendingBlock) GetHash() string {
 	if m != nil {
-		return m.Votes
+		return m.Hash
 	}
 	return ""
 }
 
-func (m *VoteInfo) GetClearedVotes() string {
+func (m *ProducerScheduleResponse_PendingBlock) GetWitness() string {
 	if m != nil {
-		return m.ClearedVotes
+		return m.Witness
 	}
 	return ""
 }
 
-type GasRatioResponse struct {
-	// lowest gas ratio in head block
-	LowestGasRatio float64 `protobuf:"fixed64,1,opt,name=lowest_gas_ratio,json=lowestGasRatio,proto3" json:"lowest_gas_ratio,omitempty"`
-	// median gas ratio in head block
-	MedianGasRatio       float64  `protobuf:"fixed64,2,opt,name=median_gas_ratio,json=medianGasRatio,proto3" json:"median_gas_ratio,omitempty"`
+func (m *ProducerScheduleResponse_PendingBlock) GetConfirmUntil() int64 {
+	if m != nil {
+		return m.ConfirmUntil
+	}
+	return 0
+}
+
+func (m *ProducerScheduleResponse_PendingBlock) GetConfirmations() int64 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+// The request message containing the tx's hash.
+type TxHashRequest struct {
+	// tx hash
+	Hash                 string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GasRatioResponse) Reset()         { *m = GasRatioResponse{} }
-func (m *GasRatioResponse) String() string { return proto.CompactTextString(m) }
-func (*GasRatioResponse) ProtoMessage()    {}
-func (*GasRatioResponse) Descriptor() ([]byte, []int) {
+func (m *TxHashRequest) Reset()         { *m = TxHashRequest{} }
+func (m *TxHashRequest) String() string { return proto.CompactTextString(m) }
+func (*TxHashRequest) ProtoMessage()    {}
+func (*TxHashRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1b773bf3e696f610, []int{20}
 }
 
-func (m *GasRatioResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GasRatioResponse.Unmarshal(m, b)
+func (m *TxHashRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxHashRequest.Unmarshal(m, b)
 }
-func (m *GasRatioResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GasRatioResponse.Marshal(b, m, deterministic)
+func (m *TxHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxHashRequest.Marshal(b, m, deterministic)
 }
-func (m *GasRatioResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GasRatioResponse.Merge(m, src)
+func (m *TxHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxHashRequest.Merge(m, src)
 }
-func (m *GasRatioResponse) XXX_Size() int {
-	return xxx_messageInfo_GasRatioResponse.Size(m)
+func (m *TxHashRequest) XXX_Size() int {
+	return xxx_messageInfo_TxHashRequest.Size(m)
 }
-func (m *GasRatioResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GasRatioResponse.DiscardUnknown(m)
+func (m *TxHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxHashRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GasRatioResponse proto.InternalMessageInfo
-
-func (m *GasRatioResponse) GetLowestGasRatio() float64 {
-	if m != nil {
-		return m.LowestGasRatio
-	}
-	return 0
-}
+var xxx_messageInfo_TxHashRequest proto.InternalMessageInfo
 
-func (m *GasRatioResponse) GetMedianGasRatio() float64 {
+func (m *TxHashRequest) GetHash() string {
 	if m != nil {
-		return m.MedianGasRatio
+		return m.Hash
 	}
-	return 0
+	return ""
 }
 
-// The message defines account struct.
-type Account struct {
-	// account name
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// account balance
-	Balance float64 `protobuf:"fixed64,2,opt,name=balance,proto3" json:"balance,omitempty"`
-	// gas information
-	GasInfo *Account_GasInfo `protobuf:"bytes,3,opt,name=gas_info,json=gasInfo,proto3" json:"gas_info,omitempty"`
-	// ram information
-	RamInfo *Account_RAMInfo `protobuf:"bytes,4,opt,name=ram_info,json=ramInfo,proto3" json:"ram_info,omitempty"`
-	// account permission
-	Permissions map[string]*Account_Permission `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// account groups
-	Groups map[string]*Account_Group `protobuf:"bytes,6,rep,name=groups,proto3" json:"groups,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// frozen balance information
-	FrozenBalances []*FrozenBalance `protobuf:"bytes,7,rep,name=frozen_balances,json=frozenBalances,proto3" json:"frozen_balances,omitempty"`
-	// vote information
-	VoteInfos            []*VoteInfo `protobuf:"bytes,8,rep,name=vote_infos,json=voteInfos,proto3" json:"vote_infos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+// The request message containing the block's hash.
+type GetBlockByHashRequest struct {
+	// block hash
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// complete means whether including the full transactions and transaction receipts
+	Complete             bool     `protobuf:"varint,2,opt,name=complete,proto3" json:"complete,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Account) Reset()         { *m = Account{} }
-func (m *Account) String() string { return proto.CompactTextString(m) }
-func (*Account) ProtoMessage()    {}
-func (*Account) Descriptor() ([]byte, []int) {
+func (m *GetBlockByHashRequest) Reset()         { *m = GetBlockByHashRequest{} }
+func (m *GetBlockByHashRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockByHashRequest) ProtoMessage()    {}
+func (*GetBlockByHashRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1b773bf3e696f610, []int{21}
 }
 
-func (m *Account) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Account.Unmarshal(m, b)
+func (m *GetBlockByHashRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlockByHashRequest.Unmarshal(m, b)
 }
-func (m *Account) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Account.Marshal(b, m, deterministic)
+func (m *GetBlockByHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlockByHashRequest.Marshal(b, m, deterministic)
 }
-func (m *Account) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Account.Merge(m, src)
+func (m *GetBlockByHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockByHashRequest.Merge(m, src)
 }
-func (m *Account) XXX_Size() int {
-	return xxx_messageInfo_Account.Size(m)
+func (m *GetBlockByHashRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBlockByHashRequest.Size(m)
 }
-func (m *Account) XXX_DiscardUnknown() {
-	xxx_messageInfo_Account.DiscardUnknown(m)
+func (m *GetBlockByHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockByHashRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Account proto.InternalMessageInfo
+var xxx_messageInfo_GetBlockByHashRequest proto.InternalMessageInfo
 
-func (m *Account) GetName() string {
+func (m *GetBlockByHashRequest) GetHash() string {
 	if m != nil {
-		return m.Name
+		return m.Hash
 	}
 	return ""
 }
 
-func (m *Account) GetBalance() float64 {
+func (m *GetBlockByHashRequest) GetComplete() bool {
 	if m != nil {
-		return m.Balance
+		return m.Complete
 	}
-	return 0
+	return false
 }
 
-func (m *Account) GetGasInfo() *Account_GasInfo {
+// The request message containing the block's number.
+type GetBlockByNumberRequest struct {
+	// block number
+	Number int64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// complete means whether including the full transactions and transaction receipts
+	Complete             bool     `protobuf:"varint,2,opt,name=complete,proto3" json:"complete,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBlockByNumberRequest) Reset()         { *m = GetBlockByNumberRequest{} }
+func (m *GetBlockByNumberRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockByNumberRequest) ProtoMessage()    {}
+func (*GetBlockByNumberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{22}
+}
+
+func (m *GetBlockByNumberRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlockByNumberRequest.Unmarshal(m, b)
+}
+func (m *GetBlockByNumberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlockByNumberRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBlockByNumberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockByNumberRequest.Merge(m, src)
+}
+func (m *GetBlockByNumberRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBlockByNumberRequest.Size(m)
+}
+func (m *GetBlockByNumberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockByNumberRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockByNumberRequest proto.InternalMessageInfo
+
+func (m *GetBlockByNumberRequest) GetNumber() int64 {
 	if m != nil {
-		return m.GasInfo
+		return m.Number
 	}
-	return nil
+	return 0
 }
 
-func (m *Account) GetRamInfo() *Account_RAMInfo {
+func (m *GetBlockByNumberRequest) GetComplete() bool {
 	if m != nil {
-		return m.RamInfo
+		return m.Complete
 	}
-	return nil
+	return false
 }
 
-func (m *Account) GetPermissions() map[string]*Account_Permission {
+// The request message containing the block range.
+type GetBlocksByRangeRequest struct {
+	// the first block number
+	StartNumber int64 `protobuf:"varint,1,opt,name=start_number,json=startNumber,proto3" json:"start_number,omitempty"`
+	// the last block number, the head block is used if it's 0 or beyond the head block
+	EndNumber int64 `protobuf:"varint,2,opt,name=end_number,json=endNumber,proto3" json:"end_number,omitempty"`
+	// complete means whether including the full transactions and transaction receipts
+	Complete bool `protobuf:"varint,3,opt,name=complete,proto3" json:"complete,omitempty"`
+	// max number of blocks returned, the next request can continue from the last returned number plus one
+	Limit                int64    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBlocksByRangeRequest) Reset()         { *m = GetBlocksByRangeRequest{} }
+func (m *GetBlocksByRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlocksByRangeRequest) ProtoMessage()    {}
+func (*GetBlocksByRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{23}
+}
+
+func (m *GetBlocksByRangeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlocksByRangeRequest.Unmarshal(m, b)
+}
+func (m *GetBlocksByRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlocksByRangeRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBlocksByRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlocksByRangeRequest.Merge(m, src)
+}
+func (m *GetBlocksByRangeRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBlocksByRangeRequest.Size(m)
+}
+func (m *GetBlocksByRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlocksByRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlocksByRangeRequest proto.InternalMessageInfo
+
+func (m *GetBlocksByRangeRequest) GetStartNumber() int64 {
 	if m != nil {
-		return m.Permissions
+		return m.StartNumber
 	}
-	return nil
+	return 0
 }
 
-func (m *Account) GetGroups() map[string]*Account_Group {
+func (m *GetBlocksByRangeRequest) GetEndNumber() int64 {
 	if m != nil {
-		return m.Groups
+		return m.EndNumber
 	}
-	return nil
+	return 0
 }
 
-func (m *Account) GetFrozenBalances() []*FrozenBalance {
+func (m *GetBlocksByRangeRequest) GetComplete() bool {
 	if m != nil {
-		return m.FrozenBalances
+		return m.Complete
 	}
-	return nil
+	return false
 }
 
-func (m *Account) GetVoteInfos() []*VoteInfo {
+func (m *GetBlocksByRangeRequest) GetLimit() int64 {
 	if m != nil {
-		return m.VoteInfos
+		return m.Limit
 	}
-	return nil
+	return 0
 }
 
-// The message defines account pledged coin information.
-type Account_PledgeInfo struct {
-	// the account who pledges
-	Pledger string `protobuf:"bytes,1,opt,name=pledger,proto3" json:"pledger,omitempty"`
-	// pledged amount
-	Amount               float64  `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+// The request message containing the block range of transactions.
+type GetTxsByBlockRangeRequest struct {
+	// the first block number
+	StartNumber int64 `protobuf:"varint,1,opt,name=start_number,json=startNumber,proto3" json:"start_number,omitempty"`
+	// the last block number, the head block is used if it's 0 or beyond the head block.
+	// at most 1000 blocks are scanned, see the cursor of the last response to continue
+	EndNumber int64 `protobuf:"varint,2,opt,name=end_number,json=endNumber,proto3" json:"end_number,omitempty"`
+	// max number of transactions returned
+	Limit int64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// the cursor returned by the last transaction of the previous request, which overrides start_number
+	Cursor               string   `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Account_PledgeInfo) Reset()         { *m = Account_PledgeInfo{} }
-func (m *Account_PledgeInfo) String() string { return proto.CompactTextString(m) }
-func (*Account_PledgeInfo) ProtoMessage()    {}
-func (*Account_PledgeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{21, 0}
+func (m *GetTxsByBlockRangeRequest) Reset()         { *m = GetTxsByBlockRangeRequest{} }
+func (m *GetTxsByBlockRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTxsByBlockRangeRequest) ProtoMessage()    {}
+func (*GetTxsByBlockRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{24}
 }
 
-func (m *Account_PledgeInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Account_PledgeInfo.Unmarshal(m, b)
+func (m *GetTxsByBlockRangeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTxsByBlockRangeRequest.Unmarshal(m, b)
 }
-func (m *Account_PledgeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Account_PledgeInfo.Marshal(b, m, deterministic)
+func (m *GetTxsByBlockRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTxsByBlockRangeRequest.Marshal(b, m, deterministic)
 }
-func (m *Account_PledgeInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Account_PledgeInfo.Merge(m, src)
+func (m *GetTxsByBlockRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTxsByBlockRangeRequest.Merge(m, src)
 }
-func (m *Account_PledgeInfo) XXX_Size() int {
-	return xxx_messageInfo_Account_PledgeInfo.Size(m)
+func (m *GetTxsByBlockRangeRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTxsByBlockRangeRequest.Size(m)
 }
-func (m *Account_PledgeInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_Account_PledgeInfo.DiscardUnknown(m)
+func (m *GetTxsByBlockRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTxsByBlockRangeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Account_PledgeInfo proto.InternalMessageInfo
+var xxx_messageInfo_GetTxsByBlockRangeRequest proto.InternalMessageInfo
 
-func (m *Account_PledgeInfo) GetPledger() string {
+func (m *GetTxsByBlockRangeRequest) GetStartNumber() int64 {
 	if m != nil {
-		return m.Pledger
+		return m.StartNumber
 	}
-	return ""
+	return 0
 }
 
-func (m *Account_PledgeInfo) GetAmount() float64 {
+func (m *GetTxsByBlockRangeRequest) GetEndNumber() int64 {
 	if m != nil {
-		return m.Amount
+		return m.EndNumber
 	}
 	return 0
 }
 
-// The message defines account gas information.
-type Account_GasInfo struct {
-	// current total gas amount
-	CurrentTotal    float64 `protobuf:"fixed64,1,opt,name=current_total,json=currentTotal,proto3" json:"current_total,omitempty"`
-	TransferableGas float64 `protobuf:"fixed64,2,opt,name=transferable_gas,json=transferableGas,proto3" json:"transferable_gas,omitempty"`
-	PledgeGas       float64 `protobuf:"fixed64,3,opt,name=pledge_gas,json=pledgeGas,proto3" json:"pledge_gas,omitempty"`
-	// gas increase speed
-	IncreaseSpeed float64 `protobuf:"fixed64,4,opt,name=increase_speed,json=increaseSpeed,proto3" json:"increase_speed,omitempty"`
-	// gas limit
-	Limit float64 `protobuf:"fixed64,5,opt,name=limit,proto3" json:"limit,omitempty"`
-	// pledge information
-	PledgedInfo          []*Account_PledgeInfo `protobuf:"bytes,6,rep,name=pledged_info,json=pledgedInfo,proto3" json:"pledged_info,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+func (m *GetTxsByBlockRangeRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
 }
 
-func (m *Account_GasInfo) Reset()         { *m = Account_GasInfo{} }
-func (m *Account_GasInfo) String() string { return proto.CompactTextString(m) }
-func (*Account_GasInfo) ProtoMessage()    {}
-func (*Account_GasInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{21, 1}
+func (m *GetTxsByBlockRangeRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
 }
 
-func (m *Account_GasInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Account_GasInfo.Unmarshal(m, b)
+// The message defines a transaction packed in a block.
+type BlockTxResponse struct {
+	// transaction status
+	Status TransactionResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=rpcpb.TransactionResponse_Status" json:"status,omitempty"`
+	// transaction
+	Transaction *Transaction `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// block number
+	BlockNumber int64 `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block hash
+	BlockHash string `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// the position of the transaction in block
+	Index int64 `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
+	// the cursor to continue from this transaction, or from the next block of block_number
+	// if transaction is empty, which ends a stream cut by the limit of scanned blocks
+	Cursor               string   `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *Account_GasInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Account_GasInfo.Marshal(b, m, deterministic)
+
+func (m *BlockTxResponse) Reset()         { *m = BlockTxResponse{} }
+func (m *BlockTxResponse) String() string { return proto.CompactTextString(m) }
+func (*BlockTxResponse) ProtoMessage()    {}
+func (*BlockTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{25}
 }
-func (m *Account_GasInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Account_GasInfo.Merge(m, src)
+
+func (m *BlockTxResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockTxResponse.Unmarshal(m, b)
 }
-func (m *Account_GasInfo) XXX_Size() int {
-	return xxx_messageInfo_Account_GasInfo.Size(m)
+func (m *BlockTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockTxResponse.Marshal(b, m, deterministic)
 }
-func (m *Account_GasInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_Account_GasInfo.DiscardUnknown(m)
+func (m *BlockTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockTxResponse.Merge(m, src)
+}
+func (m *BlockTxResponse) XXX_Size() int {
+	return xxx_messageInfo_BlockTxResponse.Size(m)
+}
+func (m *BlockTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockTxResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Account_GasInfo proto.InternalMessageInfo
+var xxx_messageInfo_BlockTxResponse proto.InternalMessageInfo
 
-func (m *Account_GasInfo) GetCurrentTotal() float64 {
+func (m *BlockTxResponse) GetStatus() TransactionResponse_Status {
 	if m != nil {
-		return m.CurrentTotal
+		return m.Status
 	}
-	return 0
+	return TransactionResponse_PENDING
 }
 
-func (m *Account_GasInfo) GetTransferableGas() float64 {
+func (m *BlockTxResponse) GetTransaction() *Transaction {
 	if m != nil {
-		return m.TransferableGas
+		return m.Transaction
 	}
-	return 0
+	return nil
 }
 
-func (m *Account_GasInfo) GetPledgeGas() float64 {
+func (m *BlockTxResponse) GetBlockNumber() int64 {
 	if m != nil {
-		return m.PledgeGas
+		return m.BlockNumber
 	}
 	return 0
 }
 
-func (m *Account_GasInfo) GetIncreaseSpeed() float64 {
+func (m *BlockTxResponse) GetBlockHash() string {
 	if m != nil {
-		return m.IncreaseSpeed
+		return m.BlockHash
 	}
-	return 0
+	return ""
 }
 
-func (m *Account_GasInfo) GetLimit() float64 {
+func (m *BlockTxResponse) GetIndex() int64 {
 	if m != nil {
-		return m.Limit
+		return m.Index
 	}
 	return 0
 }
 
-func (m *Account_GasInfo) GetPledgedInfo() []*Account_PledgeInfo {
+func (m *BlockTxResponse) GetCursor() string {
 	if m != nil {
-		return m.PledgedInfo
+		return m.Cursor
 	}
-	return nil
+	return ""
 }
 
-// The message defines account ram information.
-type Account_RAMInfo struct {
-	// available ram bytes
-	Available int64 `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
-	// used ram bytes
-	Used int64 `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
-	// total ram bytes
-	Total                int64    `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+// The request message containing the account and pagination.
+type GetTxsByAccountRequest struct {
+	// account name
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// max number of transactions returned
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// the cursor returned by the previous request
+	Cursor               string   `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Account_RAMInfo) Reset()         { *m = Account_RAMInfo{} }
-func (m *Account_RAMInfo) String() string { return proto.CompactTextString(m) }
-func (*Account_RAMInfo) ProtoMessage()    {}
-func (*Account_RAMInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{21, 2}
+func (m *GetTxsByAccountRequest) Reset()         { *m = GetTxsByAccountRequest{} }
+func (m *GetTxsByAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTxsByAccountRequest) ProtoMessage()    {}
+func (*GetTxsByAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{26}
 }
 
-func (m *Account_RAMInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Account_RAMInfo.Unmarshal(m, b)
+func (m *GetTxsByAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTxsByAccountRequest.Unmarshal(m, b)
 }
-func (m *Account_RAMInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Account_RAMInfo.Marshal(b, m, deterministic)
+func (m *GetTxsByAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTxsByAccountRequest.Marshal(b, m, deterministic)
 }
-func (m *Account_RAMInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Account_RAMInfo.Merge(m, src)
+func (m *GetTxsByAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTxsByAccountRequest.Merge(m, src)
 }
-func (m *Account_RAMInfo) XXX_Size() int {
-	return xxx_messageInfo_Account_RAMInfo.Size(m)
+func (m *GetTxsByAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTxsByAccountRequest.Size(m)
 }
-func (m *Account_RAMInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_Account_RAMInfo.DiscardUnknown(m)
+func (m *GetTxsByAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTxsByAccountRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Account_RAMInfo proto.InternalMessageInfo
+var xxx_messageInfo_GetTxsByAccountRequest proto.InternalMessageInfo
 
-func (m *Account_RAMInfo) GetAvailable() int64 {
+func (m *GetTxsByAccountRequest) GetAccount() string {
 	if m != nil {
-		return m.Available
+		return m.Account
 	}
-	return 0
+	return ""
 }
 
-func (m *Account_RAMInfo) GetUsed() int64 {
+func (m *GetTxsByAccountRequest) GetLimit() int64 {
 	if m != nil {
-		return m.Used
+		return m.Limit
 	}
 	return 0
 }
 
-func (m *Account_RAMInfo) GetTotal() int64 {
+func (m *GetTxsByAccountRequest) GetCursor() string {
 	if m != nil {
-		return m.Total
+		return m.Cursor
 	}
-	return 0
+	return ""
 }
 
-// The message defines permission item.
-type Account_Item struct {
-	// permission name or key pair id
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// whether it's a key pair
-	IsKeyPair bool `protobuf:"varint,2,opt,name=is_key_pair,json=isKeyPair,proto3" json:"is_key_pair,omitempty"`
-	// permission weight
-	Weight int64 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
-	// permission
-	Permission           string   `protobuf:"bytes,4,opt,name=permission,proto3" json:"permission,omitempty"`
+// The message defines the transactions related to an account.
+type GetTxsByAccountResponse struct {
+	// transactions in the order of block number and position
+	Transactions []*BlockTxResponse `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// the cursor of the next page, empty if there are no more transactions
+	Cursor               string   `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Account_Item) Reset()         { *m = Account_Item{} }
-func (m *Account_Item) String() string { return proto.CompactTextString(m) }
-func (*Account_Item) ProtoMessage()    {}
-func (*Account_Item) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{21, 3}
+func (m *GetTxsByAccountResponse) Reset()         { *m = GetTxsByAccountResponse{} }
+func (m *GetTxsByAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTxsByAccountResponse) ProtoMessage()    {}
+func (*GetTxsByAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{27}
 }
 
-func (m *Account_Item) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Account_Item.Unmarshal(m, b)
+func (m *GetTxsByAccountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTxsByAccountResponse.Unmarshal(m, b)
 }
-func (m *Account_Item) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Account_Item.Marshal(b, m, deterministic)
+func (m *GetTxsByAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTxsByAccountResponse.Marshal(b, m, deterministic)
 }
-func (m *Account_Item) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Account_Item.Merge(m, src)
+func (m *GetTxsByAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTxsByAccountResponse.Merge(m, src)
 }
-func (m *Account_Item) XXX_Size() int {
-	return xxx_messageInfo_Account_Item.Size(m)
+func (m *GetTxsByAccountResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTxsByAccountResponse.Size(m)
 }
-func (m *Account_Item) XXX_DiscardUnknown() {
-	xxx_messageInfo_Account_Item.DiscardUnknown(m)
+func (m *GetTxsByAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTxsByAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTxsByAccountResponse proto.InternalMessageInfo
+
+func (m *GetTxsByAccountResponse) GetTransactions() []*BlockTxResponse {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+func (m *GetTxsByAccountResponse) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+// The message defines the account's frozen balance.
+type FrozenBalance struct {
+	// balance amount
+	Amount float64 `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// free time
+	Time                 int64    `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FrozenBalance) Reset()         { *m = FrozenBalance{} }
+func (m *FrozenBalance) String() string { return proto.CompactTextString(m) }
+func (*FrozenBalance) ProtoMessage()    {}
+func (*FrozenBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{28}
+}
+
+func (m *FrozenBalance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FrozenBalance.Unmarshal(m, b)
+}
+func (m *FrozenBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FrozenBalance.Marshal(b, m, deterministic)
+}
+func (m *FrozenBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrozenBalance.Merge(m, src)
+}
+func (m *FrozenBalance) XXX_Size() int {
+	return xxx_messageInfo_FrozenBalance.Size(m)
+}
+func (m *FrozenBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrozenBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrozenBalance proto.InternalMessageInfo
+
+func (m *FrozenBalance) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *FrozenBalance) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+// The message defines the account's vote info.
+type VoteInfo struct {
+	// option name
+	Option string `protobuf:"bytes,1,opt,name=option,proto3" json:"option,omitempty"`
+	// votes
+	Votes string `protobuf:"bytes,2,opt,name=votes,proto3" json:"votes,omitempty"`
+	// cleared votes
+	ClearedVotes         string   `protobuf:"bytes,3,opt,name=cleared_votes,json=clearedVotes,proto3" json:"cleared_votes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VoteInfo) Reset()         { *m = VoteInfo{} }
+func (m *VoteInfo) String() string { return proto.CompactTextString(m) }
+func (*VoteInfo) ProtoMessage()    {}
+func (*VoteInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{29}
+}
+
+func (m *VoteInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoteInfo.Unmarshal(m, b)
+}
+func (m *VoteInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoteInfo.Marshal(b, m, deterministic)
+}
+func (m *VoteInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteInfo.Merge(m, src)
+}
+func (m *VoteInfo) XXX_Size() int {
+	return xxx_messageInfo_VoteInfo.Size(m)
+}
+func (m *VoteInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteInfo proto.InternalMessageInfo
+
+func (m *VoteInfo) GetOption() string {
+	if m != nil {
+		return m.Option
+	}
+	return ""
+}
+
+func (m *VoteInfo) GetVotes() string {
+	if m != nil {
+		return m.Votes
+	}
+	return ""
+}
+
+func (m *VoteInfo) GetClearedVotes() string {
+	if m != nil {
+		return m.ClearedVotes
+	}
+	return ""
+}
+
+type GasRatioResponse struct {
+	// lowest gas ratio in head block
+	LowestGasRatio float64 `protobuf:"fixed64,1,opt,name=lowest_gas_ratio,json=lowestGasRatio,proto3" json:"lowest_gas_ratio,omitempty"`
+	// median gas ratio in head block
+	MedianGasRatio       float64  `protobuf:"fixed64,2,opt,name=median_gas_ratio,json=medianGasRatio,proto3" json:"median_gas_ratio,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GasRatioResponse) Reset()         { *m = GasRatioResponse{} }
+func (m *GasRatioResponse) String() string { return proto.CompactTextString(m) }
+func (*GasRatioResponse) ProtoMessage()    {}
+func (*GasRatioResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{30}
+}
+
+func (m *GasRatioResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GasRatioResponse.Unmarshal(m, b)
+}
+func (m *GasRatioResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GasRatioResponse.Marshal(b, m, deterministic)
+}
+func (m *GasRatioResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasRatioResponse.Merge(m, src)
+}
+func (m *GasRatioResponse) XXX_Size() int {
+	return xxx_messageInfo_GasRatioResponse.Size(m)
+}
+func (m *GasRatioResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasRatioResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasRatioResponse proto.InternalMessageInfo
+
+func (m *GasRatioResponse) GetLowestGasRatio() float64 {
+	if m != nil {
+		return m.LowestGasRatio
+	}
+	return 0
+}
+
+func (m *GasRatioResponse) GetMedianGasRatio() float64 {
+	if m != nil {
+		return m.MedianGasRatio
+	}
+	return 0
+}
+
+// The message defines account struct.
+type Account struct {
+	// account name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// account balance
+	Balance float64 `protobuf:"fixed64,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	// gas information
+	GasInfo *Account_GasInfo `protobuf:"bytes,3,opt,name=gas_info,json=gasInfo,proto3" json:"gas_info,omitempty"`
+	// ram information
+	RamInfo *Account_RAMInfo `protobuf:"bytes,4,opt,name=ram_info,json=ramInfo,proto3" json:"ram_info,omitempty"`
+	// account permission
+	Permissions map[string]*Account_Permission `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// account groups
+	Groups map[string]*Account_Group `protobuf:"bytes,6,rep,name=groups,proto3" json:"groups,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// frozen balance information
+	FrozenBalances []*FrozenBalance `protobuf:"bytes,7,rep,name=frozen_balances,json=frozenBalances,proto3" json:"frozen_balances,omitempty"`
+	// vote information
+	VoteInfos            []*VoteInfo `protobuf:"bytes,8,rep,name=vote_infos,json=voteInfos,proto3" json:"vote_infos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *Account) Reset()         { *m = Account{} }
+func (m *Account) String() string { return proto.CompactTextString(m) }
+func (*Account) ProtoMessage()    {}
+func (*Account) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{31}
+}
+
+func (m *Account) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account.Unmarshal(m, b)
+}
+func (m *Account) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account.Marshal(b, m, deterministic)
+}
+func (m *Account) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account.Merge(m, src)
+}
+func (m *Account) XXX_Size() int {
+	return xxx_messageInfo_Account.Size(m)
+}
+func (m *Account) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account proto.InternalMessageInfo
+
+func (m *Account) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Account) GetBalance() float64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+func (m *Account) GetGasInfo() *Account_GasInfo {
+	if m != nil {
+		return m.GasInfo
+	}
+	return nil
+}
+
+func (m *Account) GetRamInfo() *Account_RAMInfo {
+	if m != nil {
+		return m.RamInfo
+	}
+	return nil
+}
+
+func (m *Account) GetPermissions() map[string]*Account_Permission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *Account) GetGroups() map[string]*Account_Group {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+func (m *Account) GetFrozenBalances() []*FrozenBalance {
+	if m != nil {
+		return m.FrozenBalances
+	}
+	return nil
+}
+
+func (m *Account) GetVoteInfos() []*VoteInfo {
+	if m != nil {
+		return m.VoteInfos
+	}
+	return nil
+}
+
+// The message defines account pledged coin information.
+type Account_PledgeInfo struct {
+	// the account who pledges
+	Pledger string `protobuf:"bytes,1,opt,name=pledger,proto3" json:"pledger,omitempty"`
+	// pledged amount
+	Amount               float64  `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Account_PledgeInfo) Reset()         { *m = Account_PledgeInfo{} }
+func (m *Account_PledgeInfo) String() string { return proto.CompactTextString(m) }
+func (*Account_PledgeInfo) ProtoMessage()    {}
+func (*Account_PledgeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{31, 0}
+}
+
+func (m *Account_PledgeInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account_PledgeInfo.Unmarshal(m, b)
+}
+func (m *Account_PledgeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account_PledgeInfo.Marshal(b, m, deterministic)
+}
+func (m *Account_PledgeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account_PledgeInfo.Merge(m, src)
+}
+func (m *Account_PledgeInfo) XXX_Size() int {
+	return xxx_messageInfo_Account_PledgeInfo.Size(m)
+}
+func (m *Account_PledgeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account_PledgeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account_PledgeInfo proto.InternalMessageInfo
+
+func (m *Account_PledgeInfo) GetPledger() string {
+	if m != nil {
+		return m.Pledger
+	}
+	return ""
+}
+
+func (m *Account_PledgeInfo) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+// The message defines account gas information.
+type Account_GasInfo struct {
+	// current total gas amount
+	CurrentTotal    float64 `protobuf:"fixed64,1,opt,name=current_total,json=currentTotal,proto3" json:"current_total,omitempty"`
+	TransferableGas float64 `protobuf:"fixed64,2,opt,name=transferable_gas,json=transferableGas,proto3" json:"transferable_gas,omitempty"`
+	PledgeGas       float64 `protobuf:"fixed64,3,opt,name=pledge_gas,json=pledgeGas,proto3" json:"pledge_gas,omitempty"`
+	// gas increase speed
+	IncreaseSpeed float64 `protobuf:"fixed64,4,opt,name=increase_speed,json=increaseSpeed,proto3" json:"increase_speed,omitempty"`
+	// gas limit
+	Limit float64 `protobuf:"fixed64,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// pledge information
+	PledgedInfo          []*Account_PledgeInfo `protobuf:"bytes,6,rep,name=pledged_info,json=pledgedInfo,proto3" json:"pledged_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *Account_GasInfo) Reset()         { *m = Account_GasInfo{} }
+func (m *Account_GasInfo) String() string { return proto.CompactTextString(m) }
+func (*Account_GasInfo) ProtoMessage()    {}
+func (*Account_GasInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{31, 1}
+}
+
+func (m *Account_GasInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account_GasInfo.Unmarshal(m, b)
+}
+func (m *Account_GasInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account_GasInfo.Marshal(b, m, deterministic)
+}
+func (m *Account_GasInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account_GasInfo.Merge(m, src)
+}
+func (m *Account_GasInfo) XXX_Size() int {
+	return xxx_messageInfo_Account_GasInfo.Size(m)
+}
+func (m *Account_GasInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account_GasInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account_GasInfo proto.InternalMessageInfo
+
+func (m *Account_GasInfo) GetCurrentTotal() float64 {
+	if m != nil {
+		return m.CurrentTotal
+	}
+	return 0
+}
+
+func (m *Account_GasInfo) GetTransferableGas() float64 {
+	if m != nil {
+		return m.TransferableGas
+	}
+	return 0
+}
+
+func (m *Account_GasInfo) GetPledgeGas() float64 {
+	if m != nil {
+		return m.PledgeGas
+	}
+	return 0
+}
+
+func (m *Account_GasInfo) GetIncreaseSpeed() float64 {
+	if m != nil {
+		return m.IncreaseSpeed
+	}
+	return 0
+}
+
+func (m *Account_GasInfo) GetLimit() float64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *Account_GasInfo) GetPledgedInfo() []*Account_PledgeInfo {
+	if m != nil {
+		return m.PledgedInfo
+	}
+	return nil
+}
+
+// The message defines account ram information.
+type Account_RAMInfo struct {
+	// available ram bytes
+	Available int64 `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+	// used ram bytes
+	Used int64 `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
+	// total ram bytes
+	Total                int64    `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Account_RAMInfo) Reset()         { *m = Account_RAMInfo{} }
+func (m *Account_RAMInfo) String() string { return proto.CompactTextString(m) }
+func (*Account_RAMInfo) ProtoMessage()    {}
+func (*Account_RAMInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{31, 2}
+}
+
+func (m *Account_RAMInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account_RAMInfo.Unmarshal(m, b)
+}
+func (m *Account_RAMInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account_RAMInfo.Marshal(b, m, deterministic)
+}
+func (m *Account_RAMInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account_RAMInfo.Merge(m, src)
+}
+func (m *Account_RAMInfo) XXX_Size() int {
+	return xxx_messageInfo_Account_RAMInfo.Size(m)
+}
+func (m *Account_RAMInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account_RAMInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account_RAMInfo proto.InternalMessageInfo
+
+func (m *Account_RAMInfo) GetAvailable() int64 {
+	if m != nil {
+		return m.Available
+	}
+	return 0
+}
+
+func (m *Account_RAMInfo) GetUsed() int64 {
+	if m != nil {
+		return m.Used
+	}
+	return 0
+}
+
+func (m *Account_RAMInfo) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+// The message defines permission item.
+type Account_Item struct {
+	// permission name or key pair id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// whether it's a key pair
+	IsKeyPair bool `protobuf:"varint,2,opt,name=is_key_pair,json=isKeyPair,proto3" json:"is_key_pair,omitempty"`
+	// permission weight
+	Weight int64 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	// permission
+	Permission           string   `protobuf:"bytes,4,opt,name=permission,proto3" json:"permission,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Account_Item) Reset()         { *m = Account_Item{} }
+func (m *Account_Item) String() string { return proto.CompactTextString(m) }
+func (*Account_Item) ProtoMessage()    {}
+func (*Account_Item) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{31, 3}
+}
+
+func (m *Account_Item) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account_Item.Unmarshal(m, b)
+}
+func (m *Account_Item) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account_Item.Marshal(b, m, deterministic)
+}
+func (m *Account_Item) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account_Item.Merge(m, src)
+}
+func (m *Account_Item) XXX_Size() int {
+	return xxx_messageInfo_Account_Item.Size(m)
+}
+func (m *Account_Item) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account_Item.DiscardUnknown(m)
 }
 
 var xxx_messageInfo_Account_Item proto.InternalMessageInfo
 
-func (m *Account_Item) GetId() string {
+func (m *Account_Item) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Account_Item) GetIsKeyPair() bool {
+	if m != nil {
+		return m.IsKeyPair
+	}
+	return false
+}
+
+func (m *Account_Item) GetWeight() int64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *Account_Item) GetPermission() string {
+	if m != nil {
+		return m.Permission
+	}
+	return ""
+}
+
+// The message defines a permission group.
+type Account_Group struct {
+	// group name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// permission items
+	Items                []*Account_Item `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Account_Group) Reset()         { *m = Account_Group{} }
+func (m *Account_Group) String() string { return proto.CompactTextString(m) }
+func (*Account_Group) ProtoMessage()    {}
+func (*Account_Group) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{31, 4}
+}
+
+func (m *Account_Group) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account_Group.Unmarshal(m, b)
+}
+func (m *Account_Group) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account_Group.Marshal(b, m, deterministic)
+}
+func (m *Account_Group) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account_Group.Merge(m, src)
+}
+func (m *Account_Group) XXX_Size() int {
+	return xxx_messageInfo_Account_Group.Size(m)
+}
+func (m *Account_Group) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account_Group.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account_Group proto.InternalMessageInfo
+
+func (m *Account_Group) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Account_Group) GetItems() []*Account_Item {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+// The message defines the permission struct.
+type Account_Permission struct {
+	// permission name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// permission groups
+	GroupNames []string `protobuf:"bytes,2,rep,name=group_names,json=groupNames,proto3" json:"group_names,omitempty"`
+	// permission items
+	Items []*Account_Item `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	// permission threshold
+	Threshold            int64    `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Account_Permission) Reset()         { *m = Account_Permission{} }
+func (m *Account_Permission) String() string { return proto.CompactTextString(m) }
+func (*Account_Permission) ProtoMessage()    {}
+func (*Account_Permission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{31, 5}
+}
+
+func (m *Account_Permission) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account_Permission.Unmarshal(m, b)
+}
+func (m *Account_Permission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account_Permission.Marshal(b, m, deterministic)
+}
+func (m *Account_Permission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account_Permission.Merge(m, src)
+}
+func (m *Account_Permission) XXX_Size() int {
+	return xxx_messageInfo_Account_Permission.Size(m)
+}
+func (m *Account_Permission) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account_Permission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account_Permission proto.InternalMessageInfo
+
+func (m *Account_Permission) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Account_Permission) GetGroupNames() []string {
+	if m != nil {
+		return m.GroupNames
+	}
+	return nil
+}
+
+func (m *Account_Permission) GetItems() []*Account_Item {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *Account_Permission) GetThreshold() int64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+// The message defines the get account request.
+type GetAccountRequest struct {
+	// account name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// get account by longest chain's head block or last irreversible block
+	ByLongestChain bool `protobuf:"varint,2,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	// get account at the block of the number if it's positive, by_longest_chain is ignored then
+	BlockNumber int64 `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// get account at the block of the hash if it's set, block_number and by_longest_chain are ignored then
+	BlockHash            string   `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAccountRequest) Reset()         { *m = GetAccountRequest{} }
+func (m *GetAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAccountRequest) ProtoMessage()    {}
+func (*GetAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{32}
+}
+
+func (m *GetAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountRequest.Unmarshal(m, b)
+}
+func (m *GetAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountRequest.Marshal(b, m, deterministic)
+}
+func (m *GetAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountRequest.Merge(m, src)
+}
+func (m *GetAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAccountRequest.Size(m)
+}
+func (m *GetAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountRequest proto.InternalMessageInfo
+
+func (m *GetAccountRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetAccountRequest) GetByLongestChain() bool {
+	if m != nil {
+		return m.ByLongestChain
+	}
+	return false
+}
+
+func (m *GetAccountRequest) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *GetAccountRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+// The message defines the contract struct.
+type Contract struct {
+	// contract id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// contract code
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// contract language
+	Language string `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	// contract version
+	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	// contract abis
+	Abis                 []*Contract_ABI `protobuf:"bytes,5,rep,name=abis,proto3" json:"abis,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Contract) Reset()         { *m = Contract{} }
+func (m *Contract) String() string { return proto.CompactTextString(m) }
+func (*Contract) ProtoMessage()    {}
+func (*Contract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{33}
+}
+
+func (m *Contract) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Contract.Unmarshal(m, b)
+}
+func (m *Contract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Contract.Marshal(b, m, deterministic)
+}
+func (m *Contract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Contract.Merge(m, src)
+}
+func (m *Contract) XXX_Size() int {
+	return xxx_messageInfo_Contract.Size(m)
+}
+func (m *Contract) XXX_DiscardUnknown() {
+	xxx_messageInfo_Contract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Contract proto.InternalMessageInfo
+
+func (m *Contract) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Contract) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *Contract) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
+}
+
+func (m *Contract) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *Contract) GetAbis() []*Contract_ABI {
+	if m != nil {
+		return m.Abis
+	}
+	return nil
+}
+
+// The message defines the ABI struct.
+type Contract_ABI struct {
+	// abi name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// abi arguments
+	Args []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	// abi amount limt
+	AmountLimit          []*AmountLimit `protobuf:"bytes,3,rep,name=amount_limit,json=amountLimit,proto3" json:"amount_limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *Contract_ABI) Reset()         { *m = Contract_ABI{} }
+func (m *Contract_ABI) String() string { return proto.CompactTextString(m) }
+func (*Contract_ABI) ProtoMessage()    {}
+func (*Contract_ABI) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{33, 0}
+}
+
+func (m *Contract_ABI) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Contract_ABI.Unmarshal(m, b)
+}
+func (m *Contract_ABI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Contract_ABI.Marshal(b, m, deterministic)
+}
+func (m *Contract_ABI) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Contract_ABI.Merge(m, src)
+}
+func (m *Contract_ABI) XXX_Size() int {
+	return xxx_messageInfo_Contract_ABI.Size(m)
+}
+func (m *Contract_ABI) XXX_DiscardUnknown() {
+	xxx_messageInfo_Contract_ABI.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Contract_ABI proto.InternalMessageInfo
+
+func (m *Contract_ABI) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Contract_ABI) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *Contract_ABI) GetAmountLimit() []*AmountLimit {
+	if m != nil {
+		return m.AmountLimit
+	}
+	return nil
+}
+
+// The message defines get contract request.
+type GetContractRequest struct {
+	// contract id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// get data by longest chain's head block or last irreversible block
+	ByLongestChain       bool     `protobuf:"varint,2,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetContractRequest) Reset()         { *m = GetContractRequest{} }
+func (m *GetContractRequest) String() string { return proto.CompactTextString(m) }
+func (*GetContractRequest) ProtoMessage()    {}
+func (*GetContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{34}
+}
+
+func (m *GetContractRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetContractRequest.Unmarshal(m, b)
+}
+func (m *GetContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetContractRequest.Marshal(b, m, deterministic)
+}
+func (m *GetContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetContractRequest.Merge(m, src)
+}
+func (m *GetContractRequest) XXX_Size() int {
+	return xxx_messageInfo_GetContractRequest.Size(m)
+}
+func (m *GetContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetContractRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetContractRequest proto.InternalMessageInfo
+
+func (m *GetContractRequest) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *Account_Item) GetIsKeyPair() bool {
+func (m *GetContractRequest) GetByLongestChain() bool {
 	if m != nil {
-		return m.IsKeyPair
+		return m.ByLongestChain
 	}
 	return false
 }
 
-func (m *Account_Item) GetWeight() int64 {
+// The message defines get contract storage request.
+type GetContractStorageRequest struct {
+	// contract id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the key in the StateDB
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// get the value from StateDB, field is needed if StateDB[key] is a map.(we get StateDB[key][field] in this case)
+	Field string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	// get data by longest chain's head block or last irreversible block
+	ByLongestChain bool `protobuf:"varint,4,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	// get data at the block of the number if it's positive, by_longest_chain is ignored then
+	BlockNumber int64 `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// get data at the block of the hash if it's set, block_number and by_longest_chain are ignored then
+	BlockHash            string   `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetContractStorageRequest) Reset()         { *m = GetContractStorageRequest{} }
+func (m *GetContractStorageRequest) String() string { return proto.CompactTextString(m) }
+func (*GetContractStorageRequest) ProtoMessage()    {}
+func (*GetContractStorageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{35}
+}
+
+func (m *GetContractStorageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetContractStorageRequest.Unmarshal(m, b)
+}
+func (m *GetContractStorageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetContractStorageRequest.Marshal(b, m, deterministic)
+}
+func (m *GetContractStorageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetContractStorageRequest.Merge(m, src)
+}
+func (m *GetContractStorageRequest) XXX_Size() int {
+	return xxx_messageInfo_GetContractStorageRequest.Size(m)
+}
+func (m *GetContractStorageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetContractStorageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetContractStorageRequest proto.InternalMessageInfo
+
+func (m *GetContractStorageRequest) GetId() string {
 	if m != nil {
-		return m.Weight
+		return m.Id
+	}
+	return ""
+}
+
+func (m *GetContractStorageRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *GetContractStorageRequest) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *GetContractStorageRequest) GetByLongestChain() bool {
+	if m != nil {
+		return m.ByLongestChain
+	}
+	return false
+}
+
+func (m *GetContractStorageRequest) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
 	}
 	return 0
 }
 
-func (m *Account_Item) GetPermission() string {
+func (m *GetContractStorageRequest) GetBlockHash() string {
 	if m != nil {
-		return m.Permission
+		return m.BlockHash
 	}
 	return ""
 }
 
-// The message defines a permission group.
-type Account_Group struct {
-	// group name
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// permission items
-	Items                []*Account_Item `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+// The message defines get contract storage response.
+type GetContractStorageResponse struct {
+	// the json string data
+	Data                 string   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Account_Group) Reset()         { *m = Account_Group{} }
-func (m *Account_Group) String() string { return proto.CompactTextString(m) }
-func (*Account_Group) ProtoMessage()    {}
-func (*Account_Group) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{21, 4}
+func (m *GetContractStorageResponse) Reset()         { *m = GetContractStorageResponse{} }
+func (m *GetContractStorageResponse) String() string { return proto.CompactTextString(m) }
+func (*GetContractStorageResponse) ProtoMessage()    {}
+func (*GetContractStorageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{36}
 }
 
-func (m *Account_Group) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Account_Group.Unmarshal(m, b)
+func (m *GetContractStorageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetContractStorageResponse.Unmarshal(m, b)
 }
-func (m *Account_Group) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Account_Group.Marshal(b, m, deterministic)
+func (m *GetContractStorageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetContractStorageResponse.Marshal(b, m, deterministic)
 }
-func (m *Account_Group) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Account_Group.Merge(m, src)
+func (m *GetContractStorageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetContractStorageResponse.Merge(m, src)
 }
-func (m *Account_Group) XXX_Size() int {
-	return xxx_messageInfo_Account_Group.Size(m)
+func (m *GetContractStorageResponse) XXX_Size() int {
+	return xxx_messageInfo_GetContractStorageResponse.Size(m)
 }
-func (m *Account_Group) XXX_DiscardUnknown() {
-	xxx_messageInfo_Account_Group.DiscardUnknown(m)
+func (m *GetContractStorageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetContractStorageResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Account_Group proto.InternalMessageInfo
+var xxx_messageInfo_GetContractStorageResponse proto.InternalMessageInfo
 
-func (m *Account_Group) GetName() string {
+func (m *GetContractStorageResponse) GetData() string {
 	if m != nil {
-		return m.Name
+		return m.Data
 	}
 	return ""
 }
 
-func (m *Account_Group) GetItems() []*Account_Item {
-	if m != nil {
-		return m.Items
-	}
-	return nil
-}
-
-// The message defines the permission struct.
-type Account_Permission struct {
-	// permission name
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// permission groups
-	GroupNames []string `protobuf:"bytes,2,rep,name=group_names,json=groupNames,proto3" json:"group_names,omitempty"`
-	// permission items
-	Items []*Account_Item `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
-	// permission threshold
-	Threshold            int64    `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+// The message defines get contract storage request.
+type GetContractStorageFieldsRequest struct {
+	// contract id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// get the fields from StateDB
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// get data by longest chain's head block or last irreversible block
+	ByLongestChain bool `protobuf:"varint,3,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	// max number of fields returned
+	Limit int64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// the cursor returned by the previous request
+	Cursor               string   `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Account_Permission) Reset()         { *m = Account_Permission{} }
-func (m *Account_Permission) String() string { return proto.CompactTextString(m) }
-func (*Account_Permission) ProtoMessage()    {}
-func (*Account_Permission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{21, 5}
+func (m *GetContractStorageFieldsRequest) Reset()         { *m = GetContractStorageFieldsRequest{} }
+func (m *GetContractStorageFieldsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetContractStorageFieldsRequest) ProtoMessage()    {}
+func (*GetContractStorageFieldsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{37}
 }
 
-func (m *Account_Permission) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Account_Permission.Unmarshal(m, b)
+func (m *GetContractStorageFieldsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetContractStorageFieldsRequest.Unmarshal(m, b)
 }
-func (m *Account_Permission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Account_Permission.Marshal(b, m, deterministic)
+func (m *GetContractStorageFieldsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetContractStorageFieldsRequest.Marshal(b, m, deterministic)
 }
-func (m *Account_Permission) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Account_Permission.Merge(m, src)
+func (m *GetContractStorageFieldsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetContractStorageFieldsRequest.Merge(m, src)
 }
-func (m *Account_Permission) XXX_Size() int {
-	return xxx_messageInfo_Account_Permission.Size(m)
+func (m *GetContractStorageFieldsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetContractStorageFieldsRequest.Size(m)
 }
-func (m *Account_Permission) XXX_DiscardUnknown() {
-	xxx_messageInfo_Account_Permission.DiscardUnknown(m)
+func (m *GetContractStorageFieldsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetContractStorageFieldsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Account_Permission proto.InternalMessageInfo
+var xxx_messageInfo_GetContractStorageFieldsRequest proto.InternalMessageInfo
 
-func (m *Account_Permission) GetName() string {
+func (m *GetContractStorageFieldsRequest) GetId() string {
 	if m != nil {
-		return m.Name
+		return m.Id
 	}
 	return ""
 }
 
-func (m *Account_Permission) GetGroupNames() []string {
+func (m *GetContractStorageFieldsRequest) GetKey() string {
 	if m != nil {
-		return m.GroupNames
+		return m.Key
 	}
-	return nil
+	return ""
 }
 
-func (m *Account_Permission) GetItems() []*Account_Item {
+func (m *GetContractStorageFieldsRequest) GetByLongestChain() bool {
 	if m != nil {
-		return m.Items
+		return m.ByLongestChain
 	}
-	return nil
+	return false
 }
 
-func (m *Account_Permission) GetThreshold() int64 {
+func (m *GetContractStorageFieldsRequest) GetLimit() int64 {
 	if m != nil {
-		return m.Threshold
+		return m.Limit
 	}
 	return 0
 }
 
-// The message defines the get account request.
-type GetAccountRequest struct {
-	// account name
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// get account by longest chain's head block or last irreversible block
-	ByLongestChain       bool     `protobuf:"varint,2,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+func (m *GetContractStorageFieldsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+// The message defines get contract storage response.
+type GetContractStorageFieldsResponse struct {
+	// the fields in the order of names.
+	Fields []string `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	// the cursor of the next page, empty if there are no more fields
+	Cursor               string   `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetAccountRequest) Reset()         { *m = GetAccountRequest{} }
-func (m *GetAccountRequest) String() string { return proto.CompactTextString(m) }
-func (*GetAccountRequest) ProtoMessage()    {}
-func (*GetAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{22}
+func (m *GetContractStorageFieldsResponse) Reset()         { *m = GetContractStorageFieldsResponse{} }
+func (m *GetContractStorageFieldsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetContractStorageFieldsResponse) ProtoMessage()    {}
+func (*GetContractStorageFieldsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{38}
 }
 
-func (m *GetAccountRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetAccountRequest.Unmarshal(m, b)
+func (m *GetContractStorageFieldsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetContractStorageFieldsResponse.Unmarshal(m, b)
 }
-func (m *GetAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetAccountRequest.Marshal(b, m, deterministic)
+func (m *GetContractStorageFieldsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetContractStorageFieldsResponse.Marshal(b, m, deterministic)
 }
-func (m *GetAccountRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetAccountRequest.Merge(m, src)
+func (m *GetContractStorageFieldsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetContractStorageFieldsResponse.Merge(m, src)
 }
-func (m *GetAccountRequest) XXX_Size() int {
-	return xxx_messageInfo_GetAccountRequest.Size(m)
+func (m *GetContractStorageFieldsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetContractStorageFieldsResponse.Size(m)
 }
-func (m *GetAccountRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetAccountRequest.DiscardUnknown(m)
+func (m *GetContractStorageFieldsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetContractStorageFieldsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetAccountRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetContractStorageFieldsResponse proto.InternalMessageInfo
 
-func (m *GetAccountRequest) GetName() string {
+func (m *GetContractStorageFieldsResponse) GetFields() []string {
 	if m != nil {
-		return m.Name
+		return m.Fields
 	}
-	return ""
+	return nil
 }
 
-func (m *GetAccountRequest) GetByLongestChain() bool {
+func (m *GetContractStorageFieldsResponse) GetCursor() string {
 	if m != nil {
-		return m.ByLongestChain
+		return m.Cursor
 	}
-	return false
+	return ""
 }
 
-// The message defines the contract struct.
-type Contract struct {
+// The message defines get state proof request.
+type GetStateProofRequest struct {
 	// contract id
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// contract code
-	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	// contract language
-	Language string `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
-	// contract version
-	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
-	// contract abis
-	Abis                 []*Contract_ABI `protobuf:"bytes,5,rep,name=abis,proto3" json:"abis,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	// the key in the StateDB
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// the field of the map if StateDB[key] is a map
+	Field string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	// get the proof at longest chain's head block or last irreversible block
+	ByLongestChain       bool     `protobuf:"varint,4,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Contract) Reset()         { *m = Contract{} }
-func (m *Contract) String() string { return proto.CompactTextString(m) }
-func (*Contract) ProtoMessage()    {}
-func (*Contract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{23}
+func (m *GetStateProofRequest) Reset()         { *m = GetStateProofRequest{} }
+func (m *GetStateProofRequest) String() string { return proto.CompactTextString(m) }
+func (*GetStateProofRequest) ProtoMessage()    {}
+func (*GetStateProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{39}
 }
 
-func (m *Contract) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Contract.Unmarshal(m, b)
+func (m *GetStateProofRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStateProofRequest.Unmarshal(m, b)
 }
-func (m *Contract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Contract.Marshal(b, m, deterministic)
+func (m *GetStateProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStateProofRequest.Marshal(b, m, deterministic)
 }
-func (m *Contract) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Contract.Merge(m, src)
+func (m *GetStateProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStateProofRequest.Merge(m, src)
 }
-func (m *Contract) XXX_Size() int {
-	return xxx_messageInfo_Contract.Size(m)
+func (m *GetStateProofRequest) XXX_Size() int {
+	return xxx_messageInfo_GetStateProofRequest.Size(m)
 }
-func (m *Contract) XXX_DiscardUnknown() {
-	xxx_messageInfo_Contract.DiscardUnknown(m)
+func (m *GetStateProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStateProofRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Contract proto.InternalMessageInfo
+var xxx_messageInfo_GetStateProofRequest proto.InternalMessageInfo
 
-func (m *Contract) GetId() string {
+func (m *GetStateProofRequest) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *Contract) GetCode() string {
+func (m *GetStateProofRequest) GetKey() string {
 	if m != nil {
-		return m.Code
+		return m.Key
 	}
 	return ""
 }
 
-func (m *Contract) GetLanguage() string {
+func (m *GetStateProofRequest) GetField() string {
 	if m != nil {
-		return m.Language
+		return m.Field
 	}
 	return ""
 }
 
-func (m *Contract) GetVersion() string {
+func (m *GetStateProofRequest) GetByLongestChain() bool {
 	if m != nil {
-		return m.Version
+		return m.ByLongestChain
 	}
-	return ""
+	return false
 }
 
-func (m *Contract) GetAbis() []*Contract_ABI {
-	if m != nil {
-		return m.Abis
-	}
-	return nil
+// The message defines get state proof response.
+type GetStateProofResponse struct {
+	// the hash of the block which the state is at
+	BlockHash string `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// the state root after the block
+	StateRoot string `protobuf:"bytes,2,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	// the raw value in the StateDB, empty if it doesn't exist
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// whether the value exists
+	Exists bool `protobuf:"varint,4,opt,name=exists,proto3" json:"exists,omitempty"`
+	// the siblings on the path from the root to the leaf or the empty subtree of the key
+	Siblings []string `protobuf:"bytes,5,rep,name=siblings,proto3" json:"siblings,omitempty"`
+	// the key hash of the leaf at the end of the path, empty if the path ends with an empty subtree
+	LeafKey string `protobuf:"bytes,6,opt,name=leaf_key,json=leafKey,proto3" json:"leaf_key,omitempty"`
+	// the value hash of the leaf at the end of the path
+	LeafValue            string   `protobuf:"bytes,7,opt,name=leaf_value,json=leafValue,proto3" json:"leaf_value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// The message defines the ABI struct.
-type Contract_ABI struct {
-	// abi name
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// abi arguments
-	Args []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
-	// abi amount limt
-	AmountLimit          []*AmountLimit `protobuf:"bytes,3,rep,name=amount_limit,json=amountLimit,proto3" json:"amount_limit,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+func (m *GetStateProofResponse) Reset()         { *m = GetStateProofResponse{} }
+func (m *GetStateProofResponse) String() string { return proto.CompactTextString(m) }
+func (*GetStateProofResponse) ProtoMessage()    {}
+func (*GetStateProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{40}
 }
 
-func (m *Contract_ABI) Reset()         { *m = Contract_ABI{} }
-func (m *Contract_ABI) String() string { return proto.CompactTextString(m) }
-func (*Contract_ABI) ProtoMessage()    {}
-func (*Contract_ABI) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{23, 0}
+func (m *GetStateProofResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStateProofResponse.Unmarshal(m, b)
 }
-
-func (m *Contract_ABI) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Contract_ABI.Unmarshal(m, b)
+func (m *GetStateProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStateProofResponse.Marshal(b, m, deterministic)
 }
-func (m *Contract_ABI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Contract_ABI.Marshal(b, m, deterministic)
+func (m *GetStateProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStateProofResponse.Merge(m, src)
 }
-func (m *Contract_ABI) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Contract_ABI.Merge(m, src)
+func (m *GetStateProofResponse) XXX_Size() int {
+	return xxx_messageInfo_GetStateProofResponse.Size(m)
 }
-func (m *Contract_ABI) XXX_Size() int {
-	return xxx_messageInfo_Contract_ABI.Size(m)
+func (m *GetStateProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStateProofResponse.DiscardUnknown(m)
 }
-func (m *Contract_ABI) XXX_DiscardUnknown() {
-	xxx_messageInfo_Contract_ABI.DiscardUnknown(m)
+
+var xxx_messageInfo_GetStateProofResponse proto.InternalMessageInfo
+
+func (m *GetStateProofResponse) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
 }
 
-var xxx_messageInfo_Contract_ABI proto.InternalMessageInfo
+func (m *GetStateProofResponse) GetStateRoot() string {
+	if m != nil {
+		return m.StateRoot
+	}
+	return ""
+}
 
-func (m *Contract_ABI) GetName() string {
+func (m *GetStateProofResponse) GetValue() string {
 	if m != nil {
-		return m.Name
+		return m.Value
 	}
 	return ""
 }
 
-func (m *Contract_ABI) GetArgs() []string {
+func (m *GetStateProofResponse) GetExists() bool {
 	if m != nil {
-		return m.Args
+		return m.Exists
 	}
-	return nil
+	return false
 }
 
-func (m *Contract_ABI) GetAmountLimit() []*AmountLimit {
+func (m *GetStateProofResponse) GetSiblings() []string {
 	if m != nil {
-		return m.AmountLimit
+		return m.Siblings
 	}
 	return nil
 }
 
-// The message defines get contract request.
-type GetContractRequest struct {
-	// contract id
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// get data by longest chain's head block or last irreversible block
-	ByLongestChain       bool     `protobuf:"varint,2,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+func (m *GetStateProofResponse) GetLeafKey() string {
+	if m != nil {
+		return m.LeafKey
+	}
+	return ""
+}
+
+func (m *GetStateProofResponse) GetLeafValue() string {
+	if m != nil {
+		return m.LeafValue
+	}
+	return ""
+}
+
+// The message defines get equivocations request.
+type GetEquivocationsRequest struct {
+	// the witness public key, empty for all the witnesses
+	Witness string `protobuf:"bytes,1,opt,name=witness,proto3" json:"witness,omitempty"`
+	// max number of equivocations returned
+	Limit                int64    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetContractRequest) Reset()         { *m = GetContractRequest{} }
-func (m *GetContractRequest) String() string { return proto.CompactTextString(m) }
-func (*GetContractRequest) ProtoMessage()    {}
-func (*GetContractRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{24}
+func (m *GetEquivocationsRequest) Reset()         { *m = GetEquivocationsRequest{} }
+func (m *GetEquivocationsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetEquivocationsRequest) ProtoMessage()    {}
+func (*GetEquivocationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{41}
 }
 
-func (m *GetContractRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetContractRequest.Unmarshal(m, b)
+func (m *GetEquivocationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEquivocationsRequest.Unmarshal(m, b)
 }
-func (m *GetContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetContractRequest.Marshal(b, m, deterministic)
+func (m *GetEquivocationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEquivocationsRequest.Marshal(b, m, deterministic)
 }
-func (m *GetContractRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetContractRequest.Merge(m, src)
+func (m *GetEquivocationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEquivocationsRequest.Merge(m, src)
 }
-func (m *GetContractRequest) XXX_Size() int {
-	return xxx_messageInfo_GetContractRequest.Size(m)
+func (m *GetEquivocationsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetEquivocationsRequest.Size(m)
 }
-func (m *GetContractRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetContractRequest.DiscardUnknown(m)
+func (m *GetEquivocationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEquivocationsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetContractRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetEquivocationsRequest proto.InternalMessageInfo
 
-func (m *GetContractRequest) GetId() string {
+func (m *GetEquivocationsRequest) GetWitness() string {
 	if m != nil {
-		return m.Id
+		return m.Witness
 	}
 	return ""
 }
 
-func (m *GetContractRequest) GetByLongestChain() bool {
+func (m *GetEquivocationsRequest) GetLimit() int64 {
 	if m != nil {
-		return m.ByLongestChain
+		return m.Limit
 	}
-	return false
+	return 0
 }
 
-// The message defines get contract storage request.
-type GetContractStorageRequest struct {
-	// contract id
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// the key in the StateDB
-	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	// get the value from StateDB, field is needed if StateDB[key] is a map.(we get StateDB[key][field] in this case)
-	Field string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
-	// get data by longest chain's head block or last irreversible block
-	ByLongestChain       bool     `protobuf:"varint,4,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+// The message defines a block head signed by its witness.
+type SignedBlockHead struct {
+	// the block without transactions
+	Block *Block `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	// the signature of the block hash
+	Signature *Signature `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// the block head encoded by protobuf, from which the block hash is computed
+	RawHead              []byte   `protobuf:"bytes,3,opt,name=raw_head,json=rawHead,proto3" json:"raw_head,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetContractStorageRequest) Reset()         { *m = GetContractStorageRequest{} }
-func (m *GetContractStorageRequest) String() string { return proto.CompactTextString(m) }
-func (*GetContractStorageRequest) ProtoMessage()    {}
-func (*GetContractStorageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{25}
+func (m *SignedBlockHead) Reset()         { *m = SignedBlockHead{} }
+func (m *SignedBlockHead) String() string { return proto.CompactTextString(m) }
+func (*SignedBlockHead) ProtoMessage()    {}
+func (*SignedBlockHead) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{42}
 }
 
-func (m *GetContractStorageRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetContractStorageRequest.Unmarshal(m, b)
+func (m *SignedBlockHead) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignedBlockHead.Unmarshal(m, b)
 }
-func (m *GetContractStorageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetContractStorageRequest.Marshal(b, m, deterministic)
+func (m *SignedBlockHead) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignedBlockHead.Marshal(b, m, deterministic)
 }
-func (m *GetContractStorageRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetContractStorageRequest.Merge(m, src)
+func (m *SignedBlockHead) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedBlockHead.Merge(m, src)
 }
-func (m *GetContractStorageRequest) XXX_Size() int {
-	return xxx_messageInfo_GetContractStorageRequest.Size(m)
+func (m *SignedBlockHead) XXX_Size() int {
+	return xxx_messageInfo_SignedBlockHead.Size(m)
 }
-func (m *GetContractStorageRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetContractStorageRequest.DiscardUnknown(m)
+func (m *SignedBlockHead) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedBlockHead.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetContractStorageRequest proto.InternalMessageInfo
-
-func (m *GetContractStorageRequest) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
-}
+var xxx_messageInfo_SignedBlockHead proto.InternalMessageInfo
 
-func (m *GetContractStorageRequest) GetKey() string {
+func (m *SignedBlockHead) GetBlock() *Block {
 	if m != nil {
-		return m.Key
+		return m.Block
 	}
-	return ""
+	return nil
 }
 
-func (m *GetContractStorageRequest) GetField() string {
+func (m *SignedBlockHead) GetSignature() *Signature {
 	if m != nil {
-		return m.Field
+		return m.Signature
 	}
-	return ""
+	return nil
 }
 
-func (m *GetContractStorageRequest) GetByLongestChain() bool {
+func (m *SignedBlockHead) GetRawHead() []byte {
 	if m != nil {
-		return m.ByLongestChain
+		return m.RawHead
 	}
-	return false
+	return nil
 }
 
-// The message defines get contract storage response.
-type GetContractStorageResponse struct {
-	// the json string data
-	Data                 string   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+// The message defines the evidence of an equivocation.
+type Equivocation struct {
+	// the witness signing the blocks
+	Witness string `protobuf:"bytes,1,opt,name=witness,proto3" json:"witness,omitempty"`
+	// same number or same slot
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// the two blocks signed by the witness
+	Blocks []*SignedBlockHead `protobuf:"bytes,3,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	// the time of detecting the equivocation
+	Time                 int64    `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetContractStorageResponse) Reset()         { *m = GetContractStorageResponse{} }
-func (m *GetContractStorageResponse) String() string { return proto.CompactTextString(m) }
-func (*GetContractStorageResponse) ProtoMessage()    {}
-func (*GetContractStorageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{26}
+func (m *Equivocation) Reset()         { *m = Equivocation{} }
+func (m *Equivocation) String() string { return proto.CompactTextString(m) }
+func (*Equivocation) ProtoMessage()    {}
+func (*Equivocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{43}
 }
 
-func (m *GetContractStorageResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetContractStorageResponse.Unmarshal(m, b)
+func (m *Equivocation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Equivocation.Unmarshal(m, b)
 }
-func (m *GetContractStorageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetContractStorageResponse.Marshal(b, m, deterministic)
+func (m *Equivocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Equivocation.Marshal(b, m, deterministic)
 }
-func (m *GetContractStorageResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetContractStorageResponse.Merge(m, src)
+func (m *Equivocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Equivocation.Merge(m, src)
 }
-func (m *GetContractStorageResponse) XXX_Size() int {
-	return xxx_messageInfo_GetContractStorageResponse.Size(m)
+func (m *Equivocation) XXX_Size() int {
+	return xxx_messageInfo_Equivocation.Size(m)
 }
-func (m *GetContractStorageResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetContractStorageResponse.DiscardUnknown(m)
+func (m *Equivocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Equivocation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetContractStorageResponse proto.InternalMessageInfo
+var xxx_messageInfo_Equivocation proto.InternalMessageInfo
 
-func (m *GetContractStorageResponse) GetData() string {
+func (m *Equivocation) GetWitness() string {
 	if m != nil {
-		return m.Data
+		return m.Witness
 	}
 	return ""
 }
 
-// The message defines get contract storage request.
-type GetContractStorageFieldsRequest struct {
-	// contract id
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// get the fields from StateDB
-	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	// get data by longest chain's head block or last irreversible block
-	ByLongestChain       bool     `protobuf:"varint,3,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *GetContractStorageFieldsRequest) Reset()         { *m = GetContractStorageFieldsRequest{} }
-func (m *GetContractStorageFieldsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetContractStorageFieldsRequest) ProtoMessage()    {}
-func (*GetContractStorageFieldsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{27}
-}
-
-func (m *GetContractStorageFieldsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetContractStorageFieldsRequest.Unmarshal(m, b)
-}
-func (m *GetContractStorageFieldsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetContractStorageFieldsRequest.Marshal(b, m, deterministic)
-}
-func (m *GetContractStorageFieldsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetContractStorageFieldsRequest.Merge(m, src)
-}
-func (m *GetContractStorageFieldsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetContractStorageFieldsRequest.Size(m)
-}
-func (m *GetContractStorageFieldsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetContractStorageFieldsRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GetContractStorageFieldsRequest proto.InternalMessageInfo
-
-func (m *GetContractStorageFieldsRequest) GetId() string {
+func (m *Equivocation) GetReason() string {
 	if m != nil {
-		return m.Id
+		return m.Reason
 	}
 	return ""
 }
 
-func (m *GetContractStorageFieldsRequest) GetKey() string {
+func (m *Equivocation) GetBlocks() []*SignedBlockHead {
 	if m != nil {
-		return m.Key
+		return m.Blocks
 	}
-	return ""
+	return nil
 }
 
-func (m *GetContractStorageFieldsRequest) GetByLongestChain() bool {
+func (m *Equivocation) GetTime() int64 {
 	if m != nil {
-		return m.ByLongestChain
+		return m.Time
 	}
-	return false
+	return 0
 }
 
-// The message defines get contract storage response.
-type GetContractStorageFieldsResponse struct {
-	// the fields.
-	Fields               []string `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// The message defines get equivocations response.
+type GetEquivocationsResponse struct {
+	// equivocations in the order of witness and block number
+	Equivocations        []*Equivocation `protobuf:"bytes,1,rep,name=equivocations,proto3" json:"equivocations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *GetContractStorageFieldsResponse) Reset()         { *m = GetContractStorageFieldsResponse{} }
-func (m *GetContractStorageFieldsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetContractStorageFieldsResponse) ProtoMessage()    {}
-func (*GetContractStorageFieldsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{28}
+func (m *GetEquivocationsResponse) Reset()         { *m = GetEquivocationsResponse{} }
+func (m *GetEquivocationsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetEquivocationsResponse) ProtoMessage()    {}
+func (*GetEquivocationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{44}
 }
 
-func (m *GetContractStorageFieldsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetContractStorageFieldsResponse.Unmarshal(m, b)
+func (m *GetEquivocationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEquivocationsResponse.Unmarshal(m, b)
 }
-func (m *GetContractStorageFieldsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetContractStorageFieldsResponse.Marshal(b, m, deterministic)
+func (m *GetEquivocationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEquivocationsResponse.Marshal(b, m, deterministic)
 }
-func (m *GetContractStorageFieldsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetContractStorageFieldsResponse.Merge(m, src)
+func (m *GetEquivocationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEquivocationsResponse.Merge(m, src)
 }
-func (m *GetContractStorageFieldsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetContractStorageFieldsResponse.Size(m)
+func (m *GetEquivocationsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetEquivocationsResponse.Size(m)
 }
-func (m *GetContractStorageFieldsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetContractStorageFieldsResponse.DiscardUnknown(m)
+func (m *GetEquivocationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEquivocationsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetContractStorageFieldsResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetEquivocationsResponse proto.InternalMessageInfo
 
-func (m *GetContractStorageFieldsResponse) GetFields() []string {
+func (m *GetEquivocationsResponse) GetEquivocations() []*Equivocation {
 	if m != nil {
-		return m.Fields
+		return m.Equivocations
 	}
 	return nil
 }
@@ -2728,7 +4169,7 @@ func (m *SendTransactionResponse) Reset()         { *m = SendTransactionResponse
 func (m *SendTransactionResponse) String() string { return proto.CompactTextString(m) }
 func (*SendTransactionResponse) ProtoMessage()    {}
 func (*SendTransactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{29}
+	return fileDescriptor_1b773bf3e696f610, []int{45}
 }
 
 func (m *SendTransactionResponse) XXX_Unmarshal(b []byte) error {
@@ -2756,6 +4197,178 @@ func (m *SendTransactionResponse) GetHash() string {
 	return ""
 }
 
+// The message defines the merkle proof of a transaction or a receipt.
+type MerkleProofResponse struct {
+	// the status of the block
+	Status BlockResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=rpcpb.BlockResponse_Status" json:"status,omitempty"`
+	// the transaction or the receipt encoded by protobuf, which is the leaf data
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// the index of the leaf
+	Index int64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	// the sibling hashes from the leaf to the merkle root
+	Path [][]byte `protobuf:"bytes,4,rep,name=path,proto3" json:"path,omitempty"`
+	// the block head encoded by protobuf
+	BlockHead []byte `protobuf:"bytes,5,opt,name=block_head,json=blockHead,proto3" json:"block_head,omitempty"`
+	// the block hash
+	BlockHash string `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// the signature of the block hash by the witness
+	Signature            *Signature `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *MerkleProofResponse) Reset()         { *m = MerkleProofResponse{} }
+func (m *MerkleProofResponse) String() string { return proto.CompactTextString(m) }
+func (*MerkleProofResponse) ProtoMessage()    {}
+func (*MerkleProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{46}
+}
+
+func (m *MerkleProofResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MerkleProofResponse.Unmarshal(m, b)
+}
+func (m *MerkleProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MerkleProofResponse.Marshal(b, m, deterministic)
+}
+func (m *MerkleProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerkleProofResponse.Merge(m, src)
+}
+func (m *MerkleProofResponse) XXX_Size() int {
+	return xxx_messageInfo_MerkleProofResponse.Size(m)
+}
+func (m *MerkleProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerkleProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerkleProofResponse proto.InternalMessageInfo
+
+func (m *MerkleProofResponse) GetStatus() BlockResponse_Status {
+	if m != nil {
+		return m.Status
+	}
+	return BlockResponse_PENDING
+}
+
+func (m *MerkleProofResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *MerkleProofResponse) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *MerkleProofResponse) GetPath() [][]byte {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *MerkleProofResponse) GetBlockHead() []byte {
+	if m != nil {
+		return m.BlockHead
+	}
+	return nil
+}
+
+func (m *MerkleProofResponse) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *MerkleProofResponse) GetSignature() *Signature {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// The message defines estimate resources response.
+type EstimateResourcesResponse struct {
+	// the receipt of running the transaction against the head state
+	TxReceipt *TxReceipt `protobuf:"bytes,1,opt,name=tx_receipt,json=txReceipt,proto3" json:"tx_receipt,omitempty"`
+	// gas used
+	GasUsed float64 `protobuf:"fixed64,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// ram delta of each payer
+	RamUsage map[string]int64 `protobuf:"bytes,3,rep,name=ram_usage,json=ramUsage,proto3" json:"ram_usage,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// the required amount limit of each token
+	AmountLimit []*AmountLimit `protobuf:"bytes,4,rep,name=amount_limit,json=amountLimit,proto3" json:"amount_limit,omitempty"`
+	// the suggested gas limit with safety margin
+	GasLimit             float64  `protobuf:"fixed64,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EstimateResourcesResponse) Reset()         { *m = EstimateResourcesResponse{} }
+func (m *EstimateResourcesResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateResourcesResponse) ProtoMessage()    {}
+func (*EstimateResourcesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{47}
+}
+
+func (m *EstimateResourcesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateResourcesResponse.Unmarshal(m, b)
+}
+func (m *EstimateResourcesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateResourcesResponse.Marshal(b, m, deterministic)
+}
+func (m *EstimateResourcesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateResourcesResponse.Merge(m, src)
+}
+func (m *EstimateResourcesResponse) XXX_Size() int {
+	return xxx_messageInfo_EstimateResourcesResponse.Size(m)
+}
+func (m *EstimateResourcesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateResourcesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateResourcesResponse proto.InternalMessageInfo
+
+func (m *EstimateResourcesResponse) GetTxReceipt() *TxReceipt {
+	if m != nil {
+		return m.TxReceipt
+	}
+	return nil
+}
+
+func (m *EstimateResourcesResponse) GetGasUsed() float64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *EstimateResourcesResponse) GetRamUsage() map[string]int64 {
+	if m != nil {
+		return m.RamUsage
+	}
+	return nil
+}
+
+func (m *EstimateResourcesResponse) GetAmountLimit() []*AmountLimit {
+	if m != nil {
+		return m.AmountLimit
+	}
+	return nil
+}
+
+func (m *EstimateResourcesResponse) GetGasLimit() float64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
 // The message defines get token balance response.
 type GetTokenBalanceResponse struct {
 	// token balance
@@ -2771,7 +4384,7 @@ func (m *GetTokenBalanceResponse) Reset()         { *m = GetTokenBalanceResponse
 func (m *GetTokenBalanceResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTokenBalanceResponse) ProtoMessage()    {}
 func (*GetTokenBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{30}
+	return fileDescriptor_1b773bf3e696f610, []int{48}
 }
 
 func (m *GetTokenBalanceResponse) XXX_Unmarshal(b []byte) error {
@@ -2813,7 +4426,11 @@ type GetTokenBalanceRequest struct {
 	// the token name
 	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 	// get data by longest chain's head block or last irreversible block
-	ByLongestChain       bool     `protobuf:"varint,3,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	ByLongestChain bool `protobuf:"varint,3,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	// get data at the block of the number if it's positive, by_longest_chain is ignored then
+	BlockNumber int64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// get data at the block of the hash if it's set, block_number and by_longest_chain are ignored then
+	BlockHash            string   `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2823,7 +4440,7 @@ func (m *GetTokenBalanceRequest) Reset()         { *m = GetTokenBalanceRequest{}
 func (m *GetTokenBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTokenBalanceRequest) ProtoMessage()    {}
 func (*GetTokenBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{31}
+	return fileDescriptor_1b773bf3e696f610, []int{49}
 }
 
 func (m *GetTokenBalanceRequest) XXX_Unmarshal(b []byte) error {
@@ -2865,6 +4482,20 @@ func (m *GetTokenBalanceRequest) GetByLongestChain() bool {
 	return false
 }
 
+func (m *GetTokenBalanceRequest) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *GetTokenBalanceRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
 // The message defines get token721 balance response.
 type GetToken721BalanceResponse struct {
 	// token balance
@@ -2880,7 +4511,7 @@ func (m *GetToken721BalanceResponse) Reset()         { *m = GetToken721BalanceRe
 func (m *GetToken721BalanceResponse) String() string { return proto.CompactTextString(m) }
 func (*GetToken721BalanceResponse) ProtoMessage()    {}
 func (*GetToken721BalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{32}
+	return fileDescriptor_1b773bf3e696f610, []int{50}
 }
 
 func (m *GetToken721BalanceResponse) XXX_Unmarshal(b []byte) error {
@@ -2932,7 +4563,7 @@ func (m *GetToken721InfoRequest) Reset()         { *m = GetToken721InfoRequest{}
 func (m *GetToken721InfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetToken721InfoRequest) ProtoMessage()    {}
 func (*GetToken721InfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{33}
+	return fileDescriptor_1b773bf3e696f610, []int{51}
 }
 
 func (m *GetToken721InfoRequest) XXX_Unmarshal(b []byte) error {
@@ -2987,7 +4618,7 @@ func (m *GetToken721MetadataResponse) Reset()         { *m = GetToken721Metadata
 func (m *GetToken721MetadataResponse) String() string { return proto.CompactTextString(m) }
 func (*GetToken721MetadataResponse) ProtoMessage()    {}
 func (*GetToken721MetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{34}
+	return fileDescriptor_1b773bf3e696f610, []int{52}
 }
 
 func (m *GetToken721MetadataResponse) XXX_Unmarshal(b []byte) error {
@@ -3028,7 +4659,7 @@ func (m *GetToken721OwnerResponse) Reset()         { *m = GetToken721OwnerRespon
 func (m *GetToken721OwnerResponse) String() string { return proto.CompactTextString(m) }
 func (*GetToken721OwnerResponse) ProtoMessage()    {}
 func (*GetToken721OwnerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{35}
+	return fileDescriptor_1b773bf3e696f610, []int{53}
 }
 
 func (m *GetToken721OwnerResponse) XXX_Unmarshal(b []byte) error {
@@ -3063,7 +4694,9 @@ type Event struct {
 	// event data
 	Data string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	// event time
-	Time                 int64    `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	Time int64 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	// the number of the block in which the event is generated
+	BlockNumber          int64    `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3073,7 +4706,7 @@ func (m *Event) Reset()         { *m = Event{} }
 func (m *Event) String() string { return proto.CompactTextString(m) }
 func (*Event) ProtoMessage()    {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{36}
+	return fileDescriptor_1b773bf3e696f610, []int{54}
 }
 
 func (m *Event) XXX_Unmarshal(b []byte) error {
@@ -3115,20 +4748,32 @@ func (m *Event) GetTime() int64 {
 	return 0
 }
 
+func (m *Event) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
 // The message defines subscribe request.
 type SubscribeRequest struct {
-	Topics               []Event_Topic            `protobuf:"varint,1,rep,packed,name=topics,proto3,enum=rpcpb.Event_Topic" json:"topics,omitempty"`
-	Filter               *SubscribeRequest_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	Topics []Event_Topic            `protobuf:"varint,1,rep,packed,name=topics,proto3,enum=rpcpb.Event_Topic" json:"topics,omitempty"`
+	Filter *SubscribeRequest_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// replay the contract receipts from this block number before sending live events, 0 means no replay.
+	// contract events are not stored, so they can't be replayed.
+	FromBlockNumber int64 `protobuf:"varint,3,opt,name=from_block_number,json=fromBlockNumber,proto3" json:"from_block_number,omitempty"`
+	// replay the contract receipts from this cursor, which is returned in the subscribe response. it overrides from_block_number.
+	Cursor               string   `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
 func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
 func (*SubscribeRequest) ProtoMessage()    {}
 func (*SubscribeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{37}
+	return fileDescriptor_1b773bf3e696f610, []int{55}
 }
 
 func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
@@ -3163,9 +4808,31 @@ func (m *SubscribeRequest) GetFilter() *SubscribeRequest_Filter {
 	return nil
 }
 
+func (m *SubscribeRequest) GetFromBlockNumber() int64 {
+	if m != nil {
+		return m.FromBlockNumber
+	}
+	return 0
+}
+
+func (m *SubscribeRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+// the filter is applied to contract receipts and contract events. only accounts are applied to dropped txs.
 type SubscribeRequest_Filter struct {
 	// contract id
-	ContractId           string   `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// contract function name, such as token.iost/transfer
+	FuncName string `protobuf:"bytes,2,opt,name=func_name,json=funcName,proto3" json:"func_name,omitempty"`
+	// the events involving any of the accounts, which are the tx publisher and the accounts in token receipts
+	Accounts []string `protobuf:"bytes,3,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// only send the contract receipts when blocks become irreversible.
+	// contract events are not stored, so they are not sent for irreversible blocks.
+	Irreversible         bool     `protobuf:"varint,4,opt,name=irreversible,proto3" json:"irreversible,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3175,7 +4842,7 @@ func (m *SubscribeRequest_Filter) Reset()         { *m = SubscribeRequest_Filter
 func (m *SubscribeRequest_Filter) String() string { return proto.CompactTextString(m) }
 func (*SubscribeRequest_Filter) ProtoMessage()    {}
 func (*SubscribeRequest_Filter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{37, 0}
+	return fileDescriptor_1b773bf3e696f610, []int{55, 0}
 }
 
 func (m *SubscribeRequest_Filter) XXX_Unmarshal(b []byte) error {
@@ -3203,9 +4870,35 @@ func (m *SubscribeRequest_Filter) GetContractId() string {
 	return ""
 }
 
+func (m *SubscribeRequest_Filter) GetFuncName() string {
+	if m != nil {
+		return m.FuncName
+	}
+	return ""
+}
+
+func (m *SubscribeRequest_Filter) GetAccounts() []string {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *SubscribeRequest_Filter) GetIrreversible() bool {
+	if m != nil {
+		return m.Irreversible
+	}
+	return false
+}
+
 // The message defines subscribe response.
 type SubscribeResponse struct {
-	Event                *Event   `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Event *Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// the cursor to resume the subscription after this response
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// whether the subscriber falls behind and some events are dropped.
+	// the stream is closed after an overflow response, resume it with the cursor.
+	Overflow             bool     `protobuf:"varint,3,opt,name=overflow,proto3" json:"overflow,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3215,7 +4908,7 @@ func (m *SubscribeResponse) Reset()         { *m = SubscribeResponse{} }
 func (m *SubscribeResponse) String() string { return proto.CompactTextString(m) }
 func (*SubscribeResponse) ProtoMessage()    {}
 func (*SubscribeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{38}
+	return fileDescriptor_1b773bf3e696f610, []int{56}
 }
 
 func (m *SubscribeResponse) XXX_Unmarshal(b []byte) error {
@@ -3243,6 +4936,20 @@ func (m *SubscribeResponse) GetEvent() *Event {
 	return nil
 }
 
+func (m *SubscribeResponse) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *SubscribeResponse) GetOverflow() bool {
+	if m != nil {
+		return m.Overflow
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("rpcpb.TxReceipt_StatusCode", TxReceipt_StatusCode_name, TxReceipt_StatusCode_value)
 	proto.RegisterEnum("rpcpb.TransactionResponse_Status", TransactionResponse_Status_name, TransactionResponse_Status_value)
@@ -3261,15 +4968,29 @@ func init() {
 	proto.RegisterType((*TxReceipt_Receipt)(nil), "rpcpb.TxReceipt.Receipt")
 	proto.RegisterType((*Transaction)(nil), "rpcpb.Transaction")
 	proto.RegisterType((*TransactionResponse)(nil), "rpcpb.TransactionResponse")
+	proto.RegisterType((*PoolStatus)(nil), "rpcpb.PoolStatus")
+	proto.RegisterType((*GetPendingTxsRequest)(nil), "rpcpb.GetPendingTxsRequest")
+	proto.RegisterType((*GetPendingTxsResponse)(nil), "rpcpb.GetPendingTxsResponse")
+	proto.RegisterType((*PendingTxStatsResponse)(nil), "rpcpb.PendingTxStatsResponse")
+	proto.RegisterType((*PendingTxStatsResponse_AgeBucket)(nil), "rpcpb.PendingTxStatsResponse.AgeBucket")
 	proto.RegisterType((*Signature)(nil), "rpcpb.Signature")
 	proto.RegisterType((*TransactionRequest)(nil), "rpcpb.TransactionRequest")
 	proto.RegisterType((*Block)(nil), "rpcpb.Block")
 	proto.RegisterType((*Block_Info)(nil), "rpcpb.Block.Info")
 	proto.RegisterType((*BlockResponse)(nil), "rpcpb.BlockResponse")
 	proto.RegisterType((*ChainInfoResponse)(nil), "rpcpb.ChainInfoResponse")
+	proto.RegisterType((*ProducerScheduleResponse)(nil), "rpcpb.ProducerScheduleResponse")
+	proto.RegisterType((*ProducerScheduleResponse_Slot)(nil), "rpcpb.ProducerScheduleResponse.Slot")
+	proto.RegisterType((*ProducerScheduleResponse_Witness)(nil), "rpcpb.ProducerScheduleResponse.Witness")
+	proto.RegisterType((*ProducerScheduleResponse_PendingBlock)(nil), "rpcpb.ProducerScheduleResponse.PendingBlock")
 	proto.RegisterType((*TxHashRequest)(nil), "rpcpb.TxHashRequest")
 	proto.RegisterType((*GetBlockByHashRequest)(nil), "rpcpb.GetBlockByHashRequest")
 	proto.RegisterType((*GetBlockByNumberRequest)(nil), "rpcpb.GetBlockByNumberRequest")
+	proto.RegisterType((*GetBlocksByRangeRequest)(nil), "rpcpb.GetBlocksByRangeRequest")
+	proto.RegisterType((*GetTxsByBlockRangeRequest)(nil), "rpcpb.GetTxsByBlockRangeRequest")
+	proto.RegisterType((*BlockTxResponse)(nil), "rpcpb.BlockTxResponse")
+	proto.RegisterType((*GetTxsByAccountRequest)(nil), "rpcpb.GetTxsByAccountRequest")
+	proto.RegisterType((*GetTxsByAccountResponse)(nil), "rpcpb.GetTxsByAccountResponse")
 	proto.RegisterType((*FrozenBalance)(nil), "rpcpb.FrozenBalance")
 	proto.RegisterType((*VoteInfo)(nil), "rpcpb.VoteInfo")
 	proto.RegisterType((*GasRatioResponse)(nil), "rpcpb.GasRatioResponse")
@@ -3290,7 +5011,16 @@ func init() {
 	proto.RegisterType((*GetContractStorageResponse)(nil), "rpcpb.GetContractStorageResponse")
 	proto.RegisterType((*GetContractStorageFieldsRequest)(nil), "rpcpb.GetContractStorageFieldsRequest")
 	proto.RegisterType((*GetContractStorageFieldsResponse)(nil), "rpcpb.GetContractStorageFieldsResponse")
+	proto.RegisterType((*GetStateProofRequest)(nil), "rpcpb.GetStateProofRequest")
+	proto.RegisterType((*GetStateProofResponse)(nil), "rpcpb.GetStateProofResponse")
+	proto.RegisterType((*GetEquivocationsRequest)(nil), "rpcpb.GetEquivocationsRequest")
+	proto.RegisterType((*SignedBlockHead)(nil), "rpcpb.SignedBlockHead")
+	proto.RegisterType((*Equivocation)(nil), "rpcpb.Equivocation")
+	proto.RegisterType((*GetEquivocationsResponse)(nil), "rpcpb.GetEquivocationsResponse")
 	proto.RegisterType((*SendTransactionResponse)(nil), "rpcpb.SendTransactionResponse")
+	proto.RegisterType((*MerkleProofResponse)(nil), "rpcpb.MerkleProofResponse")
+	proto.RegisterType((*EstimateResourcesResponse)(nil), "rpcpb.EstimateResourcesResponse")
+	proto.RegisterMapType((map[string]int64)(nil), "rpcpb.EstimateResourcesResponse.RamUsageEntry")
 	proto.RegisterType((*GetTokenBalanceResponse)(nil), "rpcpb.GetTokenBalanceResponse")
 	proto.RegisterType((*GetTokenBalanceRequest)(nil), "rpcpb.GetTokenBalanceRequest")
 	proto.RegisterType((*GetToken721BalanceResponse)(nil), "rpcpb.GetToken721BalanceResponse")
@@ -3306,214 +5036,305 @@ func init() {
 func init() { proto.RegisterFile("rpc/pb/rpc.proto", fileDescriptor_1b773bf3e696f610) }
 
 var fileDescriptor_1b773bf3e696f610 = []byte{
-	// 3306 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x39, 0x4d, 0x6f, 0x1b, 0x47,
-	0x96, 0x6e, 0x52, 0xfc, 0xe8, 0xc7, 0x0f, 0xd1, 0x25, 0xc5, 0xa6, 0xe9, 0xef, 0x8e, 0x13, 0x7f,
-	0x20, 0x11, 0x6d, 0x39, 0x8e, 0x63, 0x27, 0xd9, 0x0d, 0x25, 0xd3, 0x8a, 0x60, 0x9b, 0x52, 0x8a,
-	0x94, 0xb3, 0x01, 0x76, 0xd1, 0xdb, 0x24, 0x4b, 0x54, 0xaf, 0xc9, 0x6e, 0x6e, 0x77, 0xd3, 0xa6,
-	0x56, 0xf0, 0x65, 0x8f, 0x8b, 0xc5, 0x2e, 0x82, 0x5c, 0xe6, 0x30, 0x97, 0xb9, 0xe6, 0x07, 0xcc,
-	0x0c, 0x30, 0x3f, 0x63, 0x80, 0xb9, 0xce, 0x61, 0xe6, 0x1f, 0xe4, 0x3c, 0xc0, 0xa0, 0x5e, 0x55,
-	0x75, 0x37, 0xc9, 0xa6, 0xad, 0x39, 0xb1, 0xdf, 0xab, 0x57, 0xef, 0xbd, 0xaa, 0xf7, 0x5d, 0x84,
-	0x8a, 0x37, 0xee, 0xd5, 0xc7, 0xdd, 0xba, 0x37, 0xee, 0x6d, 0x8c, 0x3d, 0x37, 0x70, 0x49, 0xc6,
-	0x1b, 0xf7, 0xc6, 0xdd, 0xda, 0xa5, 0x81, 0xeb, 0x0e, 0x86, 0xac, 0x6e, 0x8d, 0xed, 0xba, 0xe5,
-	0x38, 0x6e, 0x60, 0x05, 0xb6, 0xeb, 0xf8, 0x82, 0xc8, 0x28, 0x43, 0xb1, 0x39, 0x1a, 0x07, 0xc7,
-	0x94, 0xfd, 0xe7, 0x84, 0xf9, 0x81, 0xb1, 0x01, 0xf9, 0x7d, 0xc6, 0xbc, 0x5d, 0xe7, 0xd0, 0x25,
-	0x65, 0x48, 0xd9, 0xfd, 0xaa, 0x76, 0x4d, 0xbb, 0xa5, 0xd3, 0x94, 0xdd, 0x27, 0x04, 0x56, 0xac,
-	0x7e, 0xdf, 0xab, 0xa6, 0x10, 0x83, 0xdf, 0xc6, 0x7f, 0x40, 0xa1, 0xc5, 0x82, 0x37, 0xae, 0xf7,
-	0x2a, 0x71, 0xcb, 0x65, 0x80, 0x31, 0x63, 0x9e, 0xd9, 0x73, 0x27, 0x4e, 0x80, 0x1b, 0x33, 0x54,
-	0xe7, 0x98, 0x6d, 0x8e, 0x20, 0x9f, 0x00, 0x02, 0xa6, 0xed, 0x1c, 0xba, 0xd5, 0xf4, 0xb5, 0xf4,
-	0xad, 0xc2, 0xe6, 0xea, 0x06, 0xaa, 0xbd, 0xa1, 0xb4, 0xa0, 0xf9, 0xb1, 0xfc, 0x32, 0x7e, 0xd6,
-	0x60, 0x95, 0x36, 0x5e, 0x20, 0x96, 0xf9, 0x63, 0xd7, 0xf1, 0x19, 0xb9, 0x00, 0xf9, 0x89, 0xcf,
-	0xfa, 0xa6, 0x67, 0x8d, 0x50, 0x6c, 0x9a, 0xe6, 0x38, 0x4c, 0xad, 0x11, 0xf9, 0x10, 0x4a, 0xd6,
-	0x6b, 0xcb, 0x1e, 0x5a, 0xdd, 0x21, 0xc3, 0xf5, 0x14, 0xae, 0x17, 0x43, 0x24, 0x27, 0xba, 0x08,
-	0x7a, 0xe0, 0x06, 0xd6, 0x10, 0x09, 0xd2, 0x48, 0x90, 0x47, 0x04, 0x5f, 0xbc, 0x0c, 0xe0, 0xb3,
-	0xe1, 0xd0, 0x1c, 0x7b, 0x76, 0x8f, 0x55, 0x57, 0xae, 0x69, 0xb7, 0x34, 0xaa, 0x73, 0xcc, 0x3e,
-	0x47, 0xf0, 0xbd, 0xdd, 0xc9, 0xb1, 0x5c, 0xcd, 0xe0, 0x6a, 0xbe, 0x3b, 0x39, 0xc6, 0x45, 0xe3,
-	0xff, 0x34, 0xa8, 0xb4, 0xdc, 0x3e, 0x9b, 0xd1, 0xf6, 0x32, 0x40, 0x77, 0x62, 0x0f, 0xfb, 0x66,
-	0x60, 0x8f, 0x98, 0xbc, 0x26, 0x1d, 0x31, 0x1d, 0x7b, 0x84, 0x87, 0x19, 0xd8, 0x81, 0x79, 0x64,
-	0xf9, 0x47, 0xf2, 0x92, 0x73, 0x03, 0x3b, 0xf8, 0xd6, 0xf2, 0x8f, 0xf8, 0xdd, 0x8f, 0xdc, 0x3e,
-	0x43, 0x15, 0x75, 0x8a, 0xdf, 0xe4, 0x13, 0xc8, 0x39, 0xe2, 0xee, 0x51, 0xb7, 0xc2, 0x26, 0x91,
-	0x77, 0x17, 0xb3, 0x08, 0x55, 0x24, 0xc6, 0x23, 0x28, 0x34, 0x46, 0xfc, 0xd6, 0x9f, 0xdb, 0x23,
-	0x3b, 0x20, 0xeb, 0x90, 0x09, 0xdc, 0x57, 0xcc, 0x91, 0x5a, 0x08, 0x80, 0x63, 0x5f, 0x5b, 0xc3,
-	0x09, 0x93, 0xe2, 0x05, 0x60, 0xfc, 0x00, 0xd9, 0x46, 0x8f, 0x7b, 0x0d, 0xa9, 0x41, 0xbe, 0xe7,
-	0x3a, 0x81, 0x67, 0xf5, 0x02, 0xb9, 0x31, 0x84, 0xc9, 0x55, 0x28, 0x58, 0x48, 0x65, 0x3a, 0xd6,
-	0x48, 0x71, 0x00, 0x81, 0x6a, 0x59, 0x23, 0xc6, 0xcf, 0xd0, 0xb7, 0x02, 0x4b, 0x9d, 0x81, 0x7f,
-	0x1b, 0x7f, 0x5e, 0x01, 0xbd, 0x33, 0xa5, 0xac, 0xc7, 0xec, 0x71, 0x40, 0xce, 0x43, 0x2e, 0x98,
-	0x8a, 0xf3, 0x0b, 0xee, 0xd9, 0x60, 0x8a, 0xc7, 0xbf, 0x08, 0xfa, 0xc0, 0xf2, 0xcd, 0x89, 0x6f,
-	0x0d, 0x04, 0x67, 0x8d, 0xe6, 0x07, 0x96, 0x7f, 0xc0, 0x61, 0xf2, 0x25, 0xe8, 0x9e, 0x35, 0x92,
-	0x8b, 0xc2, 0x8b, 0xae, 0xc8, 0x9b, 0x08, 0x59, 0x6f, 0x50, 0x6b, 0x84, 0xd4, 0x4d, 0x27, 0xf0,
-	0x8e, 0x69, 0xde, 0x93, 0x20, 0xf9, 0x0a, 0x0a, 0x7e, 0x60, 0x05, 0x13, 0xdf, 0xec, 0xf1, 0xfb,
-	0xe5, 0x17, 0x59, 0xde, 0xbc, 0xb8, 0xb0, 0xbd, 0x8d, 0x34, 0xdb, 0x6e, 0x9f, 0x51, 0xf0, 0xc3,
-	0x6f, 0x52, 0x85, 0xdc, 0x88, 0xf9, 0x28, 0x38, 0x23, 0x0c, 0x26, 0x41, 0xbe, 0xe2, 0xb1, 0x60,
-	0xe2, 0x39, 0x7e, 0x35, 0x7b, 0x2d, 0xcd, 0x57, 0x24, 0x48, 0x3e, 0x83, 0xbc, 0x27, 0xb8, 0xfa,
-	0xd5, 0x1c, 0x6a, 0x5b, 0x5d, 0xd4, 0x56, 0xfc, 0xd2, 0x90, 0xb2, 0xf6, 0x25, 0x94, 0x66, 0x8e,
-	0x40, 0x2a, 0x90, 0x7e, 0xc5, 0x8e, 0xe5, 0x3d, 0xf1, 0xcf, 0x59, 0xe3, 0xa5, 0xa5, 0xf1, 0x1e,
-	0xa7, 0xbe, 0xd0, 0x6a, 0xdf, 0x40, 0x4e, 0x5d, 0xf1, 0x45, 0xd0, 0x0f, 0x27, 0x4e, 0x4f, 0xd8,
-	0x48, 0x9a, 0x90, 0x23, 0xd0, 0x42, 0x55, 0xc8, 0x71, 0x73, 0x32, 0x19, 0xab, 0x3a, 0x55, 0xa0,
-	0xf1, 0x3b, 0x0d, 0x20, 0xba, 0x03, 0x52, 0x80, 0x5c, 0xfb, 0x60, 0x7b, 0xbb, 0xd9, 0x6e, 0x57,
-	0xce, 0x90, 0x55, 0x28, 0xec, 0x34, 0xda, 0x26, 0x3d, 0x68, 0x99, 0x7b, 0x07, 0x9d, 0x8a, 0x46,
-	0xce, 0x01, 0xd9, 0x6a, 0x3c, 0x6f, 0xb4, 0xb6, 0x9b, 0x66, 0x6b, 0xaf, 0x63, 0x36, 0x5b, 0x7b,
-	0x07, 0x3b, 0xdf, 0x56, 0x52, 0x64, 0x0d, 0x56, 0xbf, 0xa7, 0x7b, 0xad, 0x1d, 0x73, 0xbf, 0x41,
-	0x1b, 0x2f, 0x9a, 0x9d, 0x26, 0xad, 0xa4, 0xc9, 0x59, 0x28, 0xd1, 0x83, 0x56, 0x67, 0xf7, 0x45,
-	0xd3, 0x6c, 0x52, 0xba, 0x47, 0x2b, 0x2b, 0x9c, 0x3b, 0x87, 0x39, 0xb3, 0x4c, 0xb4, 0xa9, 0xf3,
-	0x2f, 0xe6, 0xd3, 0x3d, 0xfa, 0xa2, 0xd1, 0xa9, 0x64, 0xb9, 0x84, 0x27, 0x07, 0xfb, 0xcf, 0x77,
-	0xb7, 0x1b, 0x9d, 0xa6, 0xd9, 0x6e, 0x76, 0xcc, 0xed, 0xbd, 0x27, 0xcd, 0x4a, 0x8e, 0x33, 0x3b,
-	0x68, 0x3d, 0x6b, 0xed, 0x7d, 0xdf, 0x92, 0xcc, 0xf2, 0xc6, 0xcf, 0x69, 0x28, 0x74, 0x3c, 0xcb,
-	0xf1, 0x85, 0x27, 0x72, 0x2f, 0x8c, 0x39, 0x18, 0x7e, 0x73, 0x1c, 0x46, 0xa4, 0xb8, 0x38, 0xfc,
-	0x26, 0x57, 0x00, 0xd8, 0x74, 0x6c, 0x7b, 0x98, 0x2e, 0x65, 0x6a, 0x88, 0x61, 0x94, 0x4b, 0x22,
-	0x24, 0x73, 0x03, 0x77, 0x49, 0xca, 0x61, 0xb5, 0x38, 0xe4, 0xa1, 0xa6, 0x52, 0xc3, 0xc0, 0xf2,
-	0xc3, 0xd0, 0xeb, 0xb3, 0xa1, 0x75, 0x5c, 0xcd, 0x0a, 0x3b, 0x21, 0xc0, 0x83, 0xbf, 0x77, 0x64,
-	0xd9, 0x8e, 0x69, 0xf7, 0xab, 0xb9, 0x6b, 0xda, 0xad, 0x12, 0xcd, 0x21, 0xbc, 0xdb, 0x27, 0x37,
-	0x21, 0x27, 0x94, 0xf7, 0xab, 0x79, 0x74, 0x98, 0x92, 0x74, 0x18, 0x11, 0x95, 0x54, 0xad, 0x72,
-	0xfb, 0xf9, 0xf6, 0xc0, 0x61, 0x9e, 0x5f, 0xd5, 0x85, 0xd3, 0x49, 0x90, 0x5c, 0x02, 0x7d, 0x3c,
-	0xe9, 0x0e, 0x6d, 0xff, 0x88, 0x79, 0x55, 0x10, 0x89, 0x27, 0x44, 0xf0, 0xd0, 0xf5, 0xd8, 0x21,
-	0xf3, 0x3c, 0xd6, 0x37, 0x83, 0x69, 0xb5, 0x20, 0x42, 0x57, 0xa1, 0x3a, 0x53, 0xf2, 0x00, 0x8a,
-	0x16, 0x26, 0x0f, 0x79, 0xa4, 0x22, 0xaa, 0xa1, 0xf2, 0x4d, 0x2c, 0xaf, 0xd0, 0x82, 0x15, 0x4b,
-	0x32, 0x75, 0x80, 0x60, 0x6a, 0x4a, 0x1f, 0xae, 0x96, 0x30, 0x49, 0x55, 0xe6, 0x9d, 0x9d, 0xea,
-	0x81, 0xfa, 0x34, 0xfe, 0xa0, 0xc1, 0x5a, 0xcc, 0x58, 0x61, 0xe2, 0x7c, 0x04, 0x59, 0x11, 0x75,
-	0x68, 0xb6, 0xf2, 0xe6, 0x75, 0xc5, 0x64, 0x91, 0x56, 0x86, 0x2a, 0x95, 0x1b, 0xc8, 0x67, 0x50,
-	0x08, 0x22, 0x2a, 0x34, 0x71, 0xa4, 0x79, 0x7c, 0x7f, 0x9c, 0xcc, 0xb8, 0x0f, 0x59, 0xc1, 0x87,
-	0x3b, 0xe3, 0x7e, 0xb3, 0xf5, 0x64, 0xb7, 0xb5, 0x53, 0x39, 0x43, 0x00, 0xb2, 0xfb, 0x8d, 0xed,
-	0x67, 0xcd, 0x27, 0x15, 0x8d, 0x54, 0xa0, 0xb8, 0x4b, 0x69, 0xf3, 0x65, 0x93, 0xb6, 0x77, 0xb7,
-	0x9e, 0x37, 0x2b, 0x29, 0xe3, 0xf7, 0x1a, 0xe8, 0x6d, 0x7b, 0xe0, 0x58, 0xc1, 0xc4, 0x63, 0xe4,
-	0x0b, 0xd0, 0xad, 0xe1, 0xc0, 0xf5, 0xec, 0xe0, 0x68, 0x24, 0xd5, 0xae, 0x49, 0xb1, 0x21, 0xd1,
-	0x46, 0x43, 0x51, 0xd0, 0x88, 0x98, 0x1b, 0xcb, 0x57, 0x14, 0xa8, 0x70, 0x91, 0x46, 0x08, 0xac,
-	0xa9, 0xdc, 0x72, 0x3d, 0x93, 0xc7, 0x7f, 0x5a, 0x2c, 0x0b, 0xcc, 0x33, 0x76, 0x6c, 0x7c, 0x06,
-	0x7a, 0xc8, 0x94, 0x2b, 0x2f, 0xe3, 0xa1, 0x72, 0x86, 0x94, 0x40, 0x6f, 0x37, 0xb7, 0xf7, 0x37,
-	0x1f, 0x7c, 0xfe, 0xec, 0x5e, 0x45, 0xe3, 0x6b, 0xcd, 0x27, 0x9b, 0x0f, 0x1e, 0xdc, 0x7b, 0x54,
-	0x49, 0x19, 0xbf, 0x4d, 0x03, 0x99, 0xb9, 0x4c, 0x6c, 0x07, 0xc2, 0xc0, 0xd0, 0x96, 0x06, 0x46,
-	0xea, 0xdd, 0x81, 0x91, 0x7e, 0x57, 0x60, 0xac, 0x2c, 0x0b, 0x8c, 0xcc, 0xb2, 0xc0, 0xc8, 0x2e,
-	0x0d, 0x8c, 0xdc, 0x3b, 0x03, 0x63, 0xde, 0x7f, 0xf3, 0xa7, 0xf3, 0xdf, 0xe5, 0xf1, 0x74, 0x17,
-	0x20, 0xb4, 0x88, 0x5f, 0x05, 0x64, 0x57, 0x99, 0xb7, 0x2e, 0x8d, 0xd1, 0xcc, 0x46, 0x60, 0x61,
-	0x3e, 0x02, 0x1f, 0x42, 0x39, 0x04, 0x4c, 0xdf, 0x1e, 0xf8, 0x32, 0xc4, 0x16, 0x79, 0x96, 0x42,
-	0xba, 0xb6, 0x3d, 0xf0, 0x8d, 0xbf, 0xa4, 0x21, 0xb3, 0x35, 0x74, 0x7b, 0xaf, 0x12, 0x13, 0x5b,
-	0x15, 0x72, 0xaf, 0x99, 0xe7, 0x47, 0x86, 0x52, 0x20, 0x0f, 0xf9, 0xb1, 0xe5, 0x31, 0x47, 0xb6,
-	0x1b, 0xa2, 0x26, 0x83, 0x40, 0x61, 0xc9, 0xbd, 0x01, 0xe5, 0x60, 0x6a, 0x8e, 0x98, 0xf7, 0x6a,
-	0xc8, 0x04, 0xcd, 0x0a, 0xd2, 0x14, 0x83, 0xe9, 0x0b, 0x44, 0x22, 0xd5, 0x7d, 0x38, 0x17, 0x45,
-	0xf8, 0x0c, 0xb5, 0xa8, 0x87, 0x6b, 0x61, 0x6c, 0xc7, 0x36, 0x9d, 0x83, 0xac, 0x33, 0x19, 0x75,
-	0x99, 0x27, 0x33, 0xa0, 0x84, 0xb8, 0xb6, 0x6f, 0xec, 0xc0, 0x61, 0xbe, 0x8f, 0x19, 0x50, 0xa7,
-	0x0a, 0x0c, 0xfd, 0x30, 0x1f, 0xf3, 0xc3, 0x99, 0x9e, 0x40, 0x9f, 0xeb, 0x09, 0x2e, 0x40, 0x3e,
-	0x98, 0xca, 0xb6, 0x13, 0xc4, 0xc9, 0x83, 0xa9, 0x68, 0x3a, 0x3f, 0x82, 0x15, 0xec, 0x37, 0x0b,
-	0x98, 0x09, 0xce, 0xca, 0x0b, 0xc6, 0x3b, 0xdc, 0xc0, 0x96, 0x09, 0x97, 0xc9, 0xe7, 0x50, 0x8c,
-	0x25, 0x04, 0x7f, 0x2e, 0xe5, 0xc5, 0x63, 0x65, 0x86, 0xae, 0xd6, 0x86, 0x15, 0x6c, 0x85, 0x55,
-	0xc7, 0xa6, 0x61, 0xd3, 0x2b, 0x3a, 0xb6, 0x73, 0x90, 0x0d, 0x8e, 0x3c, 0x66, 0xf5, 0x65, 0x2b,
-	0x2c, 0x21, 0x6e, 0x8c, 0xae, 0x15, 0xf4, 0x8e, 0x4c, 0xdb, 0xe9, 0xb3, 0x29, 0xf6, 0x30, 0x19,
-	0x0a, 0x88, 0xda, 0xe5, 0x18, 0xe3, 0x47, 0x0d, 0x4a, 0xa8, 0x61, 0x98, 0x11, 0xef, 0xcf, 0x65,
-	0xc4, 0x8b, 0xf1, 0x73, 0x2c, 0xcb, 0x85, 0x06, 0x64, 0xba, 0x7c, 0x5d, 0x66, 0xc1, 0xe2, 0xcc,
-	0x1e, 0xb1, 0x64, 0xdc, 0x4c, 0xce, 0x7c, 0xf3, 0xd9, 0x4e, 0x33, 0x7e, 0x93, 0x82, 0xb3, 0xdb,
-	0x18, 0x88, 0x73, 0x0d, 0xb9, 0xc3, 0x82, 0x78, 0x7b, 0xc1, 0x3b, 0x50, 0xec, 0x2e, 0x6e, 0x43,
-	0x05, 0x87, 0x8e, 0x9e, 0x3b, 0x34, 0xe3, 0x5e, 0xa9, 0xd3, 0x55, 0x85, 0x7f, 0x29, 0xbd, 0x33,
-	0x1e, 0xf3, 0xe9, 0xd9, 0x98, 0xbf, 0x0c, 0x70, 0xc4, 0xac, 0xbe, 0x29, 0x0e, 0xb2, 0x82, 0xb6,
-	0xd5, 0x39, 0x46, 0x44, 0xc1, 0xc7, 0xb0, 0x1a, 0x2d, 0xc7, 0x3d, 0xb1, 0x14, 0xd2, 0xa8, 0x8e,
-	0x72, 0x68, 0x77, 0x25, 0x17, 0xe1, 0x86, 0xf9, 0xa1, 0xdd, 0x15, 0x4c, 0x6e, 0x40, 0x39, 0x5c,
-	0x14, 0x3c, 0x84, 0x3f, 0x16, 0x15, 0x05, 0xb2, 0xb8, 0x0e, 0x45, 0xe9, 0x9f, 0xe6, 0xd0, 0xf6,
-	0x45, 0x52, 0xd1, 0x69, 0x41, 0xe2, 0x9e, 0xdb, 0x7e, 0x60, 0x7c, 0x08, 0xa5, 0x0e, 0x76, 0xb0,
-	0xb1, 0x84, 0x3a, 0x1f, 0xa4, 0xc6, 0x0e, 0x7c, 0xb0, 0xc3, 0x02, 0xe4, 0xbb, 0x75, 0xfc, 0x1e,
-	0x62, 0xd1, 0x81, 0x8f, 0xc6, 0x43, 0x16, 0x88, 0xd2, 0x90, 0xa7, 0x21, 0x6c, 0xbc, 0x80, 0xf3,
-	0x11, 0xa3, 0x16, 0xc6, 0x94, 0x62, 0x15, 0x85, 0x9c, 0x36, 0x13, 0x72, 0xef, 0x62, 0xf7, 0x25,
-	0x94, 0x9e, 0x7a, 0xee, 0x7f, 0x31, 0x67, 0xcb, 0x1a, 0x5a, 0x4e, 0x0f, 0xdd, 0x57, 0x64, 0x47,
-	0x64, 0xa2, 0x51, 0x09, 0x25, 0xb5, 0x4f, 0xc6, 0xbf, 0x41, 0xfe, 0xa5, 0x1b, 0xe0, 0xf8, 0xc3,
-	0xf7, 0xb9, 0x63, 0xac, 0x16, 0xb2, 0xab, 0x17, 0x10, 0x36, 0xac, 0x6e, 0xc0, 0xfc, 0x70, 0xda,
-	0xe0, 0x00, 0x9f, 0xdb, 0x7a, 0x43, 0x66, 0xf1, 0x5e, 0x44, 0xac, 0x8a, 0xdc, 0x54, 0x94, 0x48,
-	0xce, 0xd5, 0x37, 0x0e, 0xa1, 0xb2, 0x23, 0x6b, 0x4a, 0xe8, 0x7a, 0xb7, 0xa0, 0x32, 0x74, 0xdf,
-	0x30, 0x3f, 0x30, 0xa3, 0xfa, 0x23, 0x14, 0x2d, 0x0b, 0xbc, 0xda, 0xc1, 0x29, 0x47, 0xac, 0x6f,
-	0x5b, 0x4e, 0x8c, 0x52, 0x4c, 0x15, 0x65, 0x81, 0x57, 0x94, 0xc6, 0xdf, 0x74, 0xc8, 0x35, 0x7a,
-	0x3d, 0x75, 0xcc, 0x98, 0x5b, 0xe3, 0x37, 0x4f, 0x59, 0x5d, 0x71, 0x3b, 0x92, 0x81, 0x02, 0xc9,
-	0x3d, 0xe0, 0xd9, 0x48, 0x8d, 0xb6, 0x3c, 0xdc, 0xce, 0x85, 0xc5, 0x09, 0xf9, 0x6d, 0xec, 0x58,
-	0xbe, 0x18, 0xd1, 0x06, 0xe2, 0x83, 0x6f, 0xe1, 0x83, 0x0c, 0x6e, 0x59, 0x49, 0xdc, 0xa2, 0xc6,
-	0xdf, 0x9c, 0x67, 0x8d, 0x70, 0x4b, 0x03, 0x0a, 0x63, 0xe6, 0x8d, 0x6c, 0xdf, 0xc7, 0x24, 0x95,
-	0xc1, 0x24, 0x75, 0x75, 0x6e, 0xd7, 0x7e, 0x44, 0x21, 0xc6, 0x9f, 0xf8, 0x1e, 0xb2, 0x09, 0xd9,
-	0x81, 0xe7, 0x4e, 0xc6, 0x62, 0x50, 0x29, 0x84, 0x4d, 0x4a, 0xa8, 0x26, 0x2e, 0x8a, 0x8d, 0x92,
-	0x92, 0x7c, 0x0d, 0xab, 0x87, 0xe8, 0x1a, 0xa6, 0x3c, 0xae, 0x2a, 0xc0, 0xeb, 0x72, 0xf3, 0x8c,
-	0xe3, 0xd0, 0xf2, 0x61, 0x1c, 0xf4, 0xc9, 0x06, 0x00, 0x37, 0x2d, 0x9e, 0x54, 0xf5, 0xb4, 0x6a,
-	0xf0, 0x57, 0x5e, 0x43, 0xf5, 0xd7, 0xf2, 0xcb, 0xaf, 0xfd, 0x13, 0xc0, 0xfe, 0x90, 0xf5, 0x07,
-	0xc2, 0x9d, 0xaa, 0x90, 0x1b, 0x23, 0xe4, 0xa9, 0x0c, 0x23, 0xc1, 0x98, 0x83, 0xa6, 0xe2, 0x0e,
-	0x5a, 0xfb, 0x45, 0x83, 0x9c, 0xbc, 0x6d, 0x74, 0xaf, 0x89, 0x87, 0x95, 0x0f, 0x07, 0x7d, 0xe9,
-	0x22, 0x45, 0x89, 0xec, 0x70, 0x1c, 0x4f, 0x55, 0x98, 0xd4, 0x0f, 0x99, 0x87, 0xcf, 0x07, 0x03,
-	0xcb, 0x97, 0x2c, 0x57, 0xe3, 0xf8, 0x1d, 0xcb, 0xc7, 0x76, 0x0c, 0xc5, 0x23, 0x91, 0xe8, 0x77,
-	0x74, 0x81, 0xe1, 0xcb, 0x1f, 0x41, 0xd9, 0x76, 0x7a, 0x1e, 0xb3, 0x7c, 0x66, 0xfa, 0x63, 0xc6,
-	0xfa, 0xb2, 0xeb, 0x29, 0x29, 0x6c, 0x9b, 0x23, 0x79, 0x28, 0xc4, 0x87, 0x05, 0x01, 0x90, 0xaf,
-	0xa0, 0x28, 0x38, 0xf5, 0x85, 0x53, 0x08, 0x03, 0x5d, 0x98, 0x37, 0x6f, 0x78, 0x35, 0xb4, 0x20,
-	0xc9, 0x39, 0x50, 0xfb, 0x0e, 0x72, 0xd2, 0x5f, 0x78, 0xf3, 0x11, 0x3e, 0x7b, 0xc8, 0x0c, 0x10,
-	0x21, 0xb8, 0x63, 0x4f, 0x7c, 0xd6, 0x57, 0xf1, 0xcb, 0xbf, 0xc5, 0xfb, 0x00, 0xbf, 0x1e, 0x31,
-	0xf9, 0x08, 0xa0, 0xe6, 0xc0, 0xca, 0x6e, 0xc0, 0x46, 0x0b, 0xef, 0x3c, 0x57, 0xa0, 0x60, 0xfb,
-	0xbc, 0x1f, 0x35, 0xc7, 0x96, 0xed, 0xc9, 0x4c, 0xa2, 0xdb, 0xfe, 0x33, 0x76, 0xbc, 0x6f, 0xd9,
-	0x68, 0x98, 0x37, 0xcc, 0x1e, 0x1c, 0x05, 0x92, 0x9d, 0x84, 0x78, 0x2f, 0x19, 0xb9, 0xa2, 0x6c,
-	0x30, 0x62, 0x98, 0xda, 0x53, 0xc8, 0xa0, 0xfb, 0x25, 0xc6, 0xde, 0x6d, 0xc8, 0xd8, 0x01, 0x1b,
-	0x71, 0xcb, 0xf0, 0x6b, 0x59, 0x9b, 0xbb, 0x16, 0xae, 0x28, 0x15, 0x14, 0xb5, 0xff, 0xd1, 0x00,
-	0xa2, 0x28, 0x48, 0xe4, 0x76, 0x15, 0x0a, 0xe8, 0xdc, 0x58, 0xba, 0x04, 0x4f, 0x9d, 0x02, 0xa2,
-	0x78, 0xf5, 0xf2, 0x23, 0x71, 0xe9, 0xf7, 0x89, 0xe3, 0xd7, 0xcd, 0x2b, 0xbb, 0x7f, 0xe4, 0x0e,
-	0xfb, 0xaa, 0x44, 0x85, 0x88, 0xda, 0x0f, 0x50, 0x99, 0x8f, 0xc8, 0x84, 0x69, 0xbe, 0x1e, 0x9f,
-	0xe6, 0x13, 0x8c, 0x1e, 0x72, 0x88, 0x0f, 0xfa, 0x7b, 0x50, 0x88, 0x85, 0x6b, 0x02, 0xd7, 0x3b,
-	0xb3, 0x5c, 0xd7, 0x93, 0x62, 0x3d, 0xc6, 0xd0, 0xf8, 0x0e, 0xce, 0xee, 0xb0, 0x40, 0x2e, 0xc7,
-	0xea, 0xd2, 0xc2, 0xf5, 0xdd, 0x82, 0x4a, 0xf7, 0xd8, 0x1c, 0xba, 0xce, 0x80, 0x27, 0x60, 0x2c,
-	0xd6, 0xd2, 0x0d, 0xca, 0xdd, 0xe3, 0xe7, 0x02, 0x8d, 0xdd, 0x82, 0xf1, 0x8b, 0x06, 0xf9, 0x6d,
-	0xf5, 0x68, 0x94, 0xf0, 0xc6, 0x88, 0xef, 0x30, 0xf2, 0x8d, 0x91, 0x7f, 0xf3, 0x1a, 0x35, 0xb4,
-	0x9c, 0xc1, 0x44, 0x3c, 0xef, 0xe0, 0x8b, 0x85, 0x82, 0xe3, 0x0d, 0xae, 0xf0, 0x9e, 0xb0, 0xc1,
-	0xbd, 0x09, 0x2b, 0x56, 0xd7, 0x56, 0x29, 0x51, 0x59, 0x4b, 0x09, 0xde, 0x68, 0x6c, 0xed, 0x52,
-	0x24, 0xa8, 0xf5, 0x21, 0xdd, 0xd8, 0xda, 0x4d, 0x3c, 0x14, 0x81, 0x15, 0xcb, 0x1b, 0x28, 0x67,
-	0xc0, 0xef, 0x85, 0x51, 0x22, 0x7d, 0xaa, 0x51, 0xc2, 0x68, 0x01, 0xd9, 0x61, 0x81, 0x12, 0xaf,
-	0x6e, 0x72, 0xfe, 0xf8, 0xa7, 0xbf, 0xc5, 0xb7, 0x70, 0x21, 0xc6, 0xaf, 0x1d, 0xb8, 0x9e, 0x35,
-	0x60, 0xcb, 0xd8, 0x4a, 0x3f, 0x48, 0xcd, 0xbc, 0x15, 0x1d, 0xda, 0x6c, 0xd8, 0x97, 0x17, 0x2a,
-	0x80, 0x44, 0xf1, 0x2b, 0x89, 0xe2, 0xef, 0x42, 0x2d, 0x49, 0xbc, 0xac, 0xc4, 0xea, 0xa5, 0x4f,
-	0x8b, 0xbd, 0xf4, 0x8d, 0xe0, 0xea, 0xe2, 0x8e, 0xa7, 0x5c, 0xac, 0x7f, 0x7a, 0xb5, 0x93, 0x14,
-	0x4c, 0x27, 0x2a, 0xf8, 0x18, 0xae, 0x2d, 0x17, 0x27, 0xd5, 0x3c, 0x07, 0x59, 0x3c, 0x37, 0xef,
-	0xa1, 0xb9, 0x81, 0x25, 0x64, 0x7c, 0x0a, 0xe7, 0xdb, 0xcc, 0xe9, 0x27, 0x3d, 0x44, 0x24, 0xf5,
-	0x6f, 0x1e, 0xb6, 0x5d, 0x1d, 0xf7, 0x55, 0x54, 0xf0, 0x14, 0x79, 0xac, 0x3d, 0xd0, 0x66, 0xdb,
-	0x83, 0x84, 0x0a, 0x9a, 0x3a, 0x7d, 0x05, 0x35, 0x3c, 0x38, 0xb7, 0x20, 0x53, 0x5c, 0x62, 0x95,
-	0xcf, 0xc4, 0xbd, 0xb0, 0x4b, 0xd3, 0xa9, 0x02, 0xa3, 0x27, 0xdf, 0x54, 0xfc, 0xc9, 0xf7, 0xf4,
-	0x57, 0x4a, 0xd1, 0xe6, 0x28, 0xf3, 0xe1, 0xe6, 0xbd, 0xf7, 0x1c, 0x35, 0x1d, 0x1d, 0xb5, 0x06,
-	0x79, 0x14, 0xb5, 0xfb, 0x44, 0x45, 0x52, 0x08, 0x1b, 0x7e, 0x74, 0x8e, 0x87, 0x9b, 0xf7, 0xc4,
-	0x24, 0x21, 0xce, 0x91, 0xfc, 0x40, 0x7d, 0x41, 0xf2, 0xe2, 0x83, 0x81, 0x7c, 0xa2, 0x14, 0xbc,
-	0xfa, 0xff, 0xc0, 0x41, 0x1e, 0xc1, 0xc5, 0x98, 0xd0, 0x17, 0x2c, 0xb0, 0xb8, 0x87, 0x86, 0x27,
-	0xa9, 0x41, 0x7e, 0x24, 0x71, 0xea, 0x85, 0x54, 0xc1, 0xc6, 0x5d, 0xa8, 0xc6, 0xb6, 0xee, 0xbd,
-	0x71, 0x78, 0x8b, 0x2d, 0xf7, 0xad, 0x43, 0xc6, 0xe5, 0x08, 0xa5, 0x31, 0x02, 0xc6, 0xff, 0x6a,
-	0x90, 0x69, 0xbe, 0x66, 0x4e, 0x40, 0x6e, 0xf1, 0x13, 0x8d, 0xed, 0x9e, 0x9c, 0xd8, 0x54, 0xca,
-	0xc0, 0xc5, 0x8d, 0x0e, 0x5f, 0xa1, 0x82, 0x20, 0x8c, 0x9f, 0x54, 0x14, 0x3f, 0x61, 0x93, 0x9d,
-	0x8e, 0x35, 0xd9, 0xf7, 0x20, 0x83, 0xfb, 0xc8, 0x3a, 0x54, 0xb6, 0xf7, 0x5a, 0x1d, 0xda, 0xd8,
-	0xee, 0x98, 0xb4, 0xb9, 0xdd, 0xdc, 0xdd, 0xef, 0x54, 0xce, 0x10, 0x02, 0xe5, 0x10, 0xdb, 0x7c,
-	0xd9, 0x6c, 0x75, 0xf8, 0xd4, 0xa6, 0x41, 0xa5, 0x3d, 0xe9, 0xfa, 0x3d, 0xcf, 0xee, 0x86, 0x3e,
-	0x73, 0x07, 0xb2, 0x28, 0x58, 0x04, 0x42, 0xb2, 0x6a, 0x92, 0x82, 0x7c, 0xce, 0x83, 0x66, 0x18,
-	0x30, 0x4f, 0x96, 0x10, 0xf5, 0xd4, 0x3e, 0xcf, 0x74, 0xe3, 0x29, 0x52, 0x51, 0x49, 0x5d, 0xbb,
-	0x0d, 0x59, 0x81, 0xe1, 0x95, 0x56, 0xfd, 0x69, 0x60, 0x86, 0xf1, 0x0e, 0x0a, 0xb5, 0xdb, 0x37,
-	0x1e, 0xc2, 0xd9, 0x18, 0x37, 0x79, 0xbb, 0x06, 0x64, 0x18, 0x57, 0x07, 0xe9, 0xa3, 0xd9, 0x15,
-	0x55, 0xa4, 0x62, 0x69, 0xf3, 0x4f, 0x15, 0x80, 0xc6, 0xd8, 0x6e, 0x33, 0xef, 0xb5, 0xdd, 0x63,
-	0xe4, 0x3b, 0x28, 0xec, 0xb0, 0x40, 0xfd, 0x0b, 0x43, 0x54, 0x0d, 0x88, 0xff, 0xe1, 0x55, 0x3b,
-	0xaf, 0xfe, 0x33, 0x99, 0xfb, 0xaf, 0xc6, 0x58, 0xff, 0xef, 0x3f, 0xfe, 0xf5, 0xa7, 0x54, 0x99,
-	0x14, 0xeb, 0x83, 0x18, 0x8f, 0x0e, 0x14, 0x79, 0x5a, 0x51, 0x63, 0x6f, 0x32, 0x4f, 0xf5, 0x9e,
-	0xbf, 0x30, 0x1d, 0x1b, 0x1f, 0x20, 0xd3, 0x55, 0x52, 0xe2, 0x4c, 0x23, 0x2e, 0x2d, 0x80, 0x1d,
-	0x16, 0xa8, 0x66, 0x2d, 0x91, 0xa7, 0x9a, 0x04, 0xe6, 0xfe, 0x00, 0x33, 0xd6, 0x90, 0x63, 0x89,
-	0x14, 0x38, 0x47, 0xc5, 0xe1, 0x5f, 0xf1, 0xe0, 0x9d, 0xa9, 0x18, 0x27, 0xc9, 0x7a, 0xf8, 0xe4,
-	0x1a, 0x9b, 0x2e, 0x6b, 0xb5, 0xe5, 0x6f, 0xa8, 0xc6, 0x45, 0xe4, 0xfa, 0x01, 0x59, 0xe3, 0x5c,
-	0x15, 0x9f, 0xfa, 0x09, 0x4f, 0x77, 0x6f, 0x49, 0x1f, 0xd6, 0x91, 0xbb, 0x7c, 0xd8, 0xd9, 0x3a,
-	0x16, 0x7c, 0x97, 0x88, 0x59, 0x78, 0xef, 0x35, 0x6e, 0x20, 0xf3, 0x2b, 0xe4, 0x92, 0x60, 0x3e,
-	0xc7, 0x46, 0x49, 0x71, 0xa1, 0x3c, 0x3b, 0x15, 0x93, 0x4b, 0x92, 0x53, 0xe2, 0xb0, 0x5c, 0x5b,
-	0x4f, 0x7a, 0x00, 0x31, 0x6e, 0xa3, 0xac, 0x0f, 0xc9, 0x75, 0x2e, 0x2b, 0xb6, 0x4b, 0x4a, 0xa9,
-	0x9f, 0xa8, 0x69, 0xf7, 0x2d, 0x79, 0x03, 0x95, 0xf9, 0xe9, 0x99, 0x5c, 0x59, 0x10, 0x39, 0x33,
-	0x56, 0x2f, 0x11, 0xfa, 0x29, 0x0a, 0xbd, 0x49, 0x3e, 0x8a, 0x09, 0x15, 0xfb, 0xea, 0x27, 0x62,
-	0xee, 0x9e, 0x11, 0xcc, 0xd0, 0xfa, 0x6a, 0xca, 0xac, 0x46, 0x22, 0x67, 0xdb, 0xae, 0x5a, 0x79,
-	0xb6, 0x59, 0x9b, 0x15, 0x23, 0x91, 0xf5, 0x13, 0xde, 0xb8, 0xbc, 0xad, 0x9f, 0xcc, 0xa7, 0xc2,
-	0xb7, 0xe4, 0xff, 0x35, 0x58, 0x9d, 0xab, 0x19, 0xe4, 0x72, 0x24, 0x2c, 0xa1, 0x96, 0xd4, 0xae,
-	0x2c, 0x5b, 0x96, 0x07, 0xfd, 0x1a, 0x35, 0x78, 0x48, 0x1e, 0xa0, 0x25, 0x63, 0x14, 0xf5, 0x13,
-	0x59, 0x74, 0xde, 0xd6, 0x4f, 0x30, 0x3f, 0x27, 0x6a, 0xf4, 0x2b, 0x0d, 0x9b, 0xa2, 0xb9, 0x8a,
-	0xf2, 0x3e, 0xa5, 0xae, 0xcf, 0x2d, 0x2f, 0xd6, 0x22, 0xe3, 0x1b, 0xd4, 0xeb, 0x31, 0xf9, 0x22,
-	0xd4, 0x2b, 0x22, 0x3a, 0x9d, 0x6a, 0xbf, 0xd6, 0x60, 0x2d, 0xa1, 0x46, 0x2c, 0xe8, 0x36, 0x5b,
-	0xb4, 0x6a, 0xc6, 0xe2, 0xf2, 0x7c, 0x79, 0x31, 0xb6, 0x50, 0xb9, 0xaf, 0xc8, 0xe3, 0xb8, 0x72,
-	0x8a, 0x2a, 0xd2, 0x49, 0x95, 0xb9, 0x44, 0xf5, 0x7e, 0xd2, 0xd0, 0x59, 0x67, 0xea, 0xd0, 0xfb,
-	0x74, 0xbb, 0xba, 0xb8, 0x3c, 0x53, 0xbf, 0x8c, 0x7f, 0x46, 0xc5, 0x1e, 0x91, 0x87, 0x71, 0xc5,
-	0x90, 0xe4, 0x94, 0x5a, 0x89, 0x7c, 0x1b, 0xbe, 0xb2, 0xbc, 0x33, 0xdf, 0xce, 0xbf, 0xde, 0xcc,
-	0xe6, 0xdb, 0x90, 0xc7, 0x00, 0x59, 0x86, 0xe3, 0xc2, 0x85, 0xe8, 0x0c, 0x73, 0xad, 0x74, 0x6d,
-	0x75, 0xae, 0xc3, 0x37, 0x3e, 0x41, 0x86, 0x1f, 0x93, 0x1b, 0x98, 0x6b, 0x25, 0xb6, 0x7e, 0xb2,
-	0x44, 0xf7, 0xe3, 0x99, 0xfe, 0x5c, 0xf6, 0x8b, 0xe4, 0xda, 0xa2, 0xbc, 0xd9, 0x56, 0x3b, 0xee,
-	0x8d, 0x4b, 0xba, 0x61, 0xe3, 0x0a, 0x2a, 0x52, 0x35, 0xd6, 0xe2, 0x8a, 0x48, 0xa2, 0xc7, 0xda,
-	0x1d, 0xf2, 0xa3, 0x86, 0x4d, 0x45, 0x62, 0xaf, 0x4a, 0x3e, 0x5e, 0xca, 0x7f, 0xa6, 0x77, 0xae,
-	0xdd, 0x7c, 0x2f, 0x9d, 0xd4, 0x46, 0x66, 0x5f, 0xe3, 0x42, 0x82, 0x36, 0x82, 0x94, 0xeb, 0xf4,
-	0xef, 0xb0, 0x3a, 0xd7, 0x02, 0x87, 0x77, 0xbf, 0xf8, 0x37, 0x51, 0x98, 0x27, 0x96, 0x74, 0xcd,
-	0x06, 0x41, 0x99, 0x45, 0x23, 0x57, 0xf7, 0x39, 0xc5, 0x94, 0x4b, 0xa0, 0xb0, 0xda, 0x9c, 0xb2,
-	0xde, 0x29, 0x25, 0x2c, 0x56, 0x91, 0x88, 0x27, 0xe3, 0x6c, 0x90, 0xe7, 0xf7, 0xa0, 0x87, 0x8d,
-	0x03, 0x39, 0xbf, 0xa4, 0x31, 0x09, 0xcb, 0xf3, 0x42, 0x8f, 0xa1, 0xca, 0xb3, 0x01, 0x75, 0x5f,
-	0xad, 0x3d, 0xd6, 0xee, 0xdc, 0xd5, 0xba, 0x59, 0x7c, 0xa0, 0xbe, 0xff, 0xf7, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xba, 0x80, 0x7d, 0xb5, 0x66, 0x23, 0x00, 0x00,
+	// 4755 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x3a, 0x4d, 0x6f, 0xe3, 0x48,
+	0x76, 0x43, 0x7d, 0xf3, 0x49, 0xb2, 0xd5, 0x65, 0x8f, 0x5b, 0x66, 0x7f, 0xb9, 0x39, 0x3d, 0xdb,
+	0x3d, 0x93, 0x59, 0x6b, 0xda, 0xf3, 0xdd, 0x33, 0x9b, 0xac, 0xec, 0xd6, 0x78, 0x8c, 0xee, 0x96,
+	0x3d, 0xb4, 0xdc, 0xb3, 0x1b, 0x24, 0xe0, 0x52, 0x52, 0x99, 0x66, 0x2c, 0x91, 0x1a, 0x92, 0x6a,
+	0xcb, 0xd3, 0xe8, 0x60, 0xb1, 0x48, 0x72, 0x48, 0x90, 0x04, 0x8b, 0xb9, 0x04, 0x48, 0x80, 0x20,
+	0xd7, 0x3d, 0x07, 0x49, 0x6e, 0x39, 0x04, 0xf9, 0x05, 0xc9, 0x25, 0xa7, 0x5c, 0x92, 0x73, 0x80,
+	0x2c, 0x10, 0xe4, 0x12, 0x20, 0xa8, 0x2f, 0xb2, 0x48, 0x51, 0xb6, 0xb3, 0x1b, 0xec, 0x49, 0x7a,
+	0xaf, 0x5e, 0xbd, 0x57, 0xf5, 0xea, 0xd5, 0xfb, 0x2a, 0x42, 0xc3, 0x9f, 0x0c, 0x5a, 0x93, 0x7e,
+	0xcb, 0x9f, 0x0c, 0x36, 0x27, 0xbe, 0x17, 0x7a, 0xa8, 0xe8, 0x4f, 0x06, 0x93, 0xbe, 0x76, 0xd3,
+	0xf6, 0x3c, 0x7b, 0x84, 0x5b, 0xd6, 0xc4, 0x69, 0x59, 0xae, 0xeb, 0x85, 0x56, 0xe8, 0x78, 0x6e,
+	0xc0, 0x88, 0xf4, 0x25, 0xa8, 0x75, 0xc6, 0x93, 0xf0, 0xdc, 0xc0, 0x5f, 0x4f, 0x71, 0x10, 0xea,
+	0x9b, 0x50, 0x39, 0xc0, 0xd8, 0xdf, 0x73, 0x8f, 0x3d, 0xb4, 0x04, 0x39, 0x67, 0xd8, 0x54, 0x36,
+	0x94, 0x07, 0xaa, 0x91, 0x73, 0x86, 0x08, 0x41, 0xc1, 0x1a, 0x0e, 0xfd, 0x66, 0x8e, 0x62, 0xe8,
+	0x7f, 0xfd, 0x77, 0xa0, 0xda, 0xc5, 0xe1, 0x99, 0xe7, 0x9f, 0x66, 0x4e, 0xb9, 0x05, 0x30, 0xc1,
+	0xd8, 0x37, 0x07, 0xde, 0xd4, 0x0d, 0xe9, 0xc4, 0xa2, 0xa1, 0x12, 0xcc, 0x0e, 0x41, 0xa0, 0x77,
+	0x80, 0x02, 0xa6, 0xe3, 0x1e, 0x7b, 0xcd, 0xfc, 0x46, 0xfe, 0x41, 0x75, 0x6b, 0x79, 0x93, 0x2e,
+	0x7b, 0x53, 0xac, 0xc2, 0xa8, 0x4c, 0xf8, 0x3f, 0xfd, 0x67, 0x0a, 0x2c, 0x1b, 0xed, 0x67, 0x14,
+	0x8b, 0x83, 0x89, 0xe7, 0x06, 0x18, 0xad, 0x43, 0x65, 0x1a, 0xe0, 0xa1, 0xe9, 0x5b, 0x63, 0x2a,
+	0x36, 0x6f, 0x94, 0x09, 0x6c, 0x58, 0x63, 0xf4, 0x06, 0xd4, 0xad, 0x17, 0x96, 0x33, 0xb2, 0xfa,
+	0x23, 0x4c, 0xc7, 0x73, 0x74, 0xbc, 0x16, 0x21, 0x09, 0xd1, 0x0d, 0x50, 0x43, 0x2f, 0xb4, 0x46,
+	0x94, 0x20, 0x4f, 0x09, 0x2a, 0x14, 0x41, 0x06, 0x6f, 0x01, 0x04, 0x78, 0x34, 0x32, 0x27, 0xbe,
+	0x33, 0xc0, 0xcd, 0xc2, 0x86, 0xf2, 0x40, 0x31, 0x54, 0x82, 0x39, 0x20, 0x08, 0x32, 0xb7, 0x3f,
+	0x3d, 0xe7, 0xa3, 0x45, 0x3a, 0x5a, 0xe9, 0x4f, 0xcf, 0xe9, 0xa0, 0xfe, 0x27, 0x0a, 0x34, 0xba,
+	0xde, 0x10, 0x27, 0x56, 0x7b, 0x0b, 0xa0, 0x3f, 0x75, 0x46, 0x43, 0x33, 0x74, 0xc6, 0x98, 0xab,
+	0x49, 0xa5, 0x98, 0x9e, 0x33, 0xa6, 0x9b, 0xb1, 0x9d, 0xd0, 0x3c, 0xb1, 0x82, 0x13, 0xae, 0xe4,
+	0xb2, 0xed, 0x84, 0x5f, 0x58, 0xc1, 0x09, 0xd1, 0xfd, 0xd8, 0x1b, 0x62, 0xba, 0x44, 0xd5, 0xa0,
+	0xff, 0xd1, 0x3b, 0x50, 0x76, 0x99, 0xee, 0xe9, 0xda, 0xaa, 0x5b, 0x88, 0xeb, 0x4e, 0x3a, 0x11,
+	0x43, 0x90, 0xe8, 0x9f, 0x40, 0xb5, 0x3d, 0x26, 0x5a, 0x7f, 0xea, 0x8c, 0x9d, 0x10, 0xad, 0x42,
+	0x31, 0xf4, 0x4e, 0xb1, 0xcb, 0x57, 0xc1, 0x00, 0x82, 0x7d, 0x61, 0x8d, 0xa6, 0x98, 0x8b, 0x67,
+	0x80, 0xfe, 0x43, 0x28, 0xb5, 0x07, 0xc4, 0x6a, 0x90, 0x06, 0x95, 0x81, 0xe7, 0x86, 0xbe, 0x35,
+	0x08, 0xf9, 0xc4, 0x08, 0x46, 0x77, 0xa0, 0x6a, 0x51, 0x2a, 0xd3, 0xb5, 0xc6, 0x82, 0x03, 0x30,
+	0x54, 0xd7, 0x1a, 0x63, 0xb2, 0x87, 0xa1, 0x15, 0x5a, 0x62, 0x0f, 0xe4, 0xbf, 0xfe, 0xaf, 0x05,
+	0x50, 0x7b, 0x33, 0x03, 0x0f, 0xb0, 0x33, 0x09, 0xd1, 0x75, 0x28, 0x87, 0x33, 0xb6, 0x7f, 0xc6,
+	0xbd, 0x14, 0xce, 0xe8, 0xf6, 0x6f, 0x80, 0x6a, 0x5b, 0x81, 0x39, 0x0d, 0x2c, 0x9b, 0x71, 0x56,
+	0x8c, 0x8a, 0x6d, 0x05, 0x47, 0x04, 0x46, 0x9f, 0x82, 0xea, 0x5b, 0x63, 0x3e, 0xc8, 0xac, 0xe8,
+	0x36, 0xd7, 0x44, 0xc4, 0x7a, 0xd3, 0xb0, 0xc6, 0x94, 0xba, 0xe3, 0x86, 0xfe, 0xb9, 0x51, 0xf1,
+	0x39, 0x88, 0x3e, 0x83, 0x6a, 0x10, 0x5a, 0xe1, 0x34, 0x30, 0x07, 0x44, 0xbf, 0x44, 0x91, 0x4b,
+	0x5b, 0x37, 0xe6, 0xa6, 0x1f, 0x52, 0x9a, 0x1d, 0x6f, 0x88, 0x0d, 0x08, 0xa2, 0xff, 0xa8, 0x09,
+	0xe5, 0x31, 0x0e, 0xa8, 0xe0, 0x22, 0x3b, 0x30, 0x0e, 0x92, 0x11, 0x1f, 0x87, 0x53, 0xdf, 0x0d,
+	0x9a, 0xa5, 0x8d, 0x3c, 0x19, 0xe1, 0x20, 0x7a, 0x1f, 0x2a, 0x3e, 0xe3, 0x1a, 0x34, 0xcb, 0x74,
+	0xb5, 0xcd, 0xf9, 0xd5, 0xb2, 0x5f, 0x23, 0xa2, 0xd4, 0x3e, 0x85, 0x7a, 0x62, 0x0b, 0xa8, 0x01,
+	0xf9, 0x53, 0x7c, 0xce, 0xf5, 0x44, 0xfe, 0x26, 0x0f, 0x2f, 0xcf, 0x0f, 0xef, 0x51, 0xee, 0x63,
+	0x45, 0xfb, 0x3e, 0x94, 0x85, 0x8a, 0x6f, 0x80, 0x7a, 0x3c, 0x75, 0x07, 0xec, 0x8c, 0xf8, 0x11,
+	0x12, 0x04, 0x3d, 0xa1, 0x26, 0x94, 0xc9, 0x71, 0x62, 0x7e, 0x57, 0x55, 0x43, 0x80, 0xfa, 0xdf,
+	0x2a, 0x00, 0xb1, 0x0e, 0x50, 0x15, 0xca, 0x87, 0x47, 0x3b, 0x3b, 0x9d, 0xc3, 0xc3, 0xc6, 0x6b,
+	0x68, 0x19, 0xaa, 0xbb, 0xed, 0x43, 0xd3, 0x38, 0xea, 0x9a, 0xfb, 0x47, 0xbd, 0x86, 0x82, 0xd6,
+	0x00, 0x6d, 0xb7, 0x9f, 0xb6, 0xbb, 0x3b, 0x1d, 0xb3, 0xbb, 0xdf, 0x33, 0x3b, 0xdd, 0xfd, 0xa3,
+	0xdd, 0x2f, 0x1a, 0x39, 0xb4, 0x02, 0xcb, 0x5f, 0x19, 0xfb, 0xdd, 0x5d, 0xf3, 0xa0, 0x6d, 0xb4,
+	0x9f, 0x75, 0x7a, 0x1d, 0xa3, 0x91, 0x47, 0xd7, 0xa0, 0x6e, 0x1c, 0x75, 0x7b, 0x7b, 0xcf, 0x3a,
+	0x66, 0xc7, 0x30, 0xf6, 0x8d, 0x46, 0x81, 0x70, 0x27, 0x30, 0x61, 0x56, 0x8c, 0x27, 0xf5, 0x7e,
+	0x60, 0x7e, 0xbe, 0x6f, 0x3c, 0x6b, 0xf7, 0x1a, 0x25, 0x22, 0xe1, 0xf1, 0xd1, 0xc1, 0xd3, 0xbd,
+	0x9d, 0x76, 0xaf, 0x63, 0x1e, 0x76, 0x7a, 0xe6, 0xce, 0xfe, 0xe3, 0x4e, 0xa3, 0x4c, 0x98, 0x1d,
+	0x75, 0x9f, 0x74, 0xf7, 0xbf, 0xea, 0x72, 0x66, 0x15, 0xfd, 0x67, 0x79, 0xa8, 0xf6, 0x7c, 0xcb,
+	0x0d, 0x98, 0x25, 0x12, 0x2b, 0x94, 0x0c, 0x8c, 0xfe, 0x27, 0x38, 0x7a, 0x23, 0x99, 0xe2, 0xe8,
+	0x7f, 0x74, 0x1b, 0x00, 0xcf, 0x26, 0x8e, 0x4f, 0xdd, 0x25, 0x77, 0x0d, 0x12, 0x46, 0x98, 0x24,
+	0x85, 0x9a, 0x85, 0xc8, 0x24, 0x0d, 0x02, 0x8b, 0xc1, 0x11, 0xb9, 0x6a, 0xc2, 0x35, 0xd8, 0x56,
+	0x10, 0x5d, 0xbd, 0x21, 0x1e, 0x59, 0xe7, 0xcd, 0x12, 0x3b, 0x27, 0x0a, 0x90, 0xcb, 0x3f, 0x38,
+	0xb1, 0x1c, 0xd7, 0x74, 0x86, 0xcd, 0xf2, 0x86, 0xf2, 0xa0, 0x6e, 0x94, 0x29, 0xbc, 0x37, 0x44,
+	0xf7, 0xa1, 0xcc, 0x16, 0x1f, 0x34, 0x2b, 0xd4, 0x60, 0xea, 0xdc, 0x60, 0xd8, 0xad, 0x34, 0xc4,
+	0x28, 0x39, 0xbf, 0xc0, 0xb1, 0x5d, 0xec, 0x07, 0x4d, 0x95, 0x19, 0x1d, 0x07, 0xd1, 0x4d, 0x50,
+	0x27, 0xd3, 0xfe, 0xc8, 0x09, 0x4e, 0xb0, 0xdf, 0x04, 0xe6, 0x78, 0x22, 0x04, 0xb9, 0xba, 0x3e,
+	0x3e, 0xc6, 0xbe, 0x8f, 0x87, 0x66, 0x38, 0x6b, 0x56, 0xd9, 0xd5, 0x15, 0xa8, 0xde, 0x0c, 0x7d,
+	0x00, 0x35, 0x8b, 0x3a, 0x0f, 0xbe, 0xa5, 0xda, 0x46, 0x5e, 0xf2, 0x37, 0x92, 0x5f, 0x31, 0xaa,
+	0x56, 0x0c, 0xa0, 0x16, 0x40, 0x38, 0x33, 0xb9, 0x0d, 0x37, 0xeb, 0xd4, 0x49, 0x35, 0xd2, 0xc6,
+	0x6e, 0xa8, 0xa1, 0xf8, 0xab, 0xff, 0x87, 0x02, 0x2b, 0xd2, 0x61, 0x45, 0x8e, 0xf3, 0x13, 0x28,
+	0xb1, 0x5b, 0x47, 0x8f, 0x6d, 0x69, 0xeb, 0xae, 0x60, 0x32, 0x4f, 0xcb, 0xaf, 0xaa, 0xc1, 0x27,
+	0xa0, 0xf7, 0xa1, 0x1a, 0xc6, 0x54, 0xf4, 0x88, 0xe3, 0x95, 0xcb, 0xf3, 0x65, 0x32, 0xb4, 0x05,
+	0xd5, 0x89, 0xe7, 0x8d, 0x4c, 0x2e, 0x35, 0x4f, 0x67, 0x5d, 0x13, 0xb1, 0xc9, 0xf3, 0x46, 0x5c,
+	0x0a, 0x4c, 0xa2, 0xff, 0xfa, 0x7b, 0x50, 0x62, 0xff, 0x88, 0x01, 0x1f, 0x74, 0xba, 0x8f, 0xf7,
+	0xba, 0xbb, 0x8d, 0xd7, 0x10, 0x40, 0xe9, 0xa0, 0xbd, 0xf3, 0xa4, 0xf3, 0xb8, 0xa1, 0xa0, 0x06,
+	0xd4, 0xf6, 0x0c, 0xa3, 0xf3, 0xbc, 0x63, 0x1c, 0xee, 0x6d, 0x3f, 0xed, 0x34, 0x72, 0x7a, 0x08,
+	0x10, 0xb3, 0x23, 0x86, 0xe8, 0x5b, 0xee, 0x29, 0x0f, 0x65, 0xf4, 0x3f, 0xb1, 0x25, 0xb6, 0x14,
+	0xe7, 0x1b, 0x61, 0xa1, 0x15, 0x2a, 0xd5, 0xf9, 0x06, 0x13, 0x2f, 0xc0, 0xbc, 0x1e, 0x41, 0x93,
+	0xbf, 0x24, 0xec, 0x9d, 0x59, 0x4e, 0xe8, 0xb8, 0xb6, 0xc9, 0xac, 0x8c, 0xd8, 0x66, 0xc5, 0xa8,
+	0x71, 0xe4, 0x63, 0x82, 0xd3, 0x7f, 0x17, 0x56, 0x77, 0x71, 0x78, 0x80, 0xdd, 0xa1, 0xe3, 0xda,
+	0xbd, 0x59, 0xc0, 0xc3, 0x7f, 0xd2, 0x4c, 0x94, 0xb4, 0x99, 0xc8, 0xde, 0x3f, 0x97, 0xf2, 0xfe,
+	0xab, 0x50, 0x64, 0xa6, 0xc1, 0x96, 0xc2, 0x00, 0xb4, 0x06, 0xa5, 0xc1, 0xd4, 0x0f, 0x3c, 0x9f,
+	0xae, 0x42, 0x35, 0x38, 0xa4, 0xbf, 0x82, 0xd7, 0x53, 0xf2, 0xf9, 0x41, 0x7f, 0x08, 0x35, 0xe9,
+	0x18, 0xc8, 0x71, 0xe7, 0x17, 0x1c, 0x57, 0x82, 0x4e, 0x12, 0x94, 0x93, 0x05, 0xb1, 0x30, 0x17,
+	0x5a, 0x23, 0xb1, 0x2c, 0x0a, 0xe8, 0x3f, 0xce, 0xc1, 0x5a, 0x24, 0x9c, 0xa8, 0x3e, 0x5e, 0xc0,
+	0x2a, 0x14, 0x59, 0xb2, 0xc2, 0x8e, 0x80, 0x01, 0x48, 0x87, 0xfa, 0xd8, 0x71, 0xcd, 0xf8, 0xc2,
+	0xb3, 0x18, 0x54, 0x1d, 0x3b, 0xee, 0xae, 0xb8, 0xf3, 0x84, 0xc6, 0x9a, 0x49, 0x34, 0x79, 0x4e,
+	0x63, 0xcd, 0x22, 0x9a, 0xa7, 0x50, 0xb7, 0x6c, 0x6c, 0x9e, 0x38, 0x41, 0xe8, 0xd9, 0x24, 0xe5,
+	0x28, 0xd0, 0xfd, 0xdd, 0x8f, 0x92, 0x9e, 0xac, 0x35, 0x6d, 0xb6, 0x6d, 0xbc, 0x3d, 0x1d, 0x9c,
+	0xe2, 0xd0, 0xa8, 0x59, 0x36, 0xfe, 0x42, 0x4c, 0xd6, 0x1e, 0x81, 0x1a, 0x0d, 0x91, 0xd8, 0x49,
+	0xc4, 0x13, 0x6b, 0x60, 0x4b, 0x2f, 0x8d, 0xad, 0x59, 0xdb, 0x96, 0x76, 0x94, 0x93, 0x76, 0xa4,
+	0xff, 0x9d, 0x02, 0xea, 0xa1, 0x63, 0xbb, 0x56, 0x38, 0xf5, 0x31, 0xfa, 0x18, 0x54, 0x6b, 0x64,
+	0x7b, 0xbe, 0x13, 0x9e, 0x8c, 0xf9, 0x15, 0xd3, 0xf8, 0x9a, 0x22, 0xa2, 0xcd, 0xb6, 0xa0, 0x30,
+	0x62, 0x62, 0x62, 0x31, 0x81, 0xa0, 0xa0, 0x12, 0x6a, 0x46, 0x8c, 0xa0, 0xf9, 0x1f, 0x31, 0x9f,
+	0x81, 0x49, 0x62, 0x55, 0x9e, 0x0d, 0x33, 0xcc, 0x13, 0x7c, 0xae, 0xbf, 0x0f, 0x6a, 0xc4, 0x94,
+	0x5c, 0x1a, 0xee, 0xbb, 0x1b, 0xaf, 0xa1, 0x3a, 0xa8, 0x87, 0x9d, 0x9d, 0x83, 0xad, 0x0f, 0x3e,
+	0x7c, 0xf2, 0xb0, 0xa1, 0x90, 0xb1, 0xce, 0xe3, 0xad, 0x0f, 0x3e, 0x78, 0xf8, 0x49, 0x23, 0xa7,
+	0xff, 0x4d, 0x1e, 0x50, 0xe2, 0xe2, 0x33, 0xdb, 0x15, 0x4e, 0x5c, 0x59, 0xe8, 0xc4, 0x73, 0x17,
+	0x3b, 0xf1, 0xfc, 0x45, 0x4e, 0xbc, 0xb0, 0xc8, 0x89, 0x17, 0x17, 0x39, 0xf1, 0xd2, 0x42, 0x27,
+	0x5e, 0xbe, 0xd0, 0x89, 0xa7, 0x7d, 0x6d, 0xe5, 0x6a, 0xbe, 0x76, 0xb1, 0xef, 0x7f, 0x17, 0x20,
+	0x3a, 0x91, 0xa0, 0x09, 0x1b, 0x79, 0xc9, 0x0b, 0x47, 0xa7, 0x6b, 0x48, 0x34, 0x49, 0x37, 0x50,
+	0x4d, 0xbb, 0x81, 0x8f, 0x60, 0x29, 0x02, 0xcc, 0xc0, 0xb1, 0x83, 0x66, 0x6d, 0x01, 0xcf, 0x7a,
+	0x44, 0x77, 0xe8, 0xd8, 0x81, 0xfe, 0x93, 0x02, 0x14, 0xb7, 0x47, 0xde, 0xe0, 0x34, 0x33, 0x08,
+	0x37, 0xa1, 0xfc, 0x02, 0xfb, 0x41, 0x7c, 0x50, 0x02, 0x24, 0xe1, 0x69, 0x62, 0xf9, 0xd8, 0xe5,
+	0xa9, 0x31, 0xcb, 0x1f, 0x81, 0xa1, 0x68, 0x7a, 0x78, 0x0f, 0x96, 0xc2, 0x99, 0x39, 0xc6, 0xfe,
+	0xe9, 0x08, 0x33, 0x1a, 0xe6, 0x6e, 0x6a, 0xe1, 0xec, 0x19, 0x45, 0x52, 0xaa, 0xf7, 0x60, 0x2d,
+	0x8e, 0x46, 0x09, 0x6a, 0x96, 0xbb, 0xad, 0x44, 0x71, 0x48, 0x9a, 0xb4, 0x06, 0x25, 0x77, 0x3a,
+	0xee, 0x63, 0x9f, 0x47, 0x6b, 0x0e, 0x91, 0xd5, 0x9e, 0x39, 0xa1, 0x8b, 0x83, 0x80, 0x46, 0x6b,
+	0xd5, 0x10, 0x60, 0x64, 0x87, 0x15, 0xc9, 0x0e, 0x13, 0xf9, 0xab, 0x9a, 0xca, 0x5f, 0xd7, 0xa1,
+	0x12, 0xce, 0x78, 0x89, 0x04, 0x6c, 0xe7, 0xe1, 0x8c, 0x15, 0x48, 0x6f, 0x42, 0x81, 0xd6, 0x46,
+	0xd5, 0x44, 0xfc, 0xa1, 0x3a, 0xdc, 0xa4, 0xe9, 0x3d, 0x1d, 0x9e, 0xf3, 0x9a, 0xb5, 0x2b, 0x7a,
+	0x4d, 0x52, 0xe0, 0x84, 0x56, 0x88, 0x4d, 0xdf, 0xf3, 0x58, 0x7c, 0x56, 0x0d, 0x95, 0x62, 0x0c,
+	0xcf, 0x0b, 0xb5, 0x43, 0x28, 0x10, 0x21, 0x51, 0xf1, 0xa1, 0xd0, 0xfa, 0x8d, 0xfe, 0x27, 0x7a,
+	0x09, 0x4f, 0x7c, 0x6c, 0x0d, 0x79, 0x55, 0xc7, 0x21, 0x72, 0x56, 0x7d, 0x2b, 0x1c, 0x9c, 0x98,
+	0x8e, 0x3b, 0xc4, 0x33, 0x9a, 0x8e, 0x17, 0x0d, 0xa0, 0xa8, 0x3d, 0x82, 0xd1, 0x7f, 0xaa, 0x40,
+	0x9d, 0x6e, 0x20, 0x72, 0xb9, 0xef, 0xa5, 0x82, 0xfb, 0x0d, 0x79, 0x9b, 0x8b, 0xc2, 0xba, 0x0e,
+	0xc5, 0x3e, 0x19, 0xe7, 0x01, 0xbd, 0x96, 0x98, 0xc3, 0x86, 0xf4, 0xfb, 0xd9, 0x01, 0x39, 0x1d,
+	0x84, 0x15, 0xfd, 0xaf, 0x72, 0x70, 0x6d, 0x87, 0xde, 0xd3, 0x54, 0x6d, 0xe9, 0xe2, 0x50, 0xce,
+	0x94, 0x49, 0x31, 0x45, 0x13, 0xe5, 0xb7, 0xa0, 0x41, 0xeb, 0xe7, 0x81, 0x37, 0x32, 0x65, 0xa3,
+	0x55, 0x8d, 0x65, 0x81, 0x7f, 0xce, 0xd0, 0x09, 0x97, 0x90, 0x4f, 0xba, 0x84, 0x5b, 0x00, 0x27,
+	0xd8, 0x1a, 0x9a, 0x6c, 0x23, 0x05, 0x7a, 0xf4, 0x2a, 0xc1, 0xb0, 0x4b, 0xf2, 0x1d, 0x58, 0x8e,
+	0x87, 0x65, 0x43, 0xad, 0x47, 0x34, 0xa2, 0x38, 0x1a, 0x39, 0x7d, 0xce, 0x85, 0x59, 0x69, 0x65,
+	0xe4, 0xf4, 0x19, 0x93, 0x7b, 0xb0, 0x14, 0x0d, 0x32, 0x1e, 0xcc, 0x5c, 0x6b, 0x82, 0x82, 0xb2,
+	0xb8, 0x0b, 0x35, 0x6e, 0xbe, 0xe6, 0xc8, 0x09, 0x98, 0xcf, 0x51, 0x8d, 0x2a, 0xc7, 0x3d, 0x75,
+	0x82, 0x50, 0xff, 0xe7, 0x12, 0x34, 0x0f, 0x7c, 0x6f, 0x38, 0x1d, 0x60, 0xff, 0x70, 0x70, 0x82,
+	0x87, 0xd3, 0x11, 0x8e, 0x54, 0xf5, 0x16, 0x34, 0x88, 0x4d, 0xbd, 0xc0, 0x26, 0x9f, 0x82, 0x59,
+	0xe8, 0x56, 0x8d, 0x65, 0x86, 0xff, 0x4a, 0xa0, 0xd1, 0xaf, 0xc1, 0xb5, 0x09, 0x0b, 0x73, 0x12,
+	0x6d, 0x8e, 0xd2, 0x36, 0xf8, 0x40, 0x4c, 0xfc, 0x26, 0x2c, 0x09, 0x62, 0x7e, 0x0b, 0x59, 0x1c,
+	0xaf, 0x73, 0x6c, 0x97, 0x22, 0xd1, 0x23, 0x28, 0x06, 0x23, 0x2f, 0x0c, 0x78, 0x38, 0xbd, 0x27,
+	0xc2, 0xe9, 0x82, 0xe5, 0x6e, 0x1e, 0x8e, 0xbc, 0xd0, 0x60, 0x53, 0x50, 0x07, 0xd4, 0x78, 0x1d,
+	0xc5, 0x64, 0x38, 0x5e, 0x34, 0x9f, 0x2f, 0xd0, 0x88, 0x67, 0xa2, 0xc3, 0x78, 0xa5, 0x54, 0xd7,
+	0xac, 0xec, 0xab, 0x6e, 0xbd, 0x73, 0x19, 0x2f, 0x1e, 0xf3, 0x99, 0xe1, 0xd6, 0x27, 0x12, 0x14,
+	0xa0, 0x87, 0xb0, 0x3a, 0xf0, 0xdc, 0x63, 0xc7, 0x1f, 0xb3, 0xa6, 0x8d, 0xe9, 0x62, 0x3c, 0xc4,
+	0xac, 0x3e, 0xc8, 0x1b, 0x2b, 0x89, 0xb1, 0x2e, 0x1d, 0x4a, 0xd9, 0x54, 0x25, 0x6d, 0x53, 0x09,
+	0x5b, 0x51, 0x93, 0xb6, 0xa2, 0x7d, 0x01, 0x05, 0xa2, 0x19, 0x72, 0xdf, 0x89, 0x6e, 0x44, 0x24,
+	0x0d, 0x38, 0x6e, 0xae, 0x44, 0x92, 0x7c, 0x60, 0x3e, 0xe1, 0x03, 0xb5, 0xdf, 0x57, 0xa0, 0xcc,
+	0x95, 0x24, 0x53, 0x29, 0x09, 0x2a, 0x12, 0x66, 0xce, 0xac, 0x10, 0xfb, 0x63, 0xcb, 0x3f, 0xe5,
+	0x8c, 0x63, 0x04, 0xd9, 0xc9, 0xc8, 0x0a, 0x42, 0xbe, 0x56, 0x76, 0xee, 0x2a, 0xc1, 0xb0, 0x9d,
+	0xbc, 0x01, 0x75, 0xbe, 0x7f, 0x73, 0xea, 0x86, 0xce, 0x88, 0xdf, 0x9f, 0x1a, 0x47, 0x1e, 0x11,
+	0x9c, 0xf6, 0x97, 0x0a, 0xd4, 0x64, 0x05, 0x4b, 0xee, 0x5c, 0x49, 0xb8, 0x73, 0x11, 0x90, 0x72,
+	0xc9, 0x80, 0x94, 0xbd, 0xbd, 0x2b, 0xc9, 0x46, 0xf7, 0xa0, 0x9e, 0x38, 0x20, 0x9e, 0x29, 0x24,
+	0x91, 0xfa, 0x1b, 0x50, 0xef, 0xd1, 0x1e, 0x87, 0x94, 0xc6, 0xa4, 0x43, 0xa3, 0xbe, 0x4b, 0xd3,
+	0x65, 0xba, 0x83, 0xed, 0xf3, 0x4b, 0x88, 0x59, 0x96, 0x3e, 0x9e, 0x8c, 0x70, 0xc8, 0x4e, 0xab,
+	0x62, 0x44, 0xb0, 0xfe, 0x0c, 0xae, 0xc7, 0x8c, 0xd8, 0xe5, 0x11, 0xac, 0x16, 0x69, 0xe6, 0x22,
+	0x76, 0x7f, 0xac, 0xc4, 0xfc, 0x82, 0xed, 0x73, 0xc3, 0x72, 0x6d, 0x2c, 0xf8, 0xdd, 0x85, 0x5a,
+	0x10, 0x5a, 0x7e, 0x68, 0x26, 0xb8, 0x56, 0x29, 0x8e, 0x5f, 0xdb, 0x5b, 0x00, 0xd8, 0x1d, 0x0a,
+	0x02, 0x6e, 0x00, 0xd8, 0x1d, 0x76, 0xe7, 0x25, 0xe7, 0x93, 0x92, 0xe3, 0x72, 0xa3, 0x20, 0x95,
+	0x1b, 0xfa, 0x1f, 0x29, 0xb0, 0xbe, 0x8b, 0xc3, 0xde, 0x2c, 0xd8, 0x3e, 0x67, 0x17, 0xea, 0xff,
+	0x77, 0x45, 0xff, 0xb7, 0x22, 0xe7, 0x3f, 0x15, 0x58, 0xa6, 0xab, 0xe8, 0xcd, 0xc4, 0x6d, 0xff,
+	0xd5, 0x17, 0xb2, 0x77, 0xa1, 0xc6, 0x7c, 0x7f, 0xc2, 0x7f, 0x56, 0x29, 0x2e, 0xde, 0xb4, 0x14,
+	0x1e, 0x0a, 0xbc, 0x2b, 0x19, 0xc5, 0x86, 0x55, 0x28, 0xb2, 0x58, 0xce, 0x33, 0x5d, 0x0a, 0x48,
+	0x9b, 0x2e, 0x25, 0x36, 0xfd, 0x23, 0x58, 0x13, 0x27, 0xd0, 0x1e, 0xd0, 0x9c, 0x46, 0xa8, 0xbf,
+	0x49, 0x12, 0xe0, 0xb8, 0xb6, 0x52, 0x0d, 0x01, 0xc6, 0x6a, 0xcd, 0x65, 0xab, 0x35, 0x9f, 0x90,
+	0x30, 0x86, 0xeb, 0x73, 0x12, 0xb8, 0x76, 0x1f, 0x65, 0x56, 0x8f, 0x6b, 0x72, 0x6e, 0x10, 0x9f,
+	0xc5, 0xd5, 0x2a, 0x48, 0xfd, 0x53, 0xa8, 0x7f, 0xee, 0x7b, 0xdf, 0x60, 0x77, 0xdb, 0x1a, 0x59,
+	0xee, 0x80, 0x66, 0x3e, 0xd6, 0x38, 0xda, 0x86, 0x62, 0x70, 0x28, 0xcb, 0x43, 0xea, 0xbf, 0x0d,
+	0x95, 0xe7, 0x5e, 0x48, 0x9b, 0xc0, 0x64, 0x9e, 0x37, 0xa1, 0x47, 0xc7, 0x7b, 0x9b, 0x0c, 0xa2,
+	0x6d, 0x3b, 0x2f, 0xa4, 0x41, 0x90, 0xf5, 0x5c, 0x09, 0x40, 0x5d, 0xcc, 0x08, 0x5b, 0xa4, 0x23,
+	0xc3, 0x46, 0x99, 0x12, 0x6a, 0x1c, 0x49, 0xb8, 0x06, 0xfa, 0x31, 0x34, 0x44, 0x69, 0x19, 0xe9,
+	0xe0, 0x01, 0x34, 0x46, 0xde, 0x19, 0x0e, 0x42, 0xa9, 0x12, 0x65, 0x0b, 0x5d, 0x62, 0x78, 0x31,
+	0x83, 0x50, 0x8e, 0xf1, 0xd0, 0xb1, 0xe6, 0xeb, 0xda, 0x25, 0x86, 0x17, 0x94, 0xfa, 0xff, 0xa8,
+	0x50, 0xe6, 0xba, 0x26, 0xdb, 0x94, 0x32, 0x22, 0xfa, 0x9f, 0x1c, 0x6d, 0x9f, 0x69, 0x87, 0x33,
+	0x10, 0x20, 0x7a, 0x08, 0x24, 0xcf, 0x15, 0x0d, 0x7e, 0x45, 0x3a, 0x0d, 0xce, 0x6f, 0x73, 0xd7,
+	0x0a, 0x58, 0xa3, 0xda, 0x66, 0x7f, 0xc8, 0x14, 0xd2, 0xce, 0xa5, 0x53, 0x0a, 0x99, 0x53, 0xc4,
+	0x23, 0x40, 0xd9, 0xb7, 0xc6, 0x74, 0x4a, 0x1b, 0xaa, 0x13, 0xec, 0x8f, 0x9d, 0x20, 0xe0, 0x8e,
+	0x96, 0x1c, 0xfb, 0x9d, 0xd4, 0xac, 0x83, 0x98, 0x82, 0x35, 0x81, 0xe5, 0x39, 0x68, 0x0b, 0x4a,
+	0xb6, 0xef, 0x4d, 0x27, 0x22, 0x6e, 0x6b, 0xe9, 0x65, 0xd2, 0x41, 0x36, 0x91, 0x53, 0xa2, 0xef,
+	0xc1, 0xf2, 0x31, 0x35, 0x0d, 0x93, 0x6f, 0x57, 0x94, 0x76, 0xab, 0x7c, 0x72, 0xc2, 0x70, 0x8c,
+	0xa5, 0x63, 0x19, 0x0c, 0xd0, 0x26, 0x00, 0x39, 0x5a, 0xba, 0x53, 0xd1, 0xd9, 0x13, 0xcf, 0x1f,
+	0xc2, 0x6a, 0x0c, 0xf5, 0x05, 0xff, 0x17, 0x68, 0xbf, 0x0e, 0x70, 0x30, 0xc2, 0x43, 0x9b, 0x82,
+	0x44, 0xe7, 0x13, 0x0a, 0x89, 0x46, 0x8d, 0x00, 0x25, 0x03, 0xcd, 0xc9, 0x06, 0xaa, 0xfd, 0x5c,
+	0x81, 0x32, 0xd7, 0x36, 0x35, 0xaf, 0xa9, 0x4f, 0x6b, 0x2a, 0xd6, 0x1f, 0x61, 0x26, 0x52, 0xe3,
+	0xc8, 0x1e, 0xc1, 0x91, 0xac, 0x8e, 0x5e, 0x91, 0x63, 0xec, 0xd3, 0x47, 0x14, 0xdb, 0x0a, 0x38,
+	0xcb, 0x65, 0x19, 0xbf, 0x6b, 0xd1, 0x4a, 0x82, 0x89, 0xa7, 0x44, 0xac, 0x92, 0x56, 0x19, 0x86,
+	0x0c, 0xbf, 0x09, 0x4b, 0x8e, 0x3b, 0xf0, 0xb1, 0x15, 0x60, 0x33, 0x98, 0x60, 0x3c, 0xe4, 0xf5,
+	0x74, 0x5d, 0x60, 0x0f, 0x09, 0x32, 0x76, 0x04, 0xac, 0x65, 0xca, 0x00, 0xf4, 0x19, 0xd4, 0x18,
+	0xa7, 0x21, 0x33, 0x0a, 0x76, 0x40, 0xeb, 0xe9, 0xe3, 0x8d, 0x54, 0x63, 0x54, 0x39, 0x39, 0x01,
+	0xb4, 0x2f, 0xa1, 0xcc, 0xed, 0x85, 0xe4, 0x1b, 0xd1, 0xe3, 0x0f, 0xf7, 0xfe, 0x31, 0x82, 0x18,
+	0x36, 0x79, 0x3a, 0x12, 0xf7, 0x77, 0x1a, 0xb0, 0x05, 0xcd, 0xb7, 0x8f, 0x34, 0x17, 0x0a, 0x7b,
+	0x21, 0x1e, 0xcf, 0xbd, 0x76, 0xdd, 0x86, 0xaa, 0x13, 0x90, 0x4e, 0x87, 0x39, 0xb1, 0x1c, 0x9f,
+	0x47, 0x4b, 0xd5, 0x09, 0x9e, 0xe0, 0xf3, 0x03, 0xcb, 0xa1, 0x07, 0x73, 0x86, 0x1d, 0xfb, 0x44,
+	0xc4, 0x0f, 0x0e, 0x91, 0x2e, 0x45, 0x6c, 0x8a, 0xdc, 0x01, 0x4b, 0x18, 0xed, 0x73, 0x28, 0x52,
+	0xf3, 0xcb, 0xbc, 0x7b, 0x6f, 0x41, 0xd1, 0x09, 0xf1, 0x98, 0xe5, 0xd0, 0xd5, 0xad, 0x95, 0x94,
+	0x5a, 0xc8, 0x42, 0x0d, 0x46, 0xa1, 0xfd, 0xa1, 0x02, 0x10, 0xdf, 0x82, 0x4c, 0x6e, 0x77, 0xa0,
+	0x4a, 0x8d, 0x9b, 0x56, 0x3d, 0x22, 0x2f, 0x07, 0x8a, 0x22, 0x85, 0x4f, 0x10, 0x8b, 0xcb, 0x5f,
+	0x26, 0x8e, 0xa8, 0x9b, 0x14, 0x85, 0xc1, 0x89, 0x37, 0x1a, 0x8a, 0xea, 0x26, 0x42, 0x68, 0x3f,
+	0x84, 0x46, 0xfa, 0x46, 0x66, 0xbc, 0x69, 0xb4, 0xe4, 0x37, 0x8d, 0x8c, 0x43, 0x8f, 0x38, 0xc8,
+	0xcf, 0x1d, 0xfb, 0x50, 0x95, 0xae, 0x6b, 0x06, 0xd7, 0xb7, 0x93, 0x5c, 0x57, 0xb3, 0xee, 0xba,
+	0xc4, 0x50, 0xff, 0x56, 0x81, 0x6b, 0xbb, 0x38, 0x4c, 0x05, 0xb4, 0x2c, 0xfd, 0x3d, 0x80, 0x46,
+	0xff, 0xdc, 0x1c, 0x79, 0xae, 0x4d, 0x3c, 0x30, 0x2d, 0xf4, 0xb8, 0x1d, 0x2c, 0xf5, 0xcf, 0x9f,
+	0x32, 0x34, 0xad, 0x34, 0x7f, 0xf9, 0xc0, 0xac, 0xff, 0x5c, 0x81, 0xca, 0x8e, 0xe8, 0xbf, 0x66,
+	0x3c, 0xd6, 0xd2, 0x07, 0x2d, 0x9e, 0xd0, 0x92, 0xff, 0x24, 0xa1, 0x1a, 0x59, 0xae, 0x3d, 0x15,
+	0x1d, 0x63, 0xd5, 0x88, 0x60, 0xb9, 0xfb, 0xc2, 0x04, 0x09, 0x10, 0xdd, 0x87, 0x82, 0xd5, 0x77,
+	0x84, 0x57, 0x15, 0x07, 0x2e, 0x04, 0x6f, 0xb6, 0xb7, 0xf7, 0x0c, 0x4a, 0xa0, 0x0d, 0x21, 0xdf,
+	0xde, 0xde, 0xcb, 0x54, 0x0b, 0x79, 0x3a, 0xf6, 0x6d, 0x61, 0x4f, 0xf4, 0xff, 0x5c, 0x9f, 0x2b,
+	0x7f, 0xa5, 0x3e, 0x97, 0xde, 0x05, 0xb4, 0x8b, 0x43, 0x21, 0x5e, 0x9c, 0x45, 0x7a, 0xfb, 0x57,
+	0x3e, 0x07, 0xfd, 0xef, 0x59, 0xce, 0x28, 0x18, 0x1e, 0x86, 0x9e, 0x6f, 0xd9, 0x78, 0x11, 0x5f,
+	0x6e, 0x4b, 0xb9, 0xc4, 0xab, 0xdb, 0xb1, 0x83, 0x47, 0x43, 0xae, 0x51, 0x06, 0x64, 0xca, 0x2f,
+	0x5c, 0xc9, 0x0e, 0x8a, 0x97, 0xd9, 0x41, 0x29, 0x6d, 0x07, 0xef, 0x82, 0x96, 0xb5, 0x01, 0x9e,
+	0x0f, 0x88, 0x57, 0x57, 0x45, 0x7a, 0x75, 0xfd, 0x73, 0x05, 0xee, 0xcc, 0x4f, 0xf9, 0x9c, 0xac,
+	0x3c, 0xb8, 0xfa, 0xce, 0xb3, 0xf6, 0x98, 0xcf, 0xdc, 0x63, 0x66, 0xb6, 0x2e, 0x65, 0x5c, 0xc5,
+	0x44, 0xc6, 0x65, 0xc0, 0xc6, 0xe2, 0xc5, 0xf1, 0x5d, 0xad, 0x41, 0x89, 0x2a, 0x5a, 0xb4, 0x19,
+	0x38, 0xb4, 0x30, 0x8b, 0x9b, 0xd1, 0x07, 0x0f, 0x92, 0x51, 0xe3, 0x03, 0xdf, 0xf3, 0x8e, 0x7f,
+	0x65, 0xe7, 0xab, 0xff, 0x8b, 0x02, 0xaf, 0xa7, 0x44, 0x4b, 0x5f, 0x03, 0xc4, 0xc7, 0xaa, 0xa4,
+	0xf3, 0xee, 0x64, 0x73, 0x2e, 0x97, 0x6a, 0xce, 0xc5, 0xaf, 0xbd, 0x79, 0xe9, 0xa9, 0x9e, 0xec,
+	0x1f, 0xcf, 0x9c, 0x20, 0x0c, 0xf8, 0x6a, 0x38, 0x44, 0xae, 0x7e, 0xe0, 0xf4, 0x47, 0x8e, 0x6b,
+	0xb3, 0x8b, 0xac, 0x1a, 0x11, 0x4c, 0x3a, 0x54, 0x23, 0x6c, 0x1d, 0xd3, 0x16, 0x3d, 0x33, 0xae,
+	0x32, 0x81, 0x9f, 0xe0, 0x73, 0x5a, 0x83, 0x93, 0x21, 0x26, 0x89, 0x75, 0x8e, 0x54, 0x82, 0x79,
+	0x4e, 0x10, 0xfa, 0x1e, 0x4d, 0xc5, 0x3b, 0x5f, 0x4f, 0x9d, 0x17, 0xde, 0x80, 0x15, 0xb4, 0x52,
+	0xb6, 0xbf, 0xa0, 0xea, 0xcf, 0xcc, 0xf6, 0xf5, 0x1f, 0x2b, 0xb0, 0x4c, 0x1a, 0xc7, 0x98, 0x37,
+	0xb6, 0x48, 0x2f, 0x31, 0xea, 0xf1, 0x29, 0x0b, 0x7b, 0x7c, 0x68, 0x33, 0xfd, 0xfe, 0x90, 0xd5,
+	0x87, 0x8e, 0x49, 0xc8, 0x66, 0x7d, 0xeb, 0xcc, 0x24, 0x1d, 0x11, 0xfe, 0x1e, 0x51, 0xf6, 0xad,
+	0x33, 0x22, 0x4e, 0xff, 0x3d, 0x05, 0x6a, 0xf2, 0x5e, 0x2e, 0xd8, 0xc3, 0x1a, 0x94, 0x48, 0xda,
+	0x12, 0x75, 0xfd, 0x38, 0x84, 0x36, 0xa1, 0xc4, 0xbb, 0x3f, 0xf9, 0x44, 0xe9, 0x91, 0xda, 0x99,
+	0xc1, 0xa9, 0xa2, 0x9a, 0xa1, 0x20, 0xd5, 0x0c, 0x47, 0xd0, 0x9c, 0x57, 0x6a, 0x54, 0x3e, 0xd6,
+	0xb1, 0x3c, 0xd0, 0x54, 0x12, 0x4e, 0x59, 0x9e, 0x64, 0x24, 0x29, 0xf5, 0xef, 0xc2, 0xf5, 0x43,
+	0xec, 0x0e, 0xb3, 0x5e, 0x57, 0xb3, 0x5a, 0x0e, 0xff, 0xa5, 0xc0, 0x0a, 0x6b, 0x83, 0x27, 0x8d,
+	0xf6, 0x17, 0x6a, 0xd6, 0x0a, 0x1f, 0xc4, 0xde, 0x87, 0xe8, 0xff, 0xb8, 0xac, 0xcc, 0xcb, 0x65,
+	0x25, 0x82, 0xc2, 0xc4, 0x0a, 0x4f, 0x68, 0x23, 0xaf, 0x66, 0xd0, 0xff, 0xd2, 0x3d, 0x21, 0x87,
+	0x56, 0xa4, 0x3c, 0xd4, 0x7e, 0x64, 0x25, 0x17, 0x7b, 0xc7, 0xa4, 0x81, 0x94, 0x2f, 0x35, 0x10,
+	0xfd, 0x1f, 0x73, 0xb0, 0xde, 0x09, 0x42, 0x67, 0x4c, 0x2e, 0x1a, 0x0e, 0xbc, 0xa9, 0x3f, 0xc0,
+	0xf1, 0x01, 0x24, 0x5f, 0xb4, 0x95, 0x4b, 0x5f, 0xb4, 0xe9, 0x37, 0x3d, 0xb4, 0xf3, 0xcf, 0x33,
+	0x4b, 0x85, 0x16, 0x3a, 0x47, 0x24, 0xb9, 0x7c, 0x32, 0xff, 0xdd, 0xca, 0xa6, 0x38, 0xc8, 0x45,
+	0x0b, 0x58, 0xf8, 0x1d, 0x4b, 0x3a, 0x9a, 0x16, 0xae, 0xf6, 0x6a, 0x74, 0xd1, 0x87, 0x0a, 0xbf,
+	0xd4, 0x37, 0x27, 0xba, 0xcf, 0xca, 0x74, 0xef, 0x34, 0xaa, 0x78, 0x22, 0x25, 0x4a, 0xe5, 0xa2,
+	0x92, 0x2c, 0x17, 0x33, 0x2a, 0xaa, 0xdc, 0xd5, 0x2b, 0x2a, 0xfd, 0xaf, 0x15, 0x58, 0x9b, 0x13,
+	0x7a, 0x85, 0xee, 0x03, 0xfb, 0x12, 0x2a, 0x27, 0x7f, 0x09, 0x75, 0xf5, 0xe0, 0x96, 0x0e, 0xe0,
+	0x85, 0xcb, 0x02, 0x78, 0x31, 0x1d, 0xc0, 0x0d, 0xd0, 0xc4, 0xaa, 0x3f, 0xda, 0x7a, 0x78, 0x89,
+	0xb6, 0xf2, 0xb1, 0xb6, 0x34, 0xa8, 0xd0, 0xc5, 0xee, 0x3d, 0x16, 0x99, 0x55, 0x04, 0xeb, 0x41,
+	0xac, 0x89, 0x8f, 0xb6, 0x1e, 0xb2, 0x77, 0x0d, 0xa6, 0x89, 0xec, 0x2f, 0xbf, 0xd6, 0x39, 0x2f,
+	0xf2, 0x4c, 0xc1, 0xbf, 0xfd, 0x61, 0xbc, 0x86, 0x57, 0x57, 0x85, 0xfe, 0x09, 0xdc, 0x90, 0x84,
+	0x3e, 0xc3, 0xa1, 0x45, 0xae, 0x7a, 0xb4, 0x13, 0x0d, 0x2a, 0x63, 0x8e, 0xe3, 0xc2, 0x23, 0x58,
+	0x7f, 0x17, 0x9a, 0xd2, 0xd4, 0xfd, 0x33, 0x17, 0xfb, 0xf2, 0x9b, 0xbc, 0x47, 0x10, 0x62, 0xc5,
+	0x14, 0xd0, 0xff, 0x5b, 0x81, 0x62, 0xe7, 0x05, 0x76, 0x43, 0xf4, 0x80, 0xec, 0x68, 0xe2, 0x0c,
+	0xb8, 0x4b, 0x12, 0x46, 0x4f, 0x07, 0x37, 0x7b, 0x64, 0xc4, 0x60, 0x04, 0x09, 0x47, 0xc4, 0x93,
+	0xa1, 0xc8, 0x07, 0xe7, 0xa5, 0xce, 0xf6, 0xe5, 0x67, 0xaa, 0x7f, 0x03, 0x45, 0xca, 0x1a, 0xad,
+	0x42, 0x63, 0x67, 0xbf, 0xdb, 0x33, 0xda, 0x3b, 0x3d, 0xd3, 0xe8, 0xec, 0x74, 0xf6, 0x0e, 0x7a,
+	0x8d, 0xd7, 0x10, 0x82, 0xa5, 0x08, 0xdb, 0x79, 0xde, 0xe9, 0x92, 0xef, 0xa2, 0xea, 0xa0, 0x76,
+	0x3b, 0x5f, 0x99, 0xdb, 0x4f, 0xf7, 0x77, 0x9e, 0x34, 0x72, 0xe4, 0x23, 0x26, 0xf9, 0x1d, 0x8a,
+	0xe3, 0xf3, 0x68, 0x09, 0xa0, 0xf7, 0x03, 0xf3, 0xb1, 0xb1, 0x7f, 0x70, 0xd0, 0x79, 0xdc, 0x28,
+	0x90, 0xf7, 0xaa, 0xce, 0x97, 0x47, 0x7b, 0xcf, 0xf7, 0x77, 0xda, 0xbd, 0xbd, 0xfd, 0x6e, 0xa3,
+	0xa8, 0xff, 0x43, 0x0e, 0x1a, 0x87, 0xd3, 0x7e, 0x30, 0xf0, 0x9d, 0x7e, 0x64, 0xe1, 0x6f, 0x43,
+	0x89, 0x6e, 0x92, 0x05, 0x85, 0x6c, 0x35, 0x70, 0x0a, 0xf4, 0x21, 0x49, 0x9f, 0x46, 0x21, 0xef,
+	0x71, 0xc6, 0xdf, 0xcb, 0xa5, 0x99, 0x6e, 0x7e, 0x4e, 0xa9, 0x0c, 0x4e, 0x8d, 0xde, 0x86, 0x6b,
+	0xc7, 0xbe, 0x37, 0x36, 0x33, 0x2a, 0x17, 0x72, 0x71, 0xc7, 0xdb, 0x92, 0xd1, 0x2f, 0x68, 0x8b,
+	0x6a, 0x7f, 0xa0, 0x40, 0x89, 0xb1, 0x25, 0xd5, 0xa6, 0xf8, 0x80, 0xc4, 0x8c, 0xd2, 0x30, 0x10,
+	0xa8, 0xbd, 0x61, 0xf2, 0x6b, 0xb5, 0x5c, 0xea, 0x6b, 0x35, 0x0d, 0x2a, 0xfc, 0x0e, 0xb3, 0x70,
+	0xab, 0x1a, 0x11, 0x8c, 0x74, 0xa8, 0x39, 0xbe, 0x8f, 0x69, 0x0d, 0x43, 0xaa, 0x7d, 0xfe, 0x11,
+	0x8c, 0x8c, 0xd3, 0x4f, 0xe1, 0x9a, 0xb4, 0x5f, 0x6e, 0x6b, 0x3a, 0x14, 0x31, 0x51, 0x58, 0x2a,
+	0xe7, 0xa0, 0x4a, 0x34, 0xd8, 0xd0, 0xc2, 0x8f, 0x4d, 0x34, 0xa8, 0x78, 0x2f, 0xb0, 0x7f, 0x3c,
+	0xf2, 0xce, 0x44, 0xc3, 0x5a, 0xc0, 0x5b, 0xff, 0xbe, 0x0e, 0xd0, 0x9e, 0x38, 0x87, 0xd8, 0x7f,
+	0xe1, 0x0c, 0x30, 0xfa, 0x12, 0xaa, 0xbb, 0x38, 0x14, 0x1f, 0x88, 0xa2, 0x28, 0x80, 0x4b, 0xdf,
+	0xe2, 0x6a, 0xd7, 0x39, 0x32, 0xfd, 0x19, 0xa9, 0xbe, 0xfa, 0x93, 0x7f, 0xfa, 0xb7, 0x6f, 0x73,
+	0x4b, 0xa8, 0xd6, 0xb2, 0x25, 0x1e, 0x3d, 0xa8, 0xed, 0x62, 0x76, 0x11, 0x17, 0xf3, 0x14, 0x9f,
+	0x1a, 0xce, 0xbd, 0x76, 0xea, 0xaf, 0x53, 0xa6, 0xcb, 0xa8, 0x4e, 0x98, 0xc6, 0x5c, 0x1c, 0x58,
+	0x21, 0x5f, 0xea, 0xa4, 0x5e, 0xaf, 0xb2, 0x99, 0xdf, 0xb9, 0xe4, 0xad, 0x4b, 0xbf, 0x49, 0x65,
+	0xac, 0xa1, 0x55, 0x22, 0x63, 0x8e, 0x67, 0x17, 0x60, 0x17, 0x87, 0xa2, 0x59, 0x93, 0x29, 0x41,
+	0xe4, 0x53, 0xa9, 0xcf, 0x80, 0xf5, 0x15, 0xca, 0xb8, 0x8e, 0xaa, 0x84, 0xb1, 0xe0, 0xf0, 0x5b,
+	0x54, 0xc7, 0xbd, 0x19, 0x7b, 0x32, 0x41, 0xab, 0x51, 0x98, 0x96, 0x5e, 0x50, 0x34, 0x6d, 0x71,
+	0x03, 0x5e, 0xbf, 0x41, 0xb9, 0xbe, 0x8e, 0x56, 0x5a, 0x76, 0xcc, 0xa7, 0xf5, 0x92, 0x38, 0xf1,
+	0x57, 0x68, 0x48, 0x2b, 0x8a, 0x28, 0xe6, 0x6f, 0x9f, 0xf7, 0x66, 0x17, 0x88, 0x99, 0xcb, 0x11,
+	0xf4, 0x7b, 0x94, 0xf9, 0x6d, 0x74, 0x93, 0x31, 0x4f, 0xb1, 0x11, 0x52, 0x7e, 0x93, 0xea, 0xa4,
+	0x37, 0xa3, 0x49, 0xd8, 0x25, 0x5b, 0xc8, 0x48, 0xd7, 0x74, 0x8d, 0x4a, 0x59, 0x45, 0x88, 0x49,
+	0xa1, 0x83, 0xf1, 0x0e, 0x96, 0x89, 0xbe, 0x99, 0xe0, 0x5f, 0x54, 0xc0, 0x1d, 0x2a, 0x60, 0x1d,
+	0x5d, 0x6f, 0xd9, 0x49, 0x5e, 0x42, 0x8a, 0x07, 0x4b, 0xc9, 0xb7, 0x2b, 0x74, 0x93, 0xb3, 0xcb,
+	0x7c, 0xd2, 0xd2, 0x56, 0xb3, 0x12, 0x4a, 0xfd, 0x2d, 0x2a, 0xe6, 0x0d, 0x74, 0x97, 0x88, 0x91,
+	0x66, 0x71, 0x29, 0xad, 0x97, 0xe2, 0x65, 0xe8, 0x15, 0x3a, 0x83, 0x46, 0xfa, 0x8d, 0x0b, 0xdd,
+	0x9e, 0x13, 0x99, 0x78, 0xfc, 0x5a, 0x20, 0xf4, 0xbb, 0x54, 0xe8, 0x7d, 0xf4, 0x66, 0xcb, 0x4e,
+	0xcd, 0x6b, 0xbd, 0x64, 0xbe, 0x2f, 0x21, 0xf8, 0x04, 0x1a, 0xe9, 0xc7, 0xb0, 0x39, 0xc1, 0xa9,
+	0x57, 0xb2, 0x05, 0x82, 0xf9, 0x3d, 0xd1, 0xaf, 0xb5, 0xec, 0xd4, 0xbc, 0x47, 0xca, 0xdb, 0xef,
+	0x2a, 0x68, 0x02, 0x48, 0x3c, 0x81, 0xc4, 0xcf, 0x5c, 0x68, 0x23, 0x96, 0x95, 0xfd, 0x02, 0xa6,
+	0x2d, 0x78, 0x09, 0xd1, 0x6f, 0x53, 0x79, 0x4d, 0x9d, 0x1b, 0x7a, 0x62, 0x2e, 0x93, 0x38, 0xa6,
+	0xb6, 0x22, 0x3f, 0xba, 0xa0, 0x5b, 0x29, 0x71, 0xc9, 0xee, 0x98, 0x76, 0x7b, 0xd1, 0x70, 0xf2,
+	0x72, 0xe9, 0x8d, 0x96, 0x9d, 0xa4, 0x78, 0xa4, 0xbc, 0x8d, 0x30, 0xd4, 0x13, 0xdf, 0x07, 0xa2,
+	0x1b, 0x31, 0xb7, 0xb9, 0xaf, 0x16, 0xb5, 0x9b, 0xd9, 0x83, 0x5c, 0xd0, 0x3a, 0x15, 0xb4, 0xa2,
+	0x2f, 0xb5, 0x6c, 0x79, 0x9c, 0x88, 0x19, 0xd0, 0xb6, 0x5e, 0xf2, 0xab, 0xbb, 0x6c, 0xc7, 0x73,
+	0xeb, 0xc2, 0x2f, 0xf4, 0x92, 0xd7, 0x2c, 0xc5, 0x0f, 0xd3, 0x2b, 0x2c, 0xb4, 0xd6, 0x8c, 0xd7,
+	0x9a, 0x52, 0xd8, 0x52, 0xb2, 0x0b, 0x99, 0xb4, 0x3e, 0x8e, 0x6c, 0xbd, 0x24, 0x91, 0xf0, 0x55,
+	0xeb, 0x65, 0x3a, 0x21, 0x7b, 0x85, 0xfe, 0x54, 0x81, 0x65, 0x91, 0x41, 0x89, 0xa7, 0x2a, 0xf9,
+	0x88, 0xe6, 0x73, 0x62, 0xed, 0xf6, 0xa2, 0x61, 0xbe, 0xab, 0xef, 0xd1, 0x15, 0x7c, 0x84, 0x3e,
+	0x68, 0xd9, 0x49, 0x8a, 0xd6, 0x4b, 0x1e, 0x68, 0x5f, 0xb5, 0x5e, 0xd2, 0x2c, 0x31, 0x73, 0x45,
+	0x7f, 0xa6, 0x30, 0x33, 0x4d, 0xe6, 0xb5, 0x97, 0x2d, 0xea, 0x6e, 0x6a, 0x78, 0x3e, 0x23, 0xd6,
+	0xbf, 0x4f, 0xd7, 0xf5, 0x08, 0x7d, 0xdc, 0xb2, 0xe7, 0x88, 0xae, 0xb6, 0xb4, 0xbf, 0x50, 0x68,
+	0x58, 0x4b, 0x67, 0xaa, 0x73, 0x6b, 0x4b, 0xa6, 0xce, 0x9a, 0x3e, 0x3f, 0x9c, 0x4e, 0x72, 0xf5,
+	0x6d, 0xba, 0xb8, 0xcf, 0xd0, 0xa3, 0x96, 0x3d, 0x4f, 0x15, 0xaf, 0x49, 0x24, 0xdb, 0x99, 0xcb,
+	0xfb, 0x56, 0xa1, 0xae, 0x24, 0x91, 0x0d, 0x5f, 0xb6, 0xb6, 0x3b, 0xf3, 0xc3, 0x89, 0x2c, 0x5a,
+	0xff, 0x0d, 0xba, 0xb0, 0x4f, 0xd0, 0x47, 0x2d, 0x3b, 0x45, 0x72, 0xc5, 0x55, 0xb1, 0x9c, 0x25,
+	0x7a, 0x3e, 0xbc, 0x30, 0x67, 0x49, 0x3f, 0x4b, 0x26, 0x73, 0x96, 0x88, 0x87, 0x0d, 0x55, 0xa9,
+	0xd5, 0x87, 0xd6, 0xe3, 0x3d, 0xa4, 0x1a, 0xbc, 0xda, 0x72, 0xaa, 0xef, 0xac, 0xbf, 0x43, 0x19,
+	0x7e, 0x07, 0xdd, 0xa3, 0xf9, 0x0a, 0xc7, 0xb6, 0x5e, 0x2e, 0x58, 0xfb, 0x39, 0xa0, 0xf9, 0x9e,
+	0xa2, 0xec, 0x31, 0xb3, 0xfb, 0xbf, 0xda, 0xdd, 0x0b, 0x28, 0xb2, 0x9c, 0x67, 0x8a, 0x88, 0x38,
+	0x99, 0x9f, 0x2a, 0xb4, 0xb4, 0xc9, 0xec, 0x67, 0xa2, 0xef, 0x2c, 0xe4, 0x9f, 0xe8, 0xc6, 0x6a,
+	0xf7, 0x2f, 0xa5, 0xe3, 0xab, 0xe1, 0x69, 0x85, 0xbe, 0xde, 0xb2, 0x17, 0x90, 0xc6, 0xfe, 0x35,
+	0xee, 0x49, 0xca, 0xfe, 0x75, 0xae, 0x49, 0xaa, 0xdd, 0xcc, 0x1e, 0xcc, 0xf2, 0xaf, 0xf1, 0x38,
+	0x11, 0xf3, 0x35, 0x35, 0xe3, 0x44, 0x2b, 0x4b, 0x8e, 0x88, 0x59, 0x8d, 0x43, 0xed, 0xce, 0xc2,
+	0xf1, 0xac, 0xe0, 0x98, 0x20, 0x21, 0x22, 0x7f, 0x04, 0xcb, 0xa9, 0x36, 0x57, 0x64, 0x55, 0xf3,
+	0xdf, 0x0c, 0x47, 0x1e, 0x70, 0x41, 0x67, 0x4c, 0x47, 0x54, 0x56, 0x4d, 0x2f, 0xb7, 0x02, 0x42,
+	0x31, 0x23, 0x12, 0x0c, 0x58, 0xee, 0xcc, 0xf0, 0xe0, 0x8a, 0x12, 0xe6, 0x13, 0xbf, 0x98, 0x27,
+	0x26, 0x6c, 0x28, 0x4f, 0x0f, 0xae, 0xcd, 0xb5, 0x7c, 0x2e, 0xe2, 0xba, 0x71, 0x59, 0x9f, 0x48,
+	0xbf, 0x45, 0xa5, 0x5c, 0xd7, 0x51, 0x0b, 0xa7, 0x69, 0x88, 0xc0, 0xaf, 0x40, 0x8d, 0x6a, 0x1f,
+	0x74, 0x7d, 0x41, 0xf5, 0xa7, 0x35, 0xe7, 0x07, 0x92, 0xd5, 0x82, 0x0e, 0xad, 0x40, 0x8c, 0xd1,
+	0x44, 0xa1, 0x5f, 0xa2, 0xdf, 0x3f, 0xbe, 0xf7, 0xbf, 0x03, 0x00, 0xae, 0xc6, 0x2a, 0xf7, 0x90,
+	0x38, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3532,16 +5353,34 @@ type ApiServiceClient interface {
 	GetNodeInfo(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*NodeInfoResponse, error)
 	// get blockchain information
 	GetChainInfo(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ChainInfoResponse, error)
+	// get the producer schedule and the confirming state of the blocks after the last irreversible block
+	GetProducerSchedule(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ProducerScheduleResponse, error)
 	// get current blockchain ram information
 	GetRAMInfo(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*RAMInfoResponse, error)
 	// get transaction by hash
 	GetTxByHash(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
 	// get transaction receipt by transaction hash
 	GetTxReceiptByTxHash(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*TxReceipt, error)
+	// get the merkle proof of a transaction in its block
+	GetTxProof(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*MerkleProofResponse, error)
+	// get the merkle proof of a transaction receipt in its block
+	GetReceiptProof(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*MerkleProofResponse, error)
 	// get block by hash
 	GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*BlockResponse, error)
 	// get block by number
 	GetBlockByNumber(ctx context.Context, in *GetBlockByNumberRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	// get blocks in the range [start_number, end_number]
+	GetBlocksByRange(ctx context.Context, in *GetBlocksByRangeRequest, opts ...grpc.CallOption) (ApiService_GetBlocksByRangeClient, error)
+	// get transactions packed in blocks in the range [start_number, end_number].
+	// at most 1000 blocks are scanned by a request, if the range is longer, the stream ends
+	// with a response without transaction whose cursor continues from the next block.
+	GetTxsByBlockRange(ctx context.Context, in *GetTxsByBlockRangeRequest, opts ...grpc.CallOption) (ApiService_GetTxsByBlockRangeClient, error)
+	// get transactions related to an account, the account tx index should be enabled
+	GetTxsByAccount(ctx context.Context, in *GetTxsByAccountRequest, opts ...grpc.CallOption) (*GetTxsByAccountResponse, error)
+	// get pending transactions in txpool, in the order of packing priority
+	GetPendingTxs(ctx context.Context, in *GetPendingTxsRequest, opts ...grpc.CallOption) (*GetPendingTxsResponse, error)
+	// get the statistics of pending transactions in txpool
+	GetPendingTxStats(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*PendingTxStatsResponse, error)
 	// get account
 	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*Account, error)
 	// get token balance
@@ -3560,10 +5399,16 @@ type ApiServiceClient interface {
 	GetContractStorage(ctx context.Context, in *GetContractStorageRequest, opts ...grpc.CallOption) (*GetContractStorageResponse, error)
 	// get contract fields storage
 	GetContractStorageFields(ctx context.Context, in *GetContractStorageFieldsRequest, opts ...grpc.CallOption) (*GetContractStorageFieldsResponse, error)
+	// get the value in contract storage with its proof to the state root
+	GetStateProof(ctx context.Context, in *GetStateProofRequest, opts ...grpc.CallOption) (*GetStateProofResponse, error)
+	// get the evidences of the witnesses signing two blocks of the same number or slot
+	GetEquivocations(ctx context.Context, in *GetEquivocationsRequest, opts ...grpc.CallOption) (*GetEquivocationsResponse, error)
 	// send transaction
 	SendTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
 	// execute transaction
 	ExecTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*TxReceipt, error)
+	// estimate the gas, ram and amount limit of a signed transaction
+	EstimateResources(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*EstimateResourcesResponse, error)
 	// subscribe an event
 	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error)
 }
@@ -3585,54 +5430,172 @@ func (c *apiServiceClient) GetNodeInfo(ctx context.Context, in *EmptyRequest, op
 	return out, nil
 }
 
-func (c *apiServiceClient) GetChainInfo(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ChainInfoResponse, error) {
-	out := new(ChainInfoResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetChainInfo", in, out, opts...)
+func (c *apiServiceClient) GetChainInfo(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ChainInfoResponse, error) {
+	out := new(ChainInfoResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetChainInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetProducerSchedule(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ProducerScheduleResponse, error) {
+	out := new(ProducerScheduleResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetProducerSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetRAMInfo(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*RAMInfoResponse, error) {
+	out := new(RAMInfoResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetRAMInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetTxByHash(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
+	out := new(TransactionResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetTxByHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetTxReceiptByTxHash(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*TxReceipt, error) {
+	out := new(TxReceipt)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetTxReceiptByTxHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetTxProof(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*MerkleProofResponse, error) {
+	out := new(MerkleProofResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetTxProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetReceiptProof(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*MerkleProofResponse, error) {
+	out := new(MerkleProofResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetReceiptProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	out := new(BlockResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetBlockByHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetBlockByNumber(ctx context.Context, in *GetBlockByNumberRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	out := new(BlockResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetBlockByNumber", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) GetRAMInfo(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*RAMInfoResponse, error) {
-	out := new(RAMInfoResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetRAMInfo", in, out, opts...)
+func (c *apiServiceClient) GetBlocksByRange(ctx context.Context, in *GetBlocksByRangeRequest, opts ...grpc.CallOption) (ApiService_GetBlocksByRangeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ApiService_serviceDesc.Streams[0], "/rpcpb.ApiService/GetBlocksByRange", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &apiServiceGetBlocksByRangeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *apiServiceClient) GetTxByHash(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
-	out := new(TransactionResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetTxByHash", in, out, opts...)
+type ApiService_GetBlocksByRangeClient interface {
+	Recv() (*BlockResponse, error)
+	grpc.ClientStream
+}
+
+type apiServiceGetBlocksByRangeClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiServiceGetBlocksByRangeClient) Recv() (*BlockResponse, error) {
+	m := new(BlockResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiServiceClient) GetTxsByBlockRange(ctx context.Context, in *GetTxsByBlockRangeRequest, opts ...grpc.CallOption) (ApiService_GetTxsByBlockRangeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ApiService_serviceDesc.Streams[1], "/rpcpb.ApiService/GetTxsByBlockRange", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &apiServiceGetTxsByBlockRangeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *apiServiceClient) GetTxReceiptByTxHash(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*TxReceipt, error) {
-	out := new(TxReceipt)
-	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetTxReceiptByTxHash", in, out, opts...)
+type ApiService_GetTxsByBlockRangeClient interface {
+	Recv() (*BlockTxResponse, error)
+	grpc.ClientStream
+}
+
+type apiServiceGetTxsByBlockRangeClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiServiceGetTxsByBlockRangeClient) Recv() (*BlockTxResponse, error) {
+	m := new(BlockTxResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiServiceClient) GetTxsByAccount(ctx context.Context, in *GetTxsByAccountRequest, opts ...grpc.CallOption) (*GetTxsByAccountResponse, error) {
+	out := new(GetTxsByAccountResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetTxsByAccount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
-	out := new(BlockResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetBlockByHash", in, out, opts...)
+func (c *apiServiceClient) GetPendingTxs(ctx context.Context, in *GetPendingTxsRequest, opts ...grpc.CallOption) (*GetPendingTxsResponse, error) {
+	out := new(GetPendingTxsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetPendingTxs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) GetBlockByNumber(ctx context.Context, in *GetBlockByNumberRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
-	out := new(BlockResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetBlockByNumber", in, out, opts...)
+func (c *apiServiceClient) GetPendingTxStats(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*PendingTxStatsResponse, error) {
+	out := new(PendingTxStatsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetPendingTxStats", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3720,6 +5683,24 @@ func (c *apiServiceClient) GetContractStorageFields(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *apiServiceClient) GetStateProof(ctx context.Context, in *GetStateProofRequest, opts ...grpc.CallOption) (*GetStateProofResponse, error) {
+	out := new(GetStateProofResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetStateProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetEquivocations(ctx context.Context, in *GetEquivocationsRequest, opts ...grpc.CallOption) (*GetEquivocationsResponse, error) {
+	out := new(GetEquivocationsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetEquivocations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) SendTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error) {
 	out := new(SendTransactionResponse)
 	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/SendTransaction", in, out, opts...)
@@ -3738,8 +5719,17 @@ func (c *apiServiceClient) ExecTransaction(ctx context.Context, in *TransactionR
 	return out, nil
 }
 
+func (c *apiServiceClient) EstimateResources(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*EstimateResourcesResponse, error) {
+	out := new(EstimateResourcesResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/EstimateResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ApiService_serviceDesc.Streams[0], "/rpcpb.ApiService/Subscribe", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ApiService_serviceDesc.Streams[2], "/rpcpb.ApiService/Subscribe", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3776,16 +5766,34 @@ type ApiServiceServer interface {
 	GetNodeInfo(context.Context, *EmptyRequest) (*NodeInfoResponse, error)
 	// get blockchain information
 	GetChainInfo(context.Context, *EmptyRequest) (*ChainInfoResponse, error)
+	// get the producer schedule and the confirming state of the blocks after the last irreversible block
+	GetProducerSchedule(context.Context, *EmptyRequest) (*ProducerScheduleResponse, error)
 	// get current blockchain ram information
 	GetRAMInfo(context.Context, *EmptyRequest) (*RAMInfoResponse, error)
 	// get transaction by hash
 	GetTxByHash(context.Context, *TxHashRequest) (*TransactionResponse, error)
 	// get transaction receipt by transaction hash
 	GetTxReceiptByTxHash(context.Context, *TxHashRequest) (*TxReceipt, error)
+	// get the merkle proof of a transaction in its block
+	GetTxProof(context.Context, *TxHashRequest) (*MerkleProofResponse, error)
+	// get the merkle proof of a transaction receipt in its block
+	GetReceiptProof(context.Context, *TxHashRequest) (*MerkleProofResponse, error)
 	// get block by hash
 	GetBlockByHash(context.Context, *GetBlockByHashRequest) (*BlockResponse, error)
 	// get block by number
 	GetBlockByNumber(context.Context, *GetBlockByNumberRequest) (*BlockResponse, error)
+	// get blocks in the range [start_number, end_number]
+	GetBlocksByRange(*GetBlocksByRangeRequest, ApiService_GetBlocksByRangeServer) error
+	// get transactions packed in blocks in the range [start_number, end_number].
+	// at most 1000 blocks are scanned by a request, if the range is longer, the stream ends
+	// with a response without transaction whose cursor continues from the next block.
+	GetTxsByBlockRange(*GetTxsByBlockRangeRequest, ApiService_GetTxsByBlockRangeServer) error
+	// get transactions related to an account, the account tx index should be enabled
+	GetTxsByAccount(context.Context, *GetTxsByAccountRequest) (*GetTxsByAccountResponse, error)
+	// get pending transactions in txpool, in the order of packing priority
+	GetPendingTxs(context.Context, *GetPendingTxsRequest) (*GetPendingTxsResponse, error)
+	// get the statistics of pending transactions in txpool
+	GetPendingTxStats(context.Context, *EmptyRequest) (*PendingTxStatsResponse, error)
 	// get account
 	GetAccount(context.Context, *GetAccountRequest) (*Account, error)
 	// get token balance
@@ -3804,10 +5812,16 @@ type ApiServiceServer interface {
 	GetContractStorage(context.Context, *GetContractStorageRequest) (*GetContractStorageResponse, error)
 	// get contract fields storage
 	GetContractStorageFields(context.Context, *GetContractStorageFieldsRequest) (*GetContractStorageFieldsResponse, error)
+	// get the value in contract storage with its proof to the state root
+	GetStateProof(context.Context, *GetStateProofRequest) (*GetStateProofResponse, error)
+	// get the evidences of the witnesses signing two blocks of the same number or slot
+	GetEquivocations(context.Context, *GetEquivocationsRequest) (*GetEquivocationsResponse, error)
 	// send transaction
 	SendTransaction(context.Context, *TransactionRequest) (*SendTransactionResponse, error)
 	// execute transaction
 	ExecTransaction(context.Context, *TransactionRequest) (*TxReceipt, error)
+	// estimate the gas, ram and amount limit of a signed transaction
+	EstimateResources(context.Context, *TransactionRequest) (*EstimateResourcesResponse, error)
 	// subscribe an event
 	Subscribe(*SubscribeRequest, ApiService_SubscribeServer) error
 }
@@ -3852,6 +5866,24 @@ func _ApiService_GetChainInfo_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetProducerSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetProducerSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetProducerSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetProducerSchedule(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_GetRAMInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
@@ -3906,6 +5938,42 @@ func _ApiService_GetTxReceiptByTxHash_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetTxProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTxProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetTxProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTxProof(ctx, req.(*TxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetReceiptProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetReceiptProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetReceiptProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetReceiptProof(ctx, req.(*TxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_GetBlockByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBlockByHashRequest)
 	if err := dec(in); err != nil {
@@ -3942,6 +6010,102 @@ func _ApiService_GetBlockByNumber_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetBlocksByRange_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetBlocksByRangeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServiceServer).GetBlocksByRange(m, &apiServiceGetBlocksByRangeServer{stream})
+}
+
+type ApiService_GetBlocksByRangeServer interface {
+	Send(*BlockResponse) error
+	grpc.ServerStream
+}
+
+type apiServiceGetBlocksByRangeServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiServiceGetBlocksByRangeServer) Send(m *BlockResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiService_GetTxsByBlockRange_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetTxsByBlockRangeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServiceServer).GetTxsByBlockRange(m, &apiServiceGetTxsByBlockRangeServer{stream})
+}
+
+type ApiService_GetTxsByBlockRangeServer interface {
+	Send(*BlockTxResponse) error
+	grpc.ServerStream
+}
+
+type apiServiceGetTxsByBlockRangeServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiServiceGetTxsByBlockRangeServer) Send(m *BlockTxResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiService_GetTxsByAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTxsByAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTxsByAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetTxsByAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTxsByAccount(ctx, req.(*GetTxsByAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetPendingTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetPendingTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetPendingTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetPendingTxs(ctx, req.(*GetPendingTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetPendingTxStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetPendingTxStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetPendingTxStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetPendingTxStats(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAccountRequest)
 	if err := dec(in); err != nil {
@@ -4104,6 +6268,42 @@ func _ApiService_GetContractStorageFields_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetStateProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStateProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetStateProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetStateProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetStateProof(ctx, req.(*GetStateProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetEquivocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEquivocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetEquivocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetEquivocations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetEquivocations(ctx, req.(*GetEquivocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_SendTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TransactionRequest)
 	if err := dec(in); err != nil {
@@ -4140,6 +6340,24 @@ func _ApiService_ExecTransaction_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_EstimateResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).EstimateResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/EstimateResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).EstimateResources(ctx, req.(*TransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(SubscribeRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -4173,6 +6391,10 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetChainInfo",
 			Handler:    _ApiService_GetChainInfo_Handler,
 		},
+		{
+			MethodName: "GetProducerSchedule",
+			Handler:    _ApiService_GetProducerSchedule_Handler,
+		},
 		{
 			MethodName: "GetRAMInfo",
 			Handler:    _ApiService_GetRAMInfo_Handler,
@@ -4185,6 +6407,14 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTxReceiptByTxHash",
 			Handler:    _ApiService_GetTxReceiptByTxHash_Handler,
 		},
+		{
+			MethodName: "GetTxProof",
+			Handler:    _ApiService_GetTxProof_Handler,
+		},
+		{
+			MethodName: "GetReceiptProof",
+			Handler:    _ApiService_GetReceiptProof_Handler,
+		},
 		{
 			MethodName: "GetBlockByHash",
 			Handler:    _ApiService_GetBlockByHash_Handler,
@@ -4193,6 +6423,18 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlockByNumber",
 			Handler:    _ApiService_GetBlockByNumber_Handler,
 		},
+		{
+			MethodName: "GetTxsByAccount",
+			Handler:    _ApiService_GetTxsByAccount_Handler,
+		},
+		{
+			MethodName: "GetPendingTxs",
+			Handler:    _ApiService_GetPendingTxs_Handler,
+		},
+		{
+			MethodName: "GetPendingTxStats",
+			Handler:    _ApiService_GetPendingTxStats_Handler,
+		},
 		{
 			MethodName: "GetAccount",
 			Handler:    _ApiService_GetAccount_Handler,
@@ -4229,6 +6471,14 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetContractStorageFields",
 			Handler:    _ApiService_GetContractStorageFields_Handler,
 		},
+		{
+			MethodName: "GetStateProof",
+			Handler:    _ApiService_GetStateProof_Handler,
+		},
+		{
+			MethodName: "GetEquivocations",
+			Handler:    _ApiService_GetEquivocations_Handler,
+		},
 		{
 			MethodName: "SendTransaction",
 			Handler:    _ApiService_SendTransaction_Handler,
@@ -4237,8 +6487,22 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExecTransaction",
 			Handler:    _ApiService_ExecTransaction_Handler,
 		},
+		{
+			MethodName: "EstimateResources",
+			Handler:    _ApiService_EstimateResources_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetBlocksByRange",
+			Handler:       _ApiService_GetBlocksByRange_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetTxsByBlockRange",
+			Handler:       _ApiService_GetTxsByBlockRange_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Subscribe",
 			Handler:       _ApiService_Subscribe_Handler,
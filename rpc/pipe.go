@@ -0,0 +1,90 @@
+package rpc
+
+import (
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+)
+
+var errPipeClosed = errors.New("pipe listener closed")
+
+// pipeAddr is the address of the in-process connections from the gateway and JSON-RPC server.
+type pipeAddr struct{}
+
+func (pipeAddr) Network() string { return "pipe" }
+func (pipeAddr) String() string  { return "pipe" }
+
+type pipeConn struct {
+	net.Conn
+}
+
+func (pipeConn) LocalAddr() net.Addr  { return pipeAddr{} }
+func (pipeConn) RemoteAddr() net.Addr { return pipeAddr{} }
+
+// pipeListener accepts the in-process connections, so that the gateway and JSON-RPC server
+// reach the grpc server without going through the network.
+type pipeListener struct {
+	conns  chan net.Conn
+	closed chan struct{}
+	once   sync.Once
+}
+
+func newPipeListener() *pipeListener {
+	return &pipeListener{
+		conns:  make(chan net.Conn),
+		closed: make(chan struct{}),
+	}
+}
+
+// Accept waits for the next in-process connection.
+func (l *pipeListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.closed:
+		return nil, errPipeClosed
+	}
+}
+
+// Close closes the listener.
+func (l *pipeListener) Close() error {
+	l.once.Do(func() {
+		close(l.closed)
+	})
+	return nil
+}
+
+// Addr returns the address of the listener.
+func (l *pipeListener) Addr() net.Addr {
+	return pipeAddr{}
+}
+
+// Dial returns a new in-process connection, it's used as the grpc dialer.
+func (l *pipeListener) Dial(string, time.Duration) (net.Conn, error) {
+	server, client := net.Pipe()
+	select {
+	case l.conns <- pipeConn{server}:
+		return pipeConn{client}, nil
+	case <-l.closed:
+		return nil, errPipeClosed
+	}
+}
+
+// pipeCreds skips the handshake of the in-process connections, which are trusted.
+type pipeCreds struct {
+	credentials.TransportCredentials
+}
+
+func (c pipeCreds) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	if _, ok := conn.(pipeConn); ok {
+		return conn, nil, nil
+	}
+	return c.TransportCredentials.ServerHandshake(conn)
+}
+
+func (c pipeCreds) Clone() credentials.TransportCredentials {
+	return pipeCreds{c.TransportCredentials.Clone()}
+}
@@ -0,0 +1,38 @@
+package rpc
+
+import (
+	"context"
+	"crypto/tls"
+	"testing"
+
+	"github.com/iost-official/go-iost/rpc/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+type fakeAPIServer struct {
+	rpcpb.ApiServiceServer
+}
+
+func (s *fakeAPIServer) GetChainInfo(ctx context.Context, req *rpcpb.EmptyRequest) (*rpcpb.ChainInfoResponse, error) {
+	p, _ := peer.FromContext(ctx)
+	return &rpcpb.ChainInfoResponse{NetName: p.Addr.String()}, nil
+}
+
+func TestPipeListener(t *testing.T) {
+	pipe := newPipeListener()
+	// the handshake of in-process connections is skipped even though the server has no certificate
+	server := grpc.NewServer(grpc.Creds(pipeCreds{credentials.NewTLS(&tls.Config{})}))
+	rpcpb.RegisterApiServiceServer(server, &fakeAPIServer{})
+	go server.Serve(pipe)
+	defer server.Stop()
+
+	conn, err := grpc.Dial("pipe", grpc.WithInsecure(), grpc.WithDialer(pipe.Dial))
+	assert.Nil(t, err)
+	defer conn.Close()
+	res, err := rpcpb.NewApiServiceClient(conn).GetChainInfo(context.Background(), &rpcpb.EmptyRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, "pipe", res.NetName)
+}
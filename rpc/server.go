@@ -2,12 +2,15 @@ package rpc
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
+	"strings"
 	"time"
 
+	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/core/blockcache"
 	"github.com/iost-official/go-iost/core/global"
 	"github.com/iost-official/go-iost/core/txpool"
@@ -21,6 +24,9 @@ import (
 	"github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -37,6 +43,14 @@ type Server struct {
 	gatewayServer *http.Server
 	allowOrigins  []string
 
+	jsonrpcAddr    string
+	jsonrpcConn    *grpc.ClientConn
+	jsonrpcServer  *http.Server
+	jsonrpcHandler *jsonrpcHandler
+
+	pipe      *pipeListener
+	tlsConfig *tls.Config
+
 	quitCh chan struct{}
 
 	enable bool
@@ -47,31 +61,46 @@ func p(pp interface{}) error {
 }
 
 // New returns a new rpc server instance.
-func New(tp txpool.TxPool, bc blockcache.BlockCache, bv global.BaseVariable, p2pService p2p.Service) *Server {
+func New(tp txpool.TxPool, bc blockcache.BlockCache, bv global.BaseVariable, p2pService p2p.Service) (*Server, error) {
+	conf := bv.Config().RPC
 	s := &Server{
-		grpcAddr:     bv.Config().RPC.GRPCAddr,
-		gatewayAddr:  bv.Config().RPC.GatewayAddr,
-		allowOrigins: bv.Config().RPC.AllowOrigins,
+		grpcAddr:     conf.GRPCAddr,
+		gatewayAddr:  conf.GatewayAddr,
+		allowOrigins: conf.AllowOrigins,
+		jsonrpcAddr:  conf.JSONRPCAddr,
+		pipe:         newPipeListener(),
 		quitCh:       make(chan struct{}),
-		enable:       bv.Config().RPC.Enable,
+		enable:       conf.Enable,
 	}
-	s.grpcServer = grpc.NewServer(
+	opts := []grpc.ServerOption{grpc.MaxConcurrentStreams(maxConcurrentStreams)}
+	if conf.TLSCert != "" {
+		tlsConfig, err := common.NewServerTLSConfig(conf.TLSCert, conf.TLSKey, conf.TLSClientCA)
+		if err != nil {
+			return nil, err
+		}
+		s.tlsConfig = tlsConfig
+		opts = append(opts, grpc.Creds(pipeCreds{credentials.NewTLS(tlsConfig)}))
+	}
+	ac := newAccessControl(conf)
+	opts = append(opts,
 		grpc.UnaryInterceptor(
 			grpc_middleware.ChainUnaryServer(
 				metricsUnaryMiddleware,
+				ac.unaryInterceptor,
 				grpc_recovery.UnaryServerInterceptor(grpc_recovery.WithRecoveryHandler(p)),
 			),
 		),
 		grpc.StreamInterceptor(
 			grpc_middleware.ChainStreamServer(
 				metricsStreamMiddleware,
+				ac.streamInterceptor,
 				grpc_recovery.StreamServerInterceptor(grpc_recovery.WithRecoveryHandler(p)),
 			),
-		),
-		grpc.MaxConcurrentStreams(maxConcurrentStreams))
+		))
+	s.grpcServer = grpc.NewServer(opts...)
 	apiService := NewAPIService(tp, bc, bv, p2pService, s.quitCh)
 	rpcpb.RegisterApiServiceServer(s.grpcServer, apiService)
-	return s
+	return s, nil
 }
 
 // Start starts the rpc server.
@@ -82,7 +111,10 @@ func (s *Server) Start() error {
 	if err := s.startGrpc(); err != nil {
 		return err
 	}
-	return s.startGateway()
+	if err := s.startGateway(); err != nil {
+		return err
+	}
+	return s.startJSONRPC()
 }
 
 func (s *Server) startGrpc() error {
@@ -96,20 +128,38 @@ func (s *Server) startGrpc() error {
 			ilog.Fatalf("start grpc failed. err=%v", err)
 		}
 	}()
+	go func() {
+		if err := s.grpcServer.Serve(s.pipe); err != nil && err != errPipeClosed {
+			ilog.Errorf("serve grpc pipe failed. err=%v", err)
+		}
+	}()
 	return nil
 }
 
+// dialOptions returns the options of the gateway and JSON-RPC server connecting to grpc.
+func (s *Server) dialOptions() []grpc.DialOption {
+	return []grpc.DialOption{grpc.WithInsecure(), grpc.WithDialer(s.pipe.Dial)}
+}
+
+func (s *Server) listenAndServe(server *http.Server) error {
+	if s.tlsConfig == nil {
+		return server.ListenAndServe()
+	}
+	server.TLSConfig = s.tlsConfig
+	return server.ListenAndServeTLS("", "")
+}
+
 func (s *Server) startGateway() error {
 	mux := runtime.NewServeMux(
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{OrigName: true, EmitDefaults: true}),
-		runtime.WithProtoErrorHandler(errorHandler))
-	opts := []grpc.DialOption{grpc.WithInsecure()}
-	err := rpcpb.RegisterApiServiceHandlerFromEndpoint(context.Background(), mux, s.grpcAddr, opts)
+		runtime.WithProtoErrorHandler(errorHandler),
+		runtime.WithIncomingHeaderMatcher(gatewayHeaderMatcher))
+	err := rpcpb.RegisterApiServiceHandlerFromEndpoint(context.Background(), mux, s.grpcAddr, s.dialOptions())
 	if err != nil {
 		return err
 	}
 	c := cors.New(cors.Options{
-		AllowedHeaders: []string{"Content-Type", "Accept"},
+		AllowedHeaders: []string{"Content-Type", "Accept", apiKeyHeader},
 		AllowedMethods: []string{"GET", "HEAD", "POST", "PUT", "DELETE"},
 		AllowedOrigins: s.allowOrigins,
 	})
@@ -118,15 +168,61 @@ func (s *Server) startGateway() error {
 		Handler: c.Handler(mux),
 	}
 	go func() {
-		if err := s.gatewayServer.ListenAndServe(); err != http.ErrServerClosed {
+		if err := s.listenAndServe(s.gatewayServer); err != http.ErrServerClosed {
 			ilog.Fatalf("start gateway failed. err=%v", err)
 		}
 	}()
 	return nil
 }
 
+func (s *Server) startJSONRPC() error {
+	if s.jsonrpcAddr == "" {
+		return nil
+	}
+	conn, err := grpc.Dial(s.grpcAddr, s.dialOptions()...)
+	if err != nil {
+		return err
+	}
+	s.jsonrpcConn = conn
+	c := cors.New(cors.Options{
+		AllowedHeaders: []string{"Content-Type", "Accept", apiKeyHeader},
+		AllowedMethods: []string{"POST"},
+		AllowedOrigins: s.allowOrigins,
+	})
+	s.jsonrpcHandler = newJSONRPCHandler(rpcpb.NewApiServiceClient(conn), s.checkOrigin)
+	s.jsonrpcServer = &http.Server{
+		Addr:    s.jsonrpcAddr,
+		Handler: c.Handler(s.jsonrpcHandler),
+	}
+	go func() {
+		if err := s.listenAndServe(s.jsonrpcServer); err != http.ErrServerClosed {
+			ilog.Fatalf("start jsonrpc failed. err=%v", err)
+		}
+	}()
+	return nil
+}
+
+// checkOrigin checks the websocket request origin against allowOrigins.
+func (s *Server) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	for _, o := range s.allowOrigins {
+		if o == "*" || strings.EqualFold(o, origin) {
+			return true
+		}
+	}
+	return false
+}
+
 func errorHandler(_ context.Context, _ *runtime.ServeMux, _ runtime.Marshaler, w http.ResponseWriter, _ *http.Request, err error) {
-	w.WriteHeader(400)
+	switch code := status.Code(err); code {
+	case codes.Unauthenticated, codes.PermissionDenied, codes.ResourceExhausted:
+		w.WriteHeader(runtime.HTTPStatusFromCode(code))
+	default:
+		w.WriteHeader(400)
+	}
 	bytes, e := json.Marshal(err)
 	if e != nil {
 		bytes = []byte(fmt.Sprint(err))
@@ -142,5 +238,10 @@ func (s *Server) Stop() {
 	close(s.quitCh)
 	ctx, _ := context.WithTimeout(context.Background(), time.Second) // nolint
 	s.gatewayServer.Shutdown(ctx)
+	if s.jsonrpcServer != nil {
+		s.jsonrpcServer.Shutdown(ctx)
+		s.jsonrpcHandler.close()
+		s.jsonrpcConn.Close()
+	}
 	s.grpcServer.GracefulStop()
 }
@@ -43,6 +43,11 @@ func (h *Authority) RequireAuth(id, p string) (bool, contract.Cost) {
 
 // IsContract to judge the id is contract format
 func (h *Authority) IsContract(id string) bool {
+	return IsContract(id)
+}
+
+// IsContract to judge the id is contract format
+func IsContract(id string) bool {
 	// todo tell apart contractid and accountid
 	if strings.HasPrefix(id, "Contract") || strings.Contains(id, ".") {
 		return true
@@ -1,8 +1,10 @@
 package host
 
 import (
+	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/core/contract"
 	"github.com/iost-official/go-iost/core/event"
+	"github.com/iost-official/go-iost/core/tx"
 )
 
 // EventPoster the event handler in host
@@ -18,7 +20,24 @@ func NewEventPoster(h *Host) EventPoster {
 // PostEvent post the event
 func (p *EventPoster) PostEvent(data string) contract.Cost {
 	e := event.NewEvent(event.ContractEvent, data)
-	event.GetCollector().Post(e,
-		&event.Meta{ContractID: p.h.Context().Value("contract_name").(string)})
+	e.BlockNumber, _ = p.h.Context().Value("number").(int64)
+	event.GetCollector().Post(e, p.h.eventMeta(nil))
 	return EventCost(len(data))
 }
+
+// eventMeta returns the meta of the event posted by the current contract function.
+// The accounts of the event are the publisher and the accounts in the token receipt.
+func (h *Host) eventMeta(rec *tx.Receipt) *event.Meta {
+	contractName := h.Context().Value("contract_name").(string)
+	meta := &event.Meta{
+		ContractID: contractName,
+		FuncName:   contractName + "/" + h.Context().Value("abi_name").(string),
+	}
+	if publisher, ok := h.Context().Value("publisher").(string); ok {
+		meta.Accounts = append(meta.Accounts, publisher)
+	}
+	if rec != nil {
+		meta.Accounts = append(meta.Accounts, block.ReceiptAccounts(rec)...)
+	}
+	return meta
+}
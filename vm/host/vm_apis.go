@@ -27,8 +27,9 @@ func (h *APIDelegate) receipt(s string) {
 	h.h.ctx.GSet("receipts", append(rs, rec))
 
 	// post event for receipt
-	event.GetCollector().Post(event.NewEvent(event.ContractReceipt, rec.Content),
-		&event.Meta{ContractID: h.h.Context().Value("contract_name").(string)})
+	e := event.NewEvent(event.ContractReceipt, rec.Content)
+	e.BlockNumber, _ = h.h.Context().Value("number").(int64)
+	event.GetCollector().Post(e, h.h.eventMeta(rec))
 }
 
 // Receipt ...